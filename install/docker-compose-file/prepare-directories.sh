@@ -3,3 +3,4 @@
 mkdir -p ./data/artifact/artifacts/gloo-system
 mkdir -p ./data/config/{authconfigs,gateways,graphqlapis,proxies,ratelimitconfigs,routeoptions,routetables,upstreamgroups,upstreams,virtualhostoptions,virtualservices,httpgateways}/gloo-system
 mkdir -p ./data/secret/secrets/{default,gloo-system}
+mkdir -p ./data/endpoints
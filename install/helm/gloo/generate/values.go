@@ -295,9 +295,11 @@ type GatewayValidation struct {
 }
 
 type Webhook struct {
-	Enabled          *bool             `json:"enabled,omitempty" desc:"enable validation webhook (default true)"`
-	DisableHelmHook  *bool             `json:"disableHelmHook,omitempty" desc:"do not create the webhook as helm hook (default false)"`
-	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty" desc:"extra annotations to add to the webhook"`
+	Enabled                      *bool             `json:"enabled,omitempty" desc:"enable validation webhook (default true)"`
+	DisableHelmHook              *bool             `json:"disableHelmHook,omitempty" desc:"do not create the webhook as helm hook (default false)"`
+	ExtraAnnotations             map[string]string `json:"extraAnnotations,omitempty" desc:"extra annotations to add to the webhook"`
+	ValidateSecretsAndConfigMaps *bool             `json:"validateSecretsAndConfigMaps,omitempty" desc:"also validate the kubernetes Secrets and ConfigMaps which Gloo Edge reads secrets and artifacts from, in the namespaces it watches (default false)"`
+	SecretsAndConfigMapsLabels   map[string]string `json:"secretsAndConfigMapsLabels,omitempty" desc:"if set, only validate the Secrets and ConfigMaps with these labels"`
 	*KubeResourceOverride
 }
 
@@ -397,6 +399,7 @@ type GatewayProxy struct {
 	IstioMetaClusterId             *string                      `json:"istioMetaClusterId,omitempty" desc:"ISTIO_META_CLUSTER_ID Environment Variable. Defaults to \"Kubernetes\""`
 	IstioDiscoveryAddress          *string                      `json:"istioDiscoveryAddress,omitempty" desc:"discoveryAddress field of the PROXY_CONFIG environment variable. Defaults to \"istiod.istio-system.svc:15012\""`
 	LogLevel                       *string                      `json:"logLevel,omitempty" desc:"Level at which the pod should log. Options include \"info\", \"debug\", \"warn\", \"error\", \"panic\" and \"fatal\". Default level is info"`
+	ValidateBootstrap              *bool                        `json:"validateBootstrap,omitempty" desc:"Validate the Envoy bootstrap config before starting Envoy, so that an invalid config exits with an error describing the problem rather than Envoy crash-looping. Defaults to true"`
 	XdsServiceAddress              *string                      `json:"xdsServiceAddress,omitempty" desc:"The k8s service name for the xds server. Defaults to gloo."`
 	XdsServicePort                 *uint32                      `json:"xdsServicePort,omitempty" desc:"The k8s service port for the xds server. Defaults to the value from .Values.gloo.deployment.xdsPort, but can be overridden to use, for example, xds-relay."`
 	*KubeResourceOverride
@@ -532,10 +535,11 @@ type GatewayProxyConfigMap struct {
 }
 
 type Ingress struct {
-	Enabled             *bool              `json:"enabled,omitempty"`
-	Deployment          *IngressDeployment `json:"deployment,omitempty"`
-	RequireIngressClass *bool              `json:"requireIngressClass,omitempty" desc:"only serve traffic for Ingress objects with the Ingress Class annotation 'kubernetes.io/ingress.class'. By default the annotation value must be set to 'gloo', however this can be overriden via customIngressClass."`
-	CustomIngress       *bool              `json:"customIngressClass,omitempty" desc:"Only relevant when requireIngressClass is set to true. Setting this value will cause the Gloo Edge Ingress Controller to process only those Ingress objects which have their ingress class set to this value (e.g. 'kubernetes.io/ingress.class=SOMEVALUE')."`
+	Enabled                     *bool              `json:"enabled,omitempty"`
+	Deployment                  *IngressDeployment `json:"deployment,omitempty"`
+	RequireIngressClass         *bool              `json:"requireIngressClass,omitempty" desc:"only serve traffic for Ingress objects with the Ingress Class annotation 'kubernetes.io/ingress.class'. By default the annotation value must be set to 'gloo', however this can be overriden via customIngressClass."`
+	CustomIngress               *bool              `json:"customIngressClass,omitempty" desc:"Only relevant when requireIngressClass is set to true. Setting this value will cause the Gloo Edge Ingress Controller to process only those Ingress objects which have their ingress class set to this value (e.g. 'kubernetes.io/ingress.class=SOMEVALUE')."`
+	CustomIngressControllerName *string            `json:"customIngressControllerName,omitempty" desc:"Only relevant when requireIngressClass is set to true. The controller of the IngressClasses whose Ingress objects are processed by the Gloo Edge Ingress Controller. Defaults to 'solo.io/gloo-ingress'."`
 }
 
 type IngressDeployment struct {
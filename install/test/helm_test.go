@@ -1,9 +1,11 @@
 package test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"os/exec"
 	"reflect"
 	"strings"
@@ -21,6 +23,10 @@ import (
 	values "github.com/solo-io/gloo/install/helm/gloo/generate"
 	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	// register the types used in typed configs of the envoy bootstrap
+	_ "github.com/solo-io/gloo/projects/envoyinit/hack/filter_types"
+	"github.com/solo-io/gloo/projects/envoyinit/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/envoyinit/pkg/downward"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/test/matchers"
 	"github.com/solo-io/k8s-utils/installutils/kuberesource"
@@ -617,6 +623,28 @@ var _ = Describe("Helm Test", func() {
 					})
 				})
 
+				DescribeTable("renders a gateway-proxy-envoy-config which envoyinit validates", func(valuesArgs ...string) {
+					prepareMakefile(namespace, helmValues{valuesArgs: valuesArgs})
+
+					configMapObject, err := kuberesource.ConvertUnstructured(testManifest.ExpectCustomResource("ConfigMap", namespace, "gateway-proxy-envoy-config"))
+					Expect(err).NotTo(HaveOccurred())
+					configMap, ok := configMapObject.(*v1.ConfigMap)
+					Expect(ok).To(BeTrue())
+
+					var buffer bytes.Buffer
+					downwardApi := downward.RetrieveDownwardAPIFrom(
+						func(string) ([]byte, error) { return nil, os.ErrNotExist },
+						func(name string) string { return name },
+					)
+					err = downward.NewInterpolator().Interpolate(configMap.Data["envoy.yaml"], &buffer, downwardApi)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(bootstrap.Validate(buffer.String())).To(Succeed())
+				},
+					Entry("by default"),
+					Entry("with gloo mtls", "global.glooMtls.enabled=true"),
+					Entry("with the read config api and stats", "gatewayProxies.gatewayProxy.readConfig=true", "global.glooStats.enabled=true"),
+				)
+
 				It("should set route prefix_rewrite in gateway-proxy-envoy-config from global.glooStats", func() {
 					prepareMakefile(namespace, helmValues{
 						valuesArgs: []string{
@@ -2955,6 +2983,17 @@ spec:
 						testManifest.ExpectDeploymentAppsV1(gatewayProxyDeployment)
 					})
 
+					It("can disable bootstrap validation", func() {
+						gatewayProxyDeployment.Spec.Template.Spec.Containers[0].Env = append(
+							gatewayProxyDeployment.Spec.Template.Spec.Containers[0].Env,
+							v1.EnvVar{Name: "VALIDATE_BOOTSTRAP", Value: "false"},
+						)
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{"gatewayProxies.gatewayProxy.validateBootstrap=false"},
+						})
+						testManifest.ExpectDeploymentAppsV1(gatewayProxyDeployment)
+					})
+
 					It("can accept extra env vars", func() {
 						gatewayProxyDeployment.Spec.Template.Spec.Containers[0].Env = append(
 							[]v1.EnvVar{GetTestExtraEnvVar()},
@@ -3407,11 +3446,19 @@ webhooks:
      - operations: [ "CREATE", "UPDATE", "DELETE" ]
        apiGroups: ["gloo.solo.io"]
        apiVersions: ["v1"]
-       resources: ["upstreams"]
-     - operations: [ "DELETE" ]
-       apiGroups: ["gloo.solo.io"]
+       resources: ["upstreams", "upstreamgroups"]
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: ["enterprise.gloo.solo.io"]
        apiVersions: ["v1"]
-       resources: ["secrets"]
+       resources: ["authconfigs"]
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: ["ratelimit.solo.io"]
+       apiVersions: ["v1alpha1"]
+       resources: ["ratelimitconfigs"]
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: ["graphql.gloo.solo.io"]
+       apiVersions: ["v1beta1"]
+       resources: ["graphqlapis"]
    sideEffects: None
    matchPolicy: Exact
    admissionReviewVersions:
@@ -3423,6 +3470,32 @@ webhooks:
 						testManifest.ExpectUnstructured(vwc.GetKind(), vwc.GetNamespace(), vwc.GetName()).To(BeEquivalentTo(vwc))
 					})
 
+					It("validates the secrets and config maps in the watched namespaces when enabled", func() {
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{
+								"gateway.validation.webhook.validateSecretsAndConfigMaps=true",
+								"gateway.validation.webhook.secretsAndConfigMapsLabels.gloo=validated",
+								"settings.watchNamespaces[0]=gloo-system",
+								"settings.watchNamespaces[1]=apps",
+							},
+						})
+						testManifest.ExpectUnstructured("ValidatingWebhookConfiguration", "", "gloo-gateway-validation-webhook-"+namespace).To(
+							WithTransform(func(vwc *unstructured.Unstructured) []interface{} {
+								webhooks, _, _ := unstructured.NestedSlice(vwc.Object, "webhooks")
+								return webhooks
+							}, And(
+								HaveLen(2),
+								ContainElement(HaveKeyWithValue("name", "kube.gloo."+namespace+".svc")),
+								ContainElement(HaveKeyWithValue("rules", ConsistOf(HaveKeyWithValue("resources", ConsistOf("secrets", "configmaps"))))),
+								ContainElement(HaveKeyWithValue("namespaceSelector", HaveKeyWithValue("matchExpressions", ConsistOf(And(
+									HaveKeyWithValue("key", "kubernetes.io/metadata.name"),
+									HaveKeyWithValue("operator", "In"),
+									HaveKeyWithValue("values", ConsistOf("gloo-system", "apps")),
+								))))),
+								ContainElement(HaveKeyWithValue("objectSelector", HaveKeyWithValue("matchLabels", HaveKeyWithValue("gloo", "validated")))),
+							)))
+					})
+
 					It("adds the validation port and mounts the certgen secret to the gateway deployment", func() {
 
 						gwDeployment := makeUnstructured(`
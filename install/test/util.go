@@ -36,7 +36,13 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gloo",
 		namespace,
 		[]string{""},
-		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
+		[]string{"pods", "services", "configmaps", "namespaces", "nodes", "secrets", "endpoints"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
 		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
@@ -99,7 +105,13 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.discovery",
 		namespace,
 		[]string{""},
-		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
+		[]string{"pods", "services", "configmaps", "namespaces", "nodes", "secrets", "endpoints"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
 		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
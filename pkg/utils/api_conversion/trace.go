@@ -1,10 +1,15 @@
 package api_conversion
 
 import (
+	udpa_type_v1 "github.com/cncf/xds/go/udpa/type/v1"
 	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	envoytrace_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/trace/v3"
 )
 
+// OpenTelemetryConfigTypeUrl is the type of the configuration of the OpenTelemetry tracer in envoy
+const OpenTelemetryConfigTypeUrl = "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig"
+
 // Converts between Envoy and Gloo/solokit versions of envoy protos
 // This is required because go-control-plane dropped gogoproto in favor of goproto
 // in v0.9.0, but solokit depends on gogoproto (and the generated deep equals it creates).
@@ -40,3 +45,36 @@ func ToEnvoyZipkinCollectorEndpointVersion(version envoytrace_gloo.ZipkinConfig_
 	}
 	return envoytrace.ZipkinConfig_HTTP_JSON
 }
+
+// ToEnvoyOpenTelemetryConfiguration returns the configuration of the envoy OpenTelemetry tracer as a TypedStruct,
+// since our version of go-control-plane does not include its proto. Envoy converts it to the typed configuration.
+func ToEnvoyOpenTelemetryConfiguration(glooOpenTelemetryConfig *envoytrace_gloo.OpenTelemetryConfig, clusterName string) (*udpa_type_v1.TypedStruct, error) {
+	fields := map[string]*structpb.Value{
+		"grpc_service": {
+			Kind: &structpb.Value_StructValue{
+				StructValue: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"envoy_grpc": {
+							Kind: &structpb.Value_StructValue{
+								StructValue: &structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"cluster_name": {Kind: &structpb.Value_StringValue{StringValue: clusterName}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if serviceName := glooOpenTelemetryConfig.GetServiceName(); serviceName != "" {
+		fields["service_name"] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: serviceName}}
+	}
+	return &udpa_type_v1.TypedStruct{
+		TypeUrl: OpenTelemetryConfigTypeUrl,
+		Value: &structpb.Struct{
+			Fields: fields,
+		},
+	}, nil
+}
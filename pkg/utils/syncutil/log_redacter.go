@@ -62,6 +62,8 @@ func StringifySnapshot(snapshot interface{}) string {
 type ProtoRedactor interface {
 	// Build a JSON string representation of the proto message, zeroing-out all fields in the proto that match some criteria
 	BuildRedactedJsonString(message proto.Message) (string, error)
+	// Return a copy of the proto message, zeroing-out all fields in the proto that match some criteria
+	Redact(message proto.Message) proto.Message
 }
 
 // build a ProtoRedactor that zeroes out fields that have the given struct tag set to the given value
@@ -72,11 +74,15 @@ func NewProtoRedactor() ProtoRedactor {
 type protoRedactor struct{}
 
 func (p *protoRedactor) BuildRedactedJsonString(message proto.Message) (string, error) {
+	bytes, err := json.Marshal(p.Redact(message))
+	return string(bytes), err
+}
+
+func (p *protoRedactor) Redact(message proto.Message) proto.Message {
 	// make a clone so that we can mutate it and zero-out fields
 	clone := proto.Clone(message)
 
 	redaction.Redact(proto.MessageReflect(clone))
 
-	bytes, err := json.Marshal(clone)
-	return string(bytes), err
+	return clone
 }
@@ -157,4 +157,22 @@ var _ = Describe("Log Redacter", func() {
 		}},
 	}),
 	)
+
+	It("redacts a copy of the message", func() {
+		settings := &v1.Settings{
+			Consul: &v1.Settings_ConsulConfiguration{
+				Address:  "consul:8500",
+				Password: "consul-password",
+			},
+			SecretSource: &v1.Settings_VaultSecretSource{
+				VaultSecretSource: &v1.Settings_VaultSecrets{Token: "vault-token"},
+			},
+		}
+
+		redacted := syncutil.NewProtoRedactor().Redact(settings).(*v1.Settings)
+		Expect(redacted.GetConsul().GetAddress()).To(Equal("consul:8500"))
+		Expect(redacted.GetConsul().GetPassword()).To(BeEmpty())
+		Expect(redacted.GetVaultSecretSource().GetToken()).To(BeEmpty())
+		Expect(settings.GetConsul().GetPassword()).To(Equal("consul-password"))
+	})
 })
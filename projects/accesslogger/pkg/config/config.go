@@ -0,0 +1,344 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"sigs.k8s.io/yaml"
+)
+
+type SinkType string
+
+const (
+	StdoutSink  SinkType = "stdout"
+	FileSink    SinkType = "file"
+	OtlpSink    SinkType = "otlp"
+	WebhookSink SinkType = "webhook"
+)
+
+var (
+	ReadConfigErr = func(err error, path string) error {
+		return eris.Wrapf(err, "reading access logger config from %v", path)
+	}
+	UnknownSinkTypeErr = func(index int, sinkType SinkType) error {
+		return eris.Errorf("sink %v has unknown type %q, must be one of %v, %v, %v or %v", index, sinkType, StdoutSink, FileSink, OtlpSink, WebhookSink)
+	}
+	MissingSinkOptionErr = func(index int, sinkType SinkType, option string) error {
+		return eris.Errorf("sink %v of type %v must set %v", index, sinkType, option)
+	}
+	InvalidSampleRateErr = func(index int, rate float64) error {
+		return eris.Errorf("sink %v has sample rate %v, which must be between 0 and 1", index, rate)
+	}
+	InvalidResponseCodeErr = func(index int, code string) error {
+		return eris.Errorf("sink %v filters on response code %q, which must be a status code such as 404 or a class such as 5xx", index, code)
+	}
+	InvalidDynamicMetadataErr = func(value string) error {
+		return eris.Errorf("dynamic metadata %q must be a key, or a filter name and key separated by a colon", value)
+	}
+	NoSinksErr               = eris.New("at least one sink must be configured")
+	InvalidMaxRoutesErr      = eris.New("routeMetrics.maxRoutes must be at least 1, or routeMetrics.disabled must be set")
+	InvalidWindowErr         = eris.New("routeMetrics.window must not be negative")
+	InvalidLatencyBucketsErr = eris.New("routeMetrics.latencyBucketsMs must be positive and in increasing order")
+)
+
+// Config selects the fields of each access log entry, and the sinks which the entries are written to.
+type Config struct {
+	// the fields written for each http request, from those in entry.HttpFields
+	HttpFields []string `json:"httpFields,omitempty"`
+	// the fields written for each tcp connection, from those in entry.TcpFields
+	TcpFields []string `json:"tcpFields,omitempty"`
+	// values from the dynamic metadata of each request or connection to write as fields
+	DynamicMetadata []*DynamicMetadataField `json:"dynamicMetadata,omitempty"`
+	// claims from the jwt of each http request to write as fields
+	JwtClaims []*JwtClaimField `json:"jwtClaims,omitempty"`
+	Sinks     []*Sink          `json:"sinks,omitempty"`
+	// metrics recorded for each route and virtual host
+	RouteMetrics RouteMetrics `json:"routeMetrics,omitempty"`
+}
+
+type DynamicMetadataField struct {
+	// the name of the field, defaults to the key
+	Name string `json:"name,omitempty"`
+	// the filter which set the metadata, defaults to the transformation filter
+	Filter string `json:"filter,omitempty"`
+	Key    string `json:"key"`
+}
+
+type JwtClaimField struct {
+	// the name of the field, defaults to the claim
+	Name  string `json:"name,omitempty"`
+	Claim string `json:"claim"`
+}
+
+type Sink struct {
+	Type SinkType `json:"type"`
+	// the fraction of entries written to the sink, between 0 and 1. defaults to 1
+	SampleRate *float64 `json:"sampleRate,omitempty"`
+	Filter     *Filter  `json:"filter,omitempty"`
+
+	File    *FileOptions    `json:"file,omitempty"`
+	Otlp    *OtlpOptions    `json:"otlp,omitempty"`
+	Webhook *WebhookOptions `json:"webhook,omitempty"`
+}
+
+// Filter restricts the entries written to a sink. Entries must match every field which is set.
+type Filter struct {
+	// status codes such as 404, or classes such as 5xx. tcp entries never match
+	ResponseCodes []string `json:"responseCodes,omitempty"`
+	// the names of upstream clusters
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+type FileOptions struct {
+	Path string `json:"path"`
+	// the size at which the file is rotated, defaults to 100
+	MaxSizeMb int `json:"maxSizeMb,omitempty"`
+	// the number of rotated files kept, defaults to 3
+	MaxBackups int `json:"maxBackups,omitempty"`
+}
+
+type OtlpOptions struct {
+	// the address of an OTLP gRPC collector, such as otel-collector:4317
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure,omitempty"`
+	// attributes added to the resource of every exported log
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+	Batch              BatchOptions      `json:"batch,omitempty"`
+}
+
+type WebhookOptions struct {
+	// entries are sent to the url as a json array in a POST request
+	Url     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Timeout Duration          `json:"timeout,omitempty"`
+	Batch   BatchOptions      `json:"batch,omitempty"`
+}
+
+type BatchOptions struct {
+	// the most entries sent at once, defaults to 100
+	MaxSize int `json:"maxSize,omitempty"`
+	// the longest time an entry waits to be sent, defaults to 5s
+	FlushInterval Duration `json:"flushInterval,omitempty"`
+}
+
+// RouteMetrics configures the request, error and duration metrics which are recorded for each route and virtual host.
+// To cap the number of metrics, requests are aggregated over a window, after which only the routes with the most
+// requests in it have their own metrics, and the requests for the other routes are recorded against the route "other".
+type RouteMetrics struct {
+	Disabled bool `json:"disabled,omitempty"`
+	// the most routes with their own metrics in each window, defaults to 100
+	MaxRoutes int `json:"maxRoutes,omitempty"`
+	// how long requests are aggregated for before they are added to the metrics, defaults to 1m
+	Window Duration `json:"window,omitempty"`
+	// the upper bounds of the request duration histogram buckets, in milliseconds. defaults to buckets from 5ms to 10s
+	LatencyBucketsMs []float64 `json:"latencyBucketsMs,omitempty"`
+}
+
+// Duration is a time.Duration written as a string such as 5s
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+// Default is the config used when none is provided, which writes the fields which were always written to stdout
+func Default() *Config {
+	return &Config{
+		HttpFields: []string{
+			"protocol_version",
+			"request_path",
+			"request_original_path",
+			"request_method",
+			"request_headers",
+			"response_code",
+			"response_headers",
+			"response_trailers",
+			"cluster",
+			"upstream_remote_address",
+			"route_name",
+			"start_time",
+			"downstream_resp_time",
+			"upstream_resp_time",
+		},
+		TcpFields: []string{
+			"upstream_cluster",
+			"route_name",
+		},
+		// follow the guide here to create requests with the proper transformation to populate 'pod_name' in the access logs:
+		// https://docs.solo.io/gloo-edge/latest/guides/traffic_management/request_processing/transformations/enrich_access_logs/#update-virtual-service
+		DynamicMetadata: []*DynamicMetadataField{{
+			Filter: transformation.FilterName,
+			Key:    "pod_name",
+		}},
+		// follow the guide here to create requests with a jwt that has the 'iss' claim, to populate issuer in the access logs:
+		// https://docs.solo.io/gloo-edge/latest/guides/security/auth/jwt/access_control/#appendix---use-a-remote-json-web-key-set-jwks-server
+		JwtClaims: []*JwtClaimField{{
+			Name:  "issuer",
+			Claim: "iss",
+		}},
+		Sinks: []*Sink{{
+			Type: StdoutSink,
+		}},
+	}
+}
+
+// Load reads the config from the yaml or json file at path, starting from the default config, so that anything
+// which is not set in the file keeps its default.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path == "" {
+		return cfg, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ReadConfigErr(err, path)
+	}
+	if err := yaml.UnmarshalStrict(b, cfg); err != nil {
+		return nil, ReadConfigErr(err, path)
+	}
+	return cfg, nil
+}
+
+// ParseDynamicMetadata parses values such as pod_name, or io.solo.transformation:pod_name, as set in environment variables
+func ParseDynamicMetadata(values []string) ([]*DynamicMetadataField, error) {
+	var fields []*DynamicMetadataField
+	for _, value := range values {
+		field := &DynamicMetadataField{Key: value}
+		if i := strings.LastIndex(value, ":"); i >= 0 {
+			field.Filter = value[:i]
+			field.Key = value[i+1:]
+		}
+		if field.Key == "" {
+			return nil, InvalidDynamicMetadataErr(value)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// ParseJwtClaims parses claims as set in environment variables, each of which is written to a field with the same name
+func ParseJwtClaims(values []string) []*JwtClaimField {
+	var fields []*JwtClaimField
+	for _, value := range values {
+		fields = append(fields, &JwtClaimField{Claim: value})
+	}
+	return fields
+}
+
+// Validate checks that the sinks and route metrics are complete. The fields are checked when the entries are extracted.
+func (c *Config) Validate() error {
+	if err := c.RouteMetrics.validate(); err != nil {
+		return err
+	}
+	if len(c.Sinks) == 0 {
+		return NoSinksErr
+	}
+	for i, sink := range c.Sinks {
+		switch sink.Type {
+		case StdoutSink:
+		case FileSink:
+			if sink.File.GetPath() == "" {
+				return MissingSinkOptionErr(i, sink.Type, "file.path")
+			}
+		case OtlpSink:
+			if sink.Otlp == nil || sink.Otlp.Endpoint == "" {
+				return MissingSinkOptionErr(i, sink.Type, "otlp.endpoint")
+			}
+		case WebhookSink:
+			if sink.Webhook == nil || sink.Webhook.Url == "" {
+				return MissingSinkOptionErr(i, sink.Type, "webhook.url")
+			}
+		default:
+			return UnknownSinkTypeErr(i, sink.Type)
+		}
+		if rate := sink.GetSampleRate(); rate < 0 || rate > 1 {
+			return InvalidSampleRateErr(i, rate)
+		}
+		for _, code := range sink.Filter.GetResponseCodes() {
+			if _, _, err := ParseResponseCode(code); err != nil {
+				return InvalidResponseCodeErr(i, code)
+			}
+		}
+	}
+	return nil
+}
+
+func (m RouteMetrics) validate() error {
+	if m.MaxRoutes < 0 {
+		return InvalidMaxRoutesErr
+	}
+	if m.Window.Duration < 0 {
+		return InvalidWindowErr
+	}
+	for i, bucket := range m.LatencyBucketsMs {
+		if bucket <= 0 || (i > 0 && bucket <= m.LatencyBucketsMs[i-1]) {
+			return InvalidLatencyBucketsErr
+		}
+	}
+	return nil
+}
+
+// ParseResponseCode returns the range of status codes, inclusive, matched by a status code such as 404 or a class such as 5xx
+func ParseResponseCode(code string) (uint32, uint32, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if len(code) == 3 && strings.HasSuffix(code, "xx") {
+		class, err := strconv.ParseUint(code[:1], 10, 32)
+		if err != nil || class < 1 || class > 5 {
+			return 0, 0, eris.Errorf("invalid response code class %v", code)
+		}
+		return uint32(class * 100), uint32(class*100 + 99), nil
+	}
+	status, err := strconv.ParseUint(code, 10, 32)
+	if err != nil || status < 100 || status > 599 {
+		return 0, 0, eris.Errorf("invalid response code %v", code)
+	}
+	return uint32(status), uint32(status), nil
+}
+
+func (s *Sink) GetSampleRate() float64 {
+	if s.SampleRate == nil {
+		return 1
+	}
+	return *s.SampleRate
+}
+
+func (f *Filter) GetResponseCodes() []string {
+	if f == nil {
+		return nil
+	}
+	return f.ResponseCodes
+}
+
+func (f *Filter) GetClusters() []string {
+	if f == nil {
+		return nil
+	}
+	return f.Clusters
+}
+
+func (o *FileOptions) GetPath() string {
+	if o == nil {
+		return ""
+	}
+	return o.Path
+}
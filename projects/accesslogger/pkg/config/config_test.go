@@ -0,0 +1,136 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+)
+
+var _ = Describe("Config", func() {
+
+	Context("Load", func() {
+
+		var (
+			dir string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "accesslogger-config")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		writeConfig := func(contents string) string {
+			path := filepath.Join(dir, "config.yaml")
+			Expect(os.WriteFile(path, []byte(contents), 0644)).NotTo(HaveOccurred())
+			return path
+		}
+
+		It("uses the default config when there is no file", func() {
+			cfg, err := config.Load("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(Equal(config.Default()))
+		})
+
+		It("keeps the defaults which are not set in the file", func() {
+			cfg, err := config.Load(writeConfig(`
+httpFields: [request_path, response_code]
+sinks:
+- type: webhook
+  sampleRate: 0.5
+  filter:
+    responseCodes: [5xx]
+  webhook:
+    url: http://example.com/logs
+    batch:
+      maxSize: 10
+      flushInterval: 2s
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.HttpFields).To(Equal([]string{"request_path", "response_code"}))
+			Expect(cfg.TcpFields).To(Equal(config.Default().TcpFields))
+			Expect(cfg.JwtClaims).To(Equal(config.Default().JwtClaims))
+			Expect(cfg.Sinks).To(HaveLen(1))
+			Expect(cfg.Sinks[0].GetSampleRate()).To(Equal(0.5))
+			Expect(cfg.Sinks[0].Webhook.Batch.FlushInterval.Duration).To(Equal(2 * time.Second))
+			Expect(cfg.Validate()).NotTo(HaveOccurred())
+		})
+
+		It("errors on unknown options", func() {
+			_, err := config.Load(writeConfig(`httpField: [request_path]`))
+			Expect(err).To(MatchError(ContainSubstring("reading access logger config from")))
+		})
+	})
+
+	DescribeTable("Validate",
+		func(sink *config.Sink, expectedErr string) {
+			cfg := &config.Config{Sinks: []*config.Sink{sink}}
+			Expect(cfg.Validate()).To(MatchError(expectedErr))
+		},
+		Entry("unknown type", &config.Sink{Type: "kafka"},
+			`sink 0 has unknown type "kafka", must be one of stdout, file, otlp or webhook`),
+		Entry("file without a path", &config.Sink{Type: config.FileSink},
+			"sink 0 of type file must set file.path"),
+		Entry("otlp without an endpoint", &config.Sink{Type: config.OtlpSink, Otlp: &config.OtlpOptions{}},
+			"sink 0 of type otlp must set otlp.endpoint"),
+		Entry("webhook without a url", &config.Sink{Type: config.WebhookSink},
+			"sink 0 of type webhook must set webhook.url"),
+		Entry("sample rate above 1", &config.Sink{Type: config.StdoutSink, SampleRate: pointerToFloat(1.5)},
+			"sink 0 has sample rate 1.5, which must be between 0 and 1"),
+		Entry("invalid response code", &config.Sink{Type: config.StdoutSink, Filter: &config.Filter{ResponseCodes: []string{"6xx"}}},
+			`sink 0 filters on response code "6xx", which must be a status code such as 404 or a class such as 5xx`),
+	)
+
+	It("requires the route metrics latency buckets to be increasing", func() {
+		cfg := config.Default()
+		cfg.RouteMetrics.LatencyBucketsMs = []float64{10, 5}
+		Expect(cfg.Validate()).To(MatchError(config.InvalidLatencyBucketsErr))
+	})
+
+	It("requires the route metrics window to not be negative", func() {
+		cfg := config.Default()
+		cfg.RouteMetrics.Window = config.Duration{Duration: -time.Minute}
+		Expect(cfg.Validate()).To(MatchError(config.InvalidWindowErr))
+	})
+
+	It("requires a sink", func() {
+		Expect((&config.Config{}).Validate()).To(MatchError(config.NoSinksErr))
+	})
+
+	DescribeTable("ParseResponseCode",
+		func(code string, min, max uint32) {
+			actualMin, actualMax, err := config.ParseResponseCode(code)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualMin).To(Equal(min))
+			Expect(actualMax).To(Equal(max))
+		},
+		Entry("status code", "404", uint32(404), uint32(404)),
+		Entry("class", "5xx", uint32(500), uint32(599)),
+		Entry("upper case class", "2XX", uint32(200), uint32(299)),
+	)
+
+	It("parses dynamic metadata from the environment", func() {
+		fields, err := config.ParseDynamicMetadata([]string{"pod_name", "envoy.filters.http.ext_authz:user"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fields).To(Equal([]*config.DynamicMetadataField{
+			{Key: "pod_name"},
+			{Filter: "envoy.filters.http.ext_authz", Key: "user"},
+		}))
+
+		_, err = config.ParseDynamicMetadata([]string{"envoy.filters.http.ext_authz:"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func pointerToFloat(f float64) *float64 {
+	return &f
+}
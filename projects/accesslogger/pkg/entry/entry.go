@@ -0,0 +1,375 @@
+package entry
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+)
+
+const jwtFilterName = "envoy.filters.http.jwt_authn"
+
+type Type string
+
+const (
+	Http Type = "http"
+	Tcp  Type = "tcp"
+)
+
+var (
+	UnknownFieldErr = func(entryType Type, name string, known []string) error {
+		return eris.Errorf("unknown %v field %v, must be one of %v", entryType, name, known)
+	}
+	DuplicateFieldErr = func(name string) error {
+		return eris.Errorf("field %v is written more than once", name)
+	}
+)
+
+// Entry is an access log entry with the configured fields, ready to be written to a sink.
+type Entry struct {
+	Type Type
+	// the node and log name of the envoy which sent the entry
+	NodeId  string
+	LogName string
+	// used to filter the entries written to each sink. the response code is 0 for tcp entries
+	ResponseCode uint32
+	Cluster      string
+	StartTime    time.Time
+	Fields       []Field
+}
+
+// Identifier identifies the envoy which sent a stream of entries
+type Identifier struct {
+	NodeId  string
+	LogName string
+}
+
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+// FieldMap returns the fields of the entry, including the node and log name, keyed by name
+func (e *Entry) FieldMap() map[string]interface{} {
+	fields := make(map[string]interface{}, len(e.Fields)+2)
+	fields["node_id"] = e.NodeId
+	fields["logger_name"] = e.LogName
+	for _, field := range e.Fields {
+		fields[field.Name] = field.Value
+	}
+	return fields
+}
+
+// HttpFields are the fields which can be written for each http request
+var HttpFields = map[string]func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{}{
+	"protocol_version": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetProtocolVersion().String()
+	},
+	"request_path": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetPath()
+	},
+	"request_original_path": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetOriginalPath()
+	},
+	"request_method": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetRequestMethod().String()
+	},
+	"request_headers": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetRequestHeaders()
+	},
+	"request_id": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetRequestId()
+	},
+	"authority": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetAuthority()
+	},
+	"user_agent": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetUserAgent()
+	},
+	"forwarded_for": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetRequest().GetForwardedFor()
+	},
+	"response_code": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetResponse().GetResponseCode().GetValue()
+	},
+	"response_code_details": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetResponse().GetResponseCodeDetails()
+	},
+	"response_headers": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetResponse().GetResponseHeaders()
+	},
+	"response_trailers": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetResponse().GetResponseTrailers()
+	},
+	"response_body_bytes": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetResponse().GetResponseBodyBytes()
+	},
+	"cluster": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return entry.GetCommonProperties().GetUpstreamCluster()
+	},
+	"upstream_remote_address": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return addressString(entry.GetCommonProperties().GetUpstreamRemoteAddress())
+	},
+	"downstream_remote_address": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return addressString(entry.GetCommonProperties().GetDownstreamRemoteAddress())
+	},
+	"route_name": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		// empty by default, but name can be set on routes in virtual services or route tables
+		return entry.GetCommonProperties().GetRouteName()
+	},
+	"start_time": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return timeString(entry.GetCommonProperties())
+	},
+	"downstream_resp_time": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return DownstreamRespTimeNs(entry)
+	},
+	"upstream_resp_time": func(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) interface{} {
+		return UpstreamRespTimeNs(entry)
+	},
+}
+
+// TcpFields are the fields which can be written for each tcp connection
+var TcpFields = map[string]func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{}{
+	"upstream_cluster": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return entry.GetCommonProperties().GetUpstreamCluster()
+	},
+	"upstream_remote_address": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return addressString(entry.GetCommonProperties().GetUpstreamRemoteAddress())
+	},
+	"downstream_remote_address": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return addressString(entry.GetCommonProperties().GetDownstreamRemoteAddress())
+	},
+	"route_name": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return entry.GetCommonProperties().GetRouteName()
+	},
+	"start_time": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return timeString(entry.GetCommonProperties())
+	},
+	"received_bytes": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return entry.GetConnectionProperties().GetReceivedBytes()
+	},
+	"sent_bytes": func(entry *envoy_data_accesslog_v3.TCPAccessLogEntry) interface{} {
+		return entry.GetConnectionProperties().GetSentBytes()
+	},
+}
+
+// Extractor creates entries with the fields selected by the config.
+type Extractor struct {
+	httpFields      []string
+	tcpFields       []string
+	dynamicMetadata []*config.DynamicMetadataField
+	jwtClaims       []*config.JwtClaimField
+}
+
+func NewExtractor(cfg *config.Config) (*Extractor, error) {
+	httpNames := map[string]bool{}
+	for _, name := range cfg.HttpFields {
+		if _, ok := HttpFields[name]; !ok {
+			return nil, UnknownFieldErr(Http, name, httpFieldNames())
+		}
+		if httpNames[name] {
+			return nil, DuplicateFieldErr(name)
+		}
+		httpNames[name] = true
+	}
+	tcpNames := map[string]bool{}
+	for _, name := range cfg.TcpFields {
+		if _, ok := TcpFields[name]; !ok {
+			return nil, UnknownFieldErr(Tcp, name, tcpFieldNames())
+		}
+		if tcpNames[name] {
+			return nil, DuplicateFieldErr(name)
+		}
+		tcpNames[name] = true
+	}
+	// the dynamic metadata and jwt claims are written as fields alongside both kinds of field
+	addName := func(name string) error {
+		if httpNames[name] || tcpNames[name] {
+			return DuplicateFieldErr(name)
+		}
+		httpNames[name] = true
+		tcpNames[name] = true
+		return nil
+	}
+
+	var dynamicMetadata []*config.DynamicMetadataField
+	for _, field := range cfg.DynamicMetadata {
+		field := *field
+		if field.Name == "" {
+			field.Name = field.Key
+		}
+		if field.Filter == "" {
+			field.Filter = transformation.FilterName
+		}
+		if err := addName(field.Name); err != nil {
+			return nil, err
+		}
+		dynamicMetadata = append(dynamicMetadata, &field)
+	}
+	var jwtClaims []*config.JwtClaimField
+	for _, field := range cfg.JwtClaims {
+		field := *field
+		if field.Name == "" {
+			field.Name = field.Claim
+		}
+		if err := addName(field.Name); err != nil {
+			return nil, err
+		}
+		jwtClaims = append(jwtClaims, &field)
+	}
+
+	return &Extractor{
+		httpFields:      cfg.HttpFields,
+		tcpFields:       cfg.TcpFields,
+		dynamicMetadata: dynamicMetadata,
+		jwtClaims:       jwtClaims,
+	}, nil
+}
+
+func (e *Extractor) HttpEntry(identifier *Identifier, logEntry *envoy_data_accesslog_v3.HTTPAccessLogEntry) *Entry {
+	entry := e.newEntry(Http, identifier, logEntry.GetCommonProperties())
+	entry.ResponseCode = logEntry.GetResponse().GetResponseCode().GetValue()
+	for _, name := range e.httpFields {
+		entry.Fields = append(entry.Fields, Field{Name: name, Value: HttpFields[name](logEntry)})
+	}
+
+	filterMetadata := logEntry.GetCommonProperties().GetMetadata().GetFilterMetadata()
+	e.addDynamicMetadata(entry, filterMetadata)
+	for _, field := range e.jwtClaims {
+		entry.Fields = append(entry.Fields, Field{Name: field.Name, Value: getClaimFromJwtInDynamicMetadata(field.Claim, filterMetadata)})
+	}
+	return entry
+}
+
+func (e *Extractor) TcpEntry(identifier *Identifier, logEntry *envoy_data_accesslog_v3.TCPAccessLogEntry) *Entry {
+	entry := e.newEntry(Tcp, identifier, logEntry.GetCommonProperties())
+	for _, name := range e.tcpFields {
+		entry.Fields = append(entry.Fields, Field{Name: name, Value: TcpFields[name](logEntry)})
+	}
+	e.addDynamicMetadata(entry, logEntry.GetCommonProperties().GetMetadata().GetFilterMetadata())
+	return entry
+}
+
+func (e *Extractor) newEntry(entryType Type, identifier *Identifier, common *envoy_data_accesslog_v3.AccessLogCommon) *Entry {
+	entry := &Entry{
+		Type:    entryType,
+		Cluster: common.GetUpstreamCluster(),
+	}
+	if identifier != nil {
+		entry.NodeId = identifier.NodeId
+		entry.LogName = identifier.LogName
+	}
+	if common.GetStartTime() != nil {
+		entry.StartTime = common.GetStartTime().AsTime()
+	}
+	return entry
+}
+
+// we could put any other kind of data into the dynamic metadata, including more detailed request info or info that gets
+// dropped once translated into envoy config. For example, virtual service name, virtual service namespace,
+// virtual service base path, virtual service route (operation path), the request/response body, etc.
+//
+// transformations can live at the virtual host, route, and weighted destination level on the `Proxy`, so users can
+// add very granular information to the transformation filter metadata by configuring transformations on
+// VirtualServices, RouteTables, and/or UpstreamGroups.
+func (e *Extractor) addDynamicMetadata(entry *Entry, filterMetadata map[string]*_struct.Struct) {
+	for _, field := range e.dynamicMetadata {
+		entry.Fields = append(entry.Fields, Field{Name: field.Name, Value: getValueFromDynamicMetadata(field.Filter, field.Key, filterMetadata)})
+	}
+}
+
+func getValueFromDynamicMetadata(filter, key string, filterMetadata map[string]*_struct.Struct) interface{} {
+	if val, ok := filterMetadata[filter].GetFields()[key]; ok {
+		return val.AsInterface()
+	}
+	return ""
+}
+
+func getClaimFromJwtInDynamicMetadata(claim string, filterMetadata map[string]*_struct.Struct) interface{} {
+	providerByJwt := filterMetadata[jwtFilterName]
+	jwts := providerByJwt.GetFields()
+	for _, jwt := range jwts {
+		claims := jwt.GetStructValue()
+		if claims != nil {
+			claimsMap := claims.GetFields()
+			if val, ok := claimsMap[claim]; ok {
+				return val.AsInterface()
+			}
+		}
+	}
+	return ""
+}
+
+func addressString(address *envoy_config_core_v3.Address) string {
+	switch {
+	case address.GetSocketAddress() != nil:
+		return fmt.Sprintf("%v:%v", address.GetSocketAddress().GetAddress(), address.GetSocketAddress().GetPortValue())
+	case address.GetPipe() != nil:
+		return address.GetPipe().GetPath()
+	}
+	return ""
+}
+
+func timeString(common *envoy_data_accesslog_v3.AccessLogCommon) string {
+	if common.GetStartTime() == nil {
+		return ""
+	}
+	return common.GetStartTime().AsTime().UTC().Format(time.RFC3339Nano)
+}
+
+func httpFieldNames() []string {
+	var names []string
+	for name := range HttpFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func tcpFieldNames() []string {
+	var names []string
+	for name := range TcpFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DownstreamRespTimeNs includes the time filters take during the processing of the request and response.
+func DownstreamRespTimeNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	return entry.GetCommonProperties().GetTimeToLastDownstreamTxByte().AsDuration().Nanoseconds()
+}
+
+// UpstreamRespTimeNs is the upstream response time when envoy is buffering the request before sending it upstream.
+func UpstreamRespTimeNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	// if envoy is buffering the request before sending upstream, you want the following
+	return lastToFirstNs(entry)
+	// otherwise, you want this
+	// return firstToFirstNs(entry)
+}
+
+func firstToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	timeToFirstUpstreamRxByteNs := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte().AsDuration().Nanoseconds()
+	timeToFirstUpstreamTxByteNs := entry.GetCommonProperties().GetTimeToFirstUpstreamTxByte().AsDuration().Nanoseconds()
+
+	// this excludes the time filters take during the processing of the request and response.
+	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToFirstUpstreamTxByteNs
+	return upstreamRespTimeNs
+}
+
+func lastToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	timeToFirstUpstreamRxByteNs := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte().AsDuration().Nanoseconds()
+	timeToLastUpstreamTxByteNs := entry.GetCommonProperties().GetTimeToLastUpstreamTxByte().AsDuration().Nanoseconds()
+
+	// this excludes the time filters take during the processing of the request and response.
+	// this could, in theory, be negative. for example, the upstream could reject based on the
+	// request headers and respond before the request body had finished transmitting upstream.
+	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToLastUpstreamTxByteNs
+	return upstreamRespTimeNs
+}
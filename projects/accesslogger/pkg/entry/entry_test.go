@@ -0,0 +1,150 @@
+package entry_test
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("Extractor", func() {
+
+	var (
+		identifier = &entry.Identifier{NodeId: "gateway-proxy-1.gloo-system", LogName: "example"}
+		httpEntry  *envoy_data_accesslog_v3.HTTPAccessLogEntry
+	)
+
+	BeforeEach(func() {
+		jwtClaims, err := structpb.NewStruct(map[string]interface{}{
+			"provider": map[string]interface{}{"iss": "solo.io", "sub": "user"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		httpEntry = &envoy_data_accesslog_v3.HTTPAccessLogEntry{
+			CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{
+				UpstreamCluster: "petstore_gloo-system",
+				UpstreamRemoteAddress: &envoy_config_core_v3.Address{
+					Address: &envoy_config_core_v3.Address_SocketAddress{SocketAddress: &envoy_config_core_v3.SocketAddress{
+						Address:       "10.0.0.1",
+						PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: 8080},
+					}},
+				},
+				Metadata: &envoy_config_core_v3.Metadata{FilterMetadata: map[string]*_struct.Struct{
+					"io.solo.transformation": {Fields: map[string]*_struct.Value{
+						"pod_name": structpb.NewStringValue("petstore-1"),
+					}},
+					"envoy.filters.http.ext_authz": {Fields: map[string]*_struct.Value{
+						"user": structpb.NewStringValue("alice"),
+					}},
+					"envoy.filters.http.jwt_authn": jwtClaims,
+				}},
+			},
+			Request: &envoy_data_accesslog_v3.HTTPRequestProperties{
+				Path:          "/api/pets",
+				RequestMethod: envoy_config_core_v3.RequestMethod_GET,
+			},
+			Response: &envoy_data_accesslog_v3.HTTPResponseProperties{
+				ResponseCode: &wrappers.UInt32Value{Value: 503},
+			},
+		}
+	})
+
+	fieldValue := func(e *entry.Entry, name string) interface{} {
+		for _, field := range e.Fields {
+			if field.Name == name {
+				return field.Value
+			}
+		}
+		Fail("no field " + name)
+		return nil
+	}
+
+	It("extracts the default fields", func() {
+		extractor, err := entry.NewExtractor(config.Default())
+		Expect(err).NotTo(HaveOccurred())
+
+		e := extractor.HttpEntry(identifier, httpEntry)
+		Expect(e.Type).To(Equal(entry.Http))
+		Expect(e.NodeId).To(Equal("gateway-proxy-1.gloo-system"))
+		Expect(e.ResponseCode).To(Equal(uint32(503)))
+		Expect(e.Cluster).To(Equal("petstore_gloo-system"))
+		Expect(e.Fields).To(HaveLen(len(config.Default().HttpFields) + 2))
+		Expect(fieldValue(e, "request_path")).To(Equal("/api/pets"))
+		Expect(fieldValue(e, "request_method")).To(Equal("GET"))
+		Expect(fieldValue(e, "response_code")).To(Equal(uint32(503)))
+		Expect(fieldValue(e, "upstream_remote_address")).To(Equal("10.0.0.1:8080"))
+		Expect(fieldValue(e, "pod_name")).To(Equal("petstore-1"))
+		Expect(fieldValue(e, "issuer")).To(Equal("solo.io"))
+	})
+
+	It("extracts the configured fields, metadata and claims", func() {
+		extractor, err := entry.NewExtractor(&config.Config{
+			HttpFields: []string{"cluster"},
+			DynamicMetadata: []*config.DynamicMetadataField{
+				{Name: "authz_user", Filter: "envoy.filters.http.ext_authz", Key: "user"},
+				{Key: "missing"},
+			},
+			JwtClaims: []*config.JwtClaimField{{Claim: "sub"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		e := extractor.HttpEntry(identifier, httpEntry)
+		Expect(e.Fields).To(Equal([]entry.Field{
+			{Name: "cluster", Value: "petstore_gloo-system"},
+			{Name: "authz_user", Value: "alice"},
+			{Name: "missing", Value: ""},
+			{Name: "sub", Value: "user"},
+		}))
+		Expect(e.FieldMap()).To(HaveKeyWithValue("logger_name", "example"))
+	})
+
+	It("extracts tcp entries", func() {
+		extractor, err := entry.NewExtractor(&config.Config{
+			TcpFields: []string{"upstream_cluster", "sent_bytes"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		e := extractor.TcpEntry(identifier, &envoy_data_accesslog_v3.TCPAccessLogEntry{
+			CommonProperties:     &envoy_data_accesslog_v3.AccessLogCommon{UpstreamCluster: "db_gloo-system"},
+			ConnectionProperties: &envoy_data_accesslog_v3.ConnectionProperties{SentBytes: 42},
+		})
+		Expect(e.Type).To(Equal(entry.Tcp))
+		Expect(e.ResponseCode).To(BeZero())
+		Expect(e.Fields).To(Equal([]entry.Field{
+			{Name: "upstream_cluster", Value: "db_gloo-system"},
+			{Name: "sent_bytes", Value: uint64(42)},
+		}))
+	})
+
+	It("errors on unknown fields", func() {
+		_, err := entry.NewExtractor(&config.Config{HttpFields: []string{"request_body"}})
+		Expect(err).To(MatchError(ContainSubstring("unknown http field request_body, must be one of [authority")))
+	})
+
+	It("errors when fields have the same name", func() {
+		_, err := entry.NewExtractor(&config.Config{
+			HttpFields: []string{"cluster"},
+			JwtClaims:  []*config.JwtClaimField{{Name: "cluster", Claim: "iss"}},
+		})
+		Expect(err).To(MatchError("field cluster is written more than once"))
+	})
+
+	Context("response times", func() {
+
+		It("converts the durations of more than a second to nanoseconds", func() {
+			httpEntry.CommonProperties.TimeToLastDownstreamTxByte = durationpb.New(2*time.Second + 5*time.Millisecond)
+			httpEntry.CommonProperties.TimeToFirstUpstreamRxByte = durationpb.New(1500 * time.Millisecond)
+			httpEntry.CommonProperties.TimeToLastUpstreamTxByte = durationpb.New(250 * time.Millisecond)
+
+			Expect(entry.DownstreamRespTimeNs(httpEntry)).To(Equal((2*time.Second + 5*time.Millisecond).Nanoseconds()))
+			Expect(entry.UpstreamRespTimeNs(httpEntry)).To(Equal((1250 * time.Millisecond).Nanoseconds()))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package routemetrics
+
+import (
+	"sort"
+)
+
+// OtherRoute is the route, and virtual host, which requests are recorded against when their own route does not have its own metrics
+const OtherRoute = "other"
+
+type Route struct {
+	VirtualHost string
+	Name        string
+}
+
+// RouteLimiter caps the number of distinct routes and virtual hosts which have their own metrics. The routes with the
+// most requests in an aggregation window have their own metrics for that window. The requests to other routes are
+// recorded against the route "other" of their virtual host if one of its routes has its own metrics, and otherwise
+// against the route "other" of the virtual host "other". So there are at most twice the limit plus one distinct routes
+// in each window.
+type RouteLimiter struct {
+	maxRoutes int
+
+	routes       map[Route]bool
+	virtualHosts map[string]bool
+}
+
+func NewRouteLimiter(maxRoutes int) *RouteLimiter {
+	return &RouteLimiter{
+		maxRoutes:    maxRoutes,
+		routes:       map[Route]bool{},
+		virtualHosts: map[string]bool{},
+	}
+}
+
+// Update gives the routes with the most requests their own metrics, in place of the routes which had them before.
+// Ties are broken by the order of the virtual host and route names, so the same requests always choose the same routes.
+func (l *RouteLimiter) Update(requests map[Route]int64) {
+	routes := make([]Route, 0, len(requests))
+	for route := range requests {
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if requests[routes[i]] != requests[routes[j]] {
+			return requests[routes[i]] > requests[routes[j]]
+		}
+		if routes[i].VirtualHost != routes[j].VirtualHost {
+			return routes[i].VirtualHost < routes[j].VirtualHost
+		}
+		return routes[i].Name < routes[j].Name
+	})
+	if len(routes) > l.maxRoutes {
+		routes = routes[:l.maxRoutes]
+	}
+
+	l.routes = map[Route]bool{}
+	l.virtualHosts = map[string]bool{}
+	for _, route := range routes {
+		l.routes[route] = true
+		l.virtualHosts[route.VirtualHost] = true
+	}
+}
+
+// Limit returns the route to record a request to the route against: either the route itself, or an other route.
+func (l *RouteLimiter) Limit(route Route) Route {
+	if l.routes[route] {
+		return route
+	}
+	if l.virtualHosts[route.VirtualHost] {
+		return Route{VirtualHost: route.VirtualHost, Name: OtherRoute}
+	}
+	return Route{VirtualHost: OtherRoute, Name: OtherRoute}
+}
@@ -0,0 +1,263 @@
+package routemetrics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	"go.opencensus.io/metric/metricdata"
+)
+
+const (
+	defaultMaxRoutes = 100
+	defaultWindow    = time.Minute
+
+	routeRequestsName        = "gloo.solo.io/accesslogging/route_requests"
+	routeRequestDurationName = "gloo.solo.io/accesslogging/route_request_duration"
+)
+
+var (
+	// buckets around common latency objectives
+	DefaultLatencyBucketsMs = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+	routeRequestsDescriptor = metricdata.Descriptor{
+		Name:        routeRequestsName,
+		Description: "The number of requests to each route. Can be lossy.",
+		Unit:        metricdata.UnitDimensionless,
+		Type:        metricdata.TypeCumulativeInt64,
+		LabelKeys:   []metricdata.LabelKey{{Key: "virtual_host"}, {Key: "route"}, {Key: "response_code_class"}},
+	}
+	routeRequestDurationDescriptor = metricdata.Descriptor{
+		Name:        routeRequestDurationName,
+		Description: "The downstream request time (ms) of each route. Can be lossy.",
+		Unit:        metricdata.UnitMilliseconds,
+		Type:        metricdata.TypeCumulativeDistribution,
+		LabelKeys:   []metricdata.LabelKey{{Key: "virtual_host"}, {Key: "route"}},
+	}
+
+	// gloo names envoy routes <virtual host>-route-<index>[-<route name>]-matcher-<index>, followed by -mirror-<index>
+	// for the routes which mirror requests matching headers, and names virtual hosts <namespace>_<name> after the virtual
+	// service they are created from
+	routeNameRegex = regexp.MustCompile(`^(.+?)-route-\d+(-.+)?-matcher-\d+(-mirror-\d+)?$`)
+)
+
+// VirtualHostName returns the name of the virtual host from the name of a route generated by gloo
+func VirtualHostName(routeName string) string {
+	if match := routeNameRegex.FindStringSubmatch(routeName); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// Recorder records the rate, errors and duration of the requests to each route and virtual host, and exports them as a
+// metricproducer.Producer. Requests are counted by response code class, such as 2xx or 5xx.
+// The requests are aggregated in process for each window, and only added to the exported totals when the window ends,
+// once the routes with the most requests in it are known. Only those routes are exported, so unlike opencensus views,
+// which keep every series they have recorded, the number of series stays capped however many routes are seen over the
+// lifetime of the process.
+type Recorder struct {
+	now              func() time.Time
+	window           time.Duration
+	latencyBucketsMs []float64
+	limiter          *RouteLimiter
+
+	lock        sync.Mutex
+	windowStart time.Time
+	// the requests to each route in the current window
+	windowRequests map[Route]*routeRequests
+	// the exported totals of each route with its own metrics, and of the other routes
+	totals map[Route]*routeRequests
+}
+
+func NewRecorder(cfg config.RouteMetrics) *Recorder {
+	return newRecorder(cfg, time.Now)
+}
+
+func newRecorder(cfg config.RouteMetrics, now func() time.Time) *Recorder {
+	maxRoutes := cfg.MaxRoutes
+	if maxRoutes == 0 {
+		maxRoutes = defaultMaxRoutes
+	}
+	window := cfg.Window.Duration
+	if window == 0 {
+		window = defaultWindow
+	}
+	latencyBucketsMs := cfg.LatencyBucketsMs
+	if len(latencyBucketsMs) == 0 {
+		latencyBucketsMs = DefaultLatencyBucketsMs
+	}
+	return &Recorder{
+		now:              now,
+		window:           window,
+		latencyBucketsMs: latencyBucketsMs,
+		limiter:          NewRouteLimiter(maxRoutes),
+		windowStart:      now(),
+		windowRequests:   map[Route]*routeRequests{},
+		totals:           map[Route]*routeRequests{},
+	}
+}
+
+func (r *Recorder) Record(ctx context.Context, logEntry *envoy_data_accesslog_v3.HTTPAccessLogEntry) {
+	routeName := logEntry.GetCommonProperties().GetRouteName()
+	route := Route{
+		VirtualHost: VirtualHostName(routeName),
+		Name:        routeName,
+	}
+	responseCodeClass := responseCodeClass(logEntry.GetResponse().GetResponseCode().GetValue())
+	durationMs := float64(entry.DownstreamRespTimeNs(logEntry)) / float64(time.Millisecond)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+	r.endWindow(now)
+	requests, ok := r.windowRequests[route]
+	if !ok {
+		requests = newRouteRequests(r.windowStart, len(r.latencyBucketsMs))
+		r.windowRequests[route] = requests
+	}
+	requests.counts[responseCodeClass]++
+	requests.durationCount++
+	requests.durationSumMs += durationMs
+	// as in opencensus, each bucket counts the durations below its bound
+	bucket := sort.Search(len(r.latencyBucketsMs), func(i int) bool {
+		return durationMs < r.latencyBucketsMs[i]
+	})
+	requests.durationBuckets[bucket]++
+}
+
+// endWindow adds the requests of the current window to the totals once the window has passed. The routes with the most
+// requests in the window have their own totals, and the requests to the other routes are added to the totals of their
+// other route. The totals of routes without requests in the window are dropped, so they start again from zero if the
+// route has its own metrics again later.
+func (r *Recorder) endWindow(now time.Time) {
+	if now.Sub(r.windowStart) < r.window {
+		return
+	}
+
+	requestCounts := map[Route]int64{}
+	for route, requests := range r.windowRequests {
+		requestCounts[route] = requests.durationCount
+	}
+	r.limiter.Update(requestCounts)
+
+	totals := map[Route]*routeRequests{}
+	for route, requests := range r.windowRequests {
+		limited := r.limiter.Limit(route)
+		total, ok := totals[limited]
+		if !ok {
+			if total, ok = r.totals[limited]; !ok {
+				total = newRouteRequests(r.windowStart, len(r.latencyBucketsMs))
+			}
+			totals[limited] = total
+		}
+		total.add(requests)
+	}
+
+	r.totals = totals
+	r.windowRequests = map[Route]*routeRequests{}
+	r.windowStart = now
+}
+
+// Read implements metricproducer.Producer
+func (r *Recorder) Read() []*metricdata.Metric {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+	r.endWindow(now)
+
+	routes := make([]Route, 0, len(r.totals))
+	for route := range r.totals {
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].VirtualHost != routes[j].VirtualHost {
+			return routes[i].VirtualHost < routes[j].VirtualHost
+		}
+		return routes[i].Name < routes[j].Name
+	})
+
+	requestsMetric := &metricdata.Metric{Descriptor: routeRequestsDescriptor}
+	durationMetric := &metricdata.Metric{Descriptor: routeRequestDurationDescriptor}
+	for _, route := range routes {
+		total := r.totals[route]
+		responseCodeClasses := make([]string, 0, len(total.counts))
+		for responseCodeClass := range total.counts {
+			responseCodeClasses = append(responseCodeClasses, responseCodeClass)
+		}
+		sort.Strings(responseCodeClasses)
+		for _, responseCodeClass := range responseCodeClasses {
+			requestsMetric.TimeSeries = append(requestsMetric.TimeSeries, &metricdata.TimeSeries{
+				LabelValues: []metricdata.LabelValue{
+					metricdata.NewLabelValue(route.VirtualHost),
+					metricdata.NewLabelValue(route.Name),
+					metricdata.NewLabelValue(responseCodeClass),
+				},
+				Points:    []metricdata.Point{metricdata.NewInt64Point(now, total.counts[responseCodeClass])},
+				StartTime: total.start,
+			})
+		}
+
+		buckets := make([]metricdata.Bucket, len(total.durationBuckets))
+		for i, count := range total.durationBuckets {
+			buckets[i] = metricdata.Bucket{Count: count}
+		}
+		durationMetric.TimeSeries = append(durationMetric.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{
+				metricdata.NewLabelValue(route.VirtualHost),
+				metricdata.NewLabelValue(route.Name),
+			},
+			Points: []metricdata.Point{metricdata.NewDistributionPoint(now, &metricdata.Distribution{
+				Count:         total.durationCount,
+				Sum:           total.durationSumMs,
+				BucketOptions: &metricdata.BucketOptions{Bounds: r.latencyBucketsMs},
+				Buckets:       buckets,
+			})},
+			StartTime: total.start,
+		})
+	}
+	return []*metricdata.Metric{requestsMetric, durationMetric}
+}
+
+// routeRequests are the requests to a route since start, counted by response code class, and their durations.
+// The sum of the squared deviations of the durations is not tracked, as the histogram buckets are what is exported.
+type routeRequests struct {
+	start           time.Time
+	counts          map[string]int64
+	durationCount   int64
+	durationSumMs   float64
+	durationBuckets []int64
+}
+
+func newRouteRequests(start time.Time, latencyBuckets int) *routeRequests {
+	return &routeRequests{
+		start:  start,
+		counts: map[string]int64{},
+		// there is a last bucket for the durations above every bound
+		durationBuckets: make([]int64, latencyBuckets+1),
+	}
+}
+
+func (r *routeRequests) add(other *routeRequests) {
+	for responseCodeClass, count := range other.counts {
+		r.counts[responseCodeClass] += count
+	}
+	r.durationCount += other.durationCount
+	r.durationSumMs += other.durationSumMs
+	for i, count := range other.durationBuckets {
+		r.durationBuckets[i] += count
+	}
+}
+
+// envoy logs a response code of 0 when no response was sent, such as when the downstream disconnects
+func responseCodeClass(code uint32) string {
+	if code == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%dxx", code/100)
+}
@@ -0,0 +1,75 @@
+package routemetrics_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/routemetrics"
+)
+
+var _ = Describe("Route metrics", func() {
+
+	DescribeTable("VirtualHostName",
+		func(routeName, virtualHost string) {
+			Expect(routemetrics.VirtualHostName(routeName)).To(Equal(virtualHost))
+		},
+		Entry("unnamed route", "default_petstore-route-0-matcher-0", "default_petstore"),
+		Entry("named route", "default_petstore-route-2-get-pets-matcher-1", "default_petstore"),
+		Entry("mirror route", "default_petstore-route-2-get-pets-matcher-1-mirror-0", "default_petstore"),
+		Entry("route not generated by gloo", "my-route", ""),
+		Entry("no route", "", ""),
+	)
+
+	Context("RouteLimiter", func() {
+
+		var (
+			limiter *routemetrics.RouteLimiter
+		)
+
+		route := func(virtualHost, name string) routemetrics.Route {
+			return routemetrics.Route{VirtualHost: virtualHost, Name: name}
+		}
+
+		BeforeEach(func() {
+			limiter = routemetrics.NewRouteLimiter(2)
+		})
+
+		It("gives the routes with the most requests their own metrics", func() {
+			limiter.Update(map[routemetrics.Route]int64{
+				route("petstore", "a"):  1,
+				route("petstore", "b"):  5,
+				route("bookstore", "a"): 3,
+			})
+			Expect(limiter.Limit(route("petstore", "b"))).To(Equal(route("petstore", "b")))
+			Expect(limiter.Limit(route("bookstore", "a"))).To(Equal(route("bookstore", "a")))
+			Expect(limiter.Limit(route("petstore", "a"))).To(Equal(route("petstore", routemetrics.OtherRoute)))
+			Expect(limiter.Limit(route("toystore", "a"))).To(Equal(route(routemetrics.OtherRoute, routemetrics.OtherRoute)))
+		})
+
+		It("replaces the routes with their own metrics when updated", func() {
+			limiter.Update(map[routemetrics.Route]int64{route("petstore", "a"): 5, route("petstore", "b"): 3})
+			limiter.Update(map[routemetrics.Route]int64{route("petstore", "a"): 1, route("toystore", "a"): 2, route("toystore", "b"): 2})
+			Expect(limiter.Limit(route("petstore", "a"))).To(Equal(route(routemetrics.OtherRoute, routemetrics.OtherRoute)))
+			Expect(limiter.Limit(route("toystore", "a"))).To(Equal(route("toystore", "a")))
+			Expect(limiter.Limit(route("toystore", "b"))).To(Equal(route("toystore", "b")))
+		})
+
+		It("caps the routes however many there are", func() {
+			requests := map[routemetrics.Route]int64{}
+			for i := 0; i < 1000; i++ {
+				requests[route(fmt.Sprintf("vh-%d", i%10), fmt.Sprintf("route-%d", i))] = int64(i)
+			}
+			limiter.Update(requests)
+
+			routes := map[routemetrics.Route]bool{}
+			for r := range requests {
+				routes[limiter.Limit(r)] = true
+			}
+			Expect(len(routes)).To(BeNumerically("<=", 2*2+1))
+			Expect(routes).To(HaveKey(route("vh-9", "route-999")))
+			Expect(routes).To(HaveKey(route("vh-8", "route-998")))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package routemetrics
+
+import (
+	"context"
+	"time"
+
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"go.opencensus.io/metric/metricdata"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Recorder", func() {
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		recorder *Recorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		recorder = newRecorder(config.RouteMetrics{
+			MaxRoutes:        1,
+			Window:           config.Duration{Duration: time.Minute},
+			LatencyBucketsMs: []float64{10, 100},
+		}, func() time.Time { return now })
+	})
+
+	logEntry := func(routeName string, responseCode uint32, duration time.Duration) *envoy_data_accesslog_v3.HTTPAccessLogEntry {
+		return &envoy_data_accesslog_v3.HTTPAccessLogEntry{
+			CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{
+				RouteName:                  routeName,
+				TimeToLastDownstreamTxByte: durationpb.New(duration),
+			},
+			Response: &envoy_data_accesslog_v3.HTTPResponseProperties{
+				ResponseCode: &wrappers.UInt32Value{Value: responseCode},
+			},
+		}
+	}
+
+	requests := func(metrics []*metricdata.Metric) map[string]int64 {
+		Expect(metrics[0].Descriptor.Name).To(Equal(routeRequestsName))
+		requests := map[string]int64{}
+		for _, ts := range metrics[0].TimeSeries {
+			key := ts.LabelValues[0].Value + " " + ts.LabelValues[1].Value + " " + ts.LabelValues[2].Value
+			requests[key] = ts.Points[0].Value.(int64)
+		}
+		return requests
+	}
+
+	buckets := func(metrics []*metricdata.Metric) map[string][]int64 {
+		Expect(metrics[1].Descriptor.Name).To(Equal(routeRequestDurationName))
+		buckets := map[string][]int64{}
+		for _, ts := range metrics[1].TimeSeries {
+			var counts []int64
+			for _, bucket := range ts.Points[0].Value.(*metricdata.Distribution).Buckets {
+				counts = append(counts, bucket.Count)
+			}
+			buckets[ts.LabelValues[0].Value+" "+ts.LabelValues[1].Value] = counts
+		}
+		return buckets
+	}
+
+	It("exports the requests, errors and durations of the routes with the most requests once the window ends", func() {
+		recorder.Record(ctx, logEntry("default_petstore-route-0-matcher-0", 200, 500*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_petstore-route-1-matcher-0", 200, 5*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_petstore-route-1-matcher-0", 503, 50*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_toystore-route-0-matcher-0", 200, 5*time.Millisecond))
+		Expect(requests(recorder.Read())).To(BeEmpty())
+
+		now = now.Add(time.Minute)
+		metrics := recorder.Read()
+		Expect(requests(metrics)).To(Equal(map[string]int64{
+			"default_petstore default_petstore-route-1-matcher-0 2xx": 1,
+			"default_petstore default_petstore-route-1-matcher-0 5xx": 1,
+			"default_petstore other 2xx":                              1,
+			"other other 2xx":                                         1,
+		}))
+		Expect(buckets(metrics)).To(Equal(map[string][]int64{
+			"default_petstore default_petstore-route-1-matcher-0": {1, 1, 0},
+			"default_petstore other":                              {0, 0, 1},
+			"other other":                                         {1, 0, 0},
+		}))
+	})
+
+	It("accumulates the requests of the routes which keep their own metrics, and drops the others", func() {
+		recorder.Record(ctx, logEntry("default_petstore-route-0-matcher-0", 200, 5*time.Millisecond))
+		now = now.Add(time.Minute)
+		recorder.Record(ctx, logEntry("default_petstore-route-0-matcher-0", 200, 5*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_toystore-route-0-matcher-0", 200, 5*time.Millisecond))
+		now = now.Add(time.Minute)
+		Expect(requests(recorder.Read())).To(Equal(map[string]int64{
+			"default_petstore default_petstore-route-0-matcher-0 2xx": 2,
+			"other other 2xx": 1,
+		}))
+
+		recorder.Record(ctx, logEntry("default_toystore-route-0-matcher-0", 200, 5*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_toystore-route-0-matcher-0", 200, 5*time.Millisecond))
+		recorder.Record(ctx, logEntry("default_petstore-route-0-matcher-0", 200, 5*time.Millisecond))
+		now = now.Add(time.Minute)
+		Expect(requests(recorder.Read())).To(Equal(map[string]int64{
+			"default_toystore default_toystore-route-0-matcher-0 2xx": 2,
+			"other other 2xx": 2,
+		}))
+
+		By("dropping the routes without requests in the window")
+		now = now.Add(time.Minute)
+		Expect(requests(recorder.Read())).To(BeEmpty())
+	})
+})
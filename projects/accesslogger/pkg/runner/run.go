@@ -7,13 +7,15 @@ import (
 
 	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
-	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
 	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
-	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/routemetrics"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/healthchecker"
 	"github.com/solo-io/go-utils/stats"
+	"go.opencensus.io/metric/metricproducer"
 	"go.opencensus.io/plugin/ocgrpc"
 	ocstats "go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -77,101 +79,17 @@ func Run() {
 		stats.StartStatsServerWithPort(stats.StartupOptions{Port: clientSettings.DebugPort})
 	}
 
+	callback, err := NewAlsCallback(ctx, clientSettings)
+	if err != nil {
+		panic(err)
+	}
 	opts := loggingservice.Options{
-		Callbacks: loggingservice.AlsCallbackList{
-			func(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
-				logger := contextutils.LoggerFrom(ctx)
-				switch msg := message.GetLogEntries().(type) {
-				case *pb.StreamAccessLogsMessage_HttpLogs:
-					for _, v := range msg.HttpLogs.GetLogEntry() {
-
-						meta := v.GetCommonProperties().GetMetadata().GetFilterMetadata()
-						// we could put any other kind of data into the transformation metadata, including more
-						// detailed request info or info that gets dropped once translated into envoy config. For
-						// example, virtual service name, virtual service namespace, virtual service base path,
-						// virtual service route (operation path), the request/response body, etc.
-						//
-						// transformations can live at the virtual host, route, and weighted destination level on the
-						// `Proxy`, so users can add very granular information to the transformation filter metadata by
-						// configuring transformations on VirtualServices, RouteTables, and/or UpstreamGroups.
-						//
-						// follow the guide here to create requests with the proper transformation to populate 'pod_name' in the access logs:
-						// https://docs.solo.io/gloo-edge/latest/guides/traffic_management/request_processing/transformations/enrich_access_logs/#update-virtual-service
-						podName := getTransformationValueFromDynamicMetadata("pod_name", meta)
-
-						// we could change the claim to any other jwt claim, such as client_id
-						//
-						// follow the guide here to create requests with a jwt that has the 'iss' claim, to populate issuer in the access logs:
-						// https://docs.solo.io/gloo-edge/latest/guides/security/auth/jwt/access_control/#appendix---use-a-remote-json-web-key-set-jwks-server
-						issuer := getClaimFromJwtInDynamicMetadata("iss", meta)
-
-						utils.MeasureOne(
-							ctx,
-							mAccessLogsRequests,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						// this includes the time filters take during the processing of the request and response.
-						downstreamRespTime := v.GetCommonProperties().GetTimeToLastDownstreamTxByte()
-						downstreamRespTimeNs := int64(downstreamRespTime.GetNanos()) + (downstreamRespTime.GetSeconds()*1 ^ 9)
-
-						// if envoy is buffering the request before sending upstream, you want the following
-						upstreamRespTimeNs := lastToFirstNs(v)
-						// otherwise, you want this
-						// upstreamRespTimeNs := firstToFirstNs(v)
-
-						utils.Measure(
-							ctx,
-							mAccessLogsDownstreamRespTime,
-							downstreamRespTimeNs,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						utils.Measure(
-							ctx,
-							mAccessLogsUpstreamRespTime,
-							upstreamRespTimeNs,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						logger.With(
-							zap.Any("protocol_version", v.GetProtocolVersion()),
-							zap.Any("request_path", v.GetRequest().GetPath()),
-							zap.Any("request_original_path", v.GetRequest().GetOriginalPath()),
-							zap.Any("request_method", v.GetRequest().GetRequestMethod().String()),
-							zap.Any("request_headers", v.GetRequest().GetRequestHeaders()),
-							zap.Any("response_code", v.GetResponse().GetResponseCode().String()),
-							zap.Any("response_headers", v.GetResponse().GetResponseHeaders()),
-							zap.Any("response_trailers", v.GetResponse().GetResponseTrailers()),
-							zap.Any("cluster", v.GetCommonProperties().GetUpstreamCluster()),
-							zap.Any("upstream_remote_address", v.GetCommonProperties().GetUpstreamRemoteAddress()),
-							zap.Any("issuer", issuer),                                     // requires jwt set up and jwt with 'iss' claim to be non-empty
-							zap.Any("pod_name", podName),                                  // requires transformation set up with dynamic metadata (with 'pod_name' key) to be non-empty
-							zap.Any("route_name", v.GetCommonProperties().GetRouteName()), // empty by default, but name can be set on routes in virtual services or route tables
-							zap.Any("start_time", v.GetCommonProperties().GetStartTime()),
-							zap.Any("downstream_resp_time", downstreamRespTimeNs),
-							zap.Any("upstream_resp_time", upstreamRespTimeNs),
-						).Info("received http request")
-					}
-				case *pb.StreamAccessLogsMessage_TcpLogs:
-					for _, v := range msg.TcpLogs.GetLogEntry() {
-						logger.With(
-							zap.Any("upstream_cluster", v.GetCommonProperties().GetUpstreamCluster()),
-							zap.Any("route_name", v.GetCommonProperties().GetRouteName()),
-						).Info("received tcp request")
-					}
-				}
-				return nil
-			},
-		},
-		Ctx: ctx,
+		Callbacks: loggingservice.AlsCallbackList{callback},
+		Ctx:       ctx,
 	}
 	service := loggingservice.NewServer(opts)
 
-	err := RunWithSettings(ctx, service, clientSettings)
+	err = RunWithSettings(ctx, service, clientSettings)
 
 	if err != nil {
 		if ctx.Err() == nil {
@@ -181,6 +99,83 @@ func Run() {
 	}
 }
 
+// NewAlsCallback records metrics for each access log entry, including those for each route unless they are disabled,
+// and writes the fields selected by the settings to each of the configured sinks.
+func NewAlsCallback(ctx context.Context, clientSettings Settings) (loggingservice.AlsCallback, error) {
+	cfg, err := clientSettings.Config()
+	if err != nil {
+		return nil, err
+	}
+	extractor, err := entry.NewExtractor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	entrySinks, err := sinks.NewSinks(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	var routeMetrics *routemetrics.Recorder
+	if !cfg.RouteMetrics.Disabled {
+		routeMetrics = routemetrics.NewRecorder(cfg.RouteMetrics)
+		metricproducer.GlobalManager().AddProducer(routeMetrics)
+	}
+
+	write := func(ctx context.Context, e *entry.Entry) {
+		for _, sink := range entrySinks {
+			if err := sink.Write(ctx, e); err != nil {
+				contextutils.LoggerFrom(ctx).Warnw("failed to write access log entry", zap.Error(err))
+			}
+		}
+	}
+
+	return func(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
+		identifier := &entry.Identifier{
+			NodeId:  message.GetIdentifier().GetNode().GetId(),
+			LogName: message.GetIdentifier().GetLogName(),
+		}
+		switch msg := message.GetLogEntries().(type) {
+		case *pb.StreamAccessLogsMessage_HttpLogs:
+			for _, v := range msg.HttpLogs.GetLogEntry() {
+				recordMetrics(ctx, v)
+				if routeMetrics != nil {
+					routeMetrics.Record(ctx, v)
+				}
+				write(ctx, extractor.HttpEntry(identifier, v))
+			}
+		case *pb.StreamAccessLogsMessage_TcpLogs:
+			for _, v := range msg.TcpLogs.GetLogEntry() {
+				write(ctx, extractor.TcpEntry(identifier, v))
+			}
+		}
+		return nil
+	}, nil
+}
+
+func recordMetrics(ctx context.Context, v *envoy_data_accesslog_v3.HTTPAccessLogEntry) {
+	utils.MeasureOne(
+		ctx,
+		mAccessLogsRequests,
+		tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+		tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+		tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+
+	utils.Measure(
+		ctx,
+		mAccessLogsDownstreamRespTime,
+		entry.DownstreamRespTimeNs(v),
+		tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+		tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+		tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+
+	utils.Measure(
+		ctx,
+		mAccessLogsUpstreamRespTime,
+		entry.UpstreamRespTimeNs(v),
+		tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+		tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+		tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+}
+
 func RunWithSettings(ctx context.Context, service *loggingservice.Server, clientSettings Settings) error {
 	err := StartAccessLog(ctx, clientSettings, service)
 	if ctx.Err() != nil {
@@ -218,52 +213,3 @@ func StartAccessLog(ctx context.Context, clientSettings Settings, service *loggi
 
 	return srv.Serve(lis)
 }
-
-func getTransformationValueFromDynamicMetadata(key string, filterMetadata map[string]*_struct.Struct) string {
-	transformationMeta := filterMetadata[transformation.FilterName]
-	for tKey, tVal := range transformationMeta.GetFields() {
-		if tKey == key {
-			return tVal.GetStringValue()
-		}
-	}
-	return ""
-}
-
-func getClaimFromJwtInDynamicMetadata(claim string, filterMetadata map[string]*_struct.Struct) string {
-	providerByJwt := filterMetadata["envoy.filters.http.jwt_authn"]
-	jwts := providerByJwt.GetFields()
-	for _, jwt := range jwts {
-		claims := jwt.GetStructValue()
-		if claims != nil {
-			claimsMap := claims.GetFields()
-			if val, ok := claimsMap[claim]; ok {
-				return val.GetStringValue()
-			}
-		}
-	}
-	return ""
-}
-
-func firstToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
-	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
-	timeToFirstUpstreamRxByteNs := int64(timeToFirstUpstreamRxByte.GetNanos()) + (timeToFirstUpstreamRxByte.GetSeconds()*1 ^ 9)
-	timeToFirstUpstreamTxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamTxByte()
-	timeToFirstUpstreamTxByteNs := int64(timeToFirstUpstreamTxByte.GetNanos()) + (timeToFirstUpstreamTxByte.GetSeconds()*1 ^ 9)
-
-	// this excludes the time filters take during the processing of the request and response.
-	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToFirstUpstreamTxByteNs
-	return upstreamRespTimeNs
-}
-
-func lastToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
-	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
-	timeToFirstUpstreamRxByteNs := int64(timeToFirstUpstreamRxByte.GetNanos()) + (timeToFirstUpstreamRxByte.GetSeconds()*1 ^ 9)
-	timeToLastUpstreamTxByte := entry.GetCommonProperties().GetTimeToLastUpstreamTxByte()
-	timeToLastUpstreamTxByteNs := int64(timeToLastUpstreamTxByte.GetNanos()) + (timeToLastUpstreamTxByte.GetSeconds()*1 ^ 9)
-
-	// this excludes the time filters take during the processing of the request and response.
-	// this could, in theory, be negative. for example, the upstream could reject based on the
-	// request headers and respond before the request body had finished transmitting upstream.
-	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToLastUpstreamTxByteNs
-	return upstreamRespTimeNs
-}
@@ -2,12 +2,23 @@ package runner
 
 import (
 	"github.com/kelseyhightower/envconfig"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
 )
 
 type Settings struct {
 	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
 	ServerPort  int    `envconfig:"SERVER_PORT" default:"8083"`
 	ServiceName string `envconfig:"SERVICE_NAME" default:"AccessLog"`
+
+	// a yaml or json file with the fields to write and the sinks to write them to. when unset, the fields which were
+	// always written are written to stdout
+	ConfigFile string `envconfig:"CONFIG_FILE"`
+	// the following replace the fields from the config file when set, each as a comma separated list
+	HttpFields []string `envconfig:"HTTP_FIELDS"`
+	TcpFields  []string `envconfig:"TCP_FIELDS"`
+	// keys such as pod_name, from the transformation filter metadata, or filter:key from the metadata of another filter
+	DynamicMetadata []string `envconfig:"DYNAMIC_METADATA"`
+	JwtClaims       []string `envconfig:"JWT_CLAIMS"`
 }
 
 func NewSettings() Settings {
@@ -20,3 +31,27 @@ func NewSettings() Settings {
 
 	return s
 }
+
+// Config loads the config file, and applies the fields set in the environment
+func (s Settings) Config() (*config.Config, error) {
+	cfg, err := config.Load(s.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.HttpFields) > 0 {
+		cfg.HttpFields = s.HttpFields
+	}
+	if len(s.TcpFields) > 0 {
+		cfg.TcpFields = s.TcpFields
+	}
+	if len(s.DynamicMetadata) > 0 {
+		cfg.DynamicMetadata, err = config.ParseDynamicMetadata(s.DynamicMetadata)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(s.JwtClaims) > 0 {
+		cfg.JwtClaims = config.ParseJwtClaims(s.JwtClaims)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,104 @@
+package sinks
+
+import (
+	"context"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	defaultBatchMaxSize       = 100
+	defaultBatchFlushInterval = 5 * time.Second
+	// the number of batches of entries which can wait while a batch is sent, before entries are dropped
+	bufferedBatches = 4
+)
+
+var (
+	BatchFullErr = func(sinkType config.SinkType) error {
+		return eris.Errorf("dropped access log entry, the %v sink is not keeping up", sinkType)
+	}
+)
+
+type flushFunc func(ctx context.Context, entries []*entry.Entry) error
+
+// batcher collects entries, and sends them in batches of up to the max size, or when the oldest entry has waited
+// for the flush interval. Batches are sent one at a time, so entries are dropped rather than blocking the
+// access log stream if the destination is too slow.
+type batcher struct {
+	sinkType      config.SinkType
+	maxSize       int
+	flushInterval time.Duration
+	flush         flushFunc
+	entries       chan *entry.Entry
+}
+
+func newBatcher(ctx context.Context, sinkType config.SinkType, opts config.BatchOptions, flush flushFunc) *batcher {
+	b := &batcher{
+		sinkType:      sinkType,
+		maxSize:       opts.MaxSize,
+		flushInterval: opts.FlushInterval.Duration,
+		flush:         flush,
+	}
+	if b.maxSize <= 0 {
+		b.maxSize = defaultBatchMaxSize
+	}
+	if b.flushInterval <= 0 {
+		b.flushInterval = defaultBatchFlushInterval
+	}
+	b.entries = make(chan *entry.Entry, b.maxSize*bufferedBatches)
+	go b.run(ctx)
+	return b
+}
+
+func (b *batcher) Write(_ context.Context, e *entry.Entry) error {
+	select {
+	case b.entries <- e:
+		return nil
+	default:
+		return BatchFullErr(b.sinkType)
+	}
+}
+
+func (b *batcher) run(ctx context.Context) {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	var batch []*entry.Entry
+	send := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		if err := b.flush(ctx, batch); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("failed to send access log entries", "sink", b.sinkType, "entries", len(batch), "error", err)
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// send the entries which have already been collected, giving up if the destination is slow to respond
+			flushCtx, cancel := context.WithTimeout(contextutils.WithExistingLogger(context.Background(), contextutils.LoggerFrom(ctx)), b.flushInterval)
+			for len(b.entries) > 0 {
+				batch = append(batch, <-b.entries)
+				if len(batch) >= b.maxSize {
+					send(flushCtx)
+				}
+			}
+			send(flushCtx)
+			cancel()
+			return
+		case e := <-b.entries:
+			batch = append(batch, e)
+			if len(batch) >= b.maxSize {
+				send(ctx)
+			}
+		case <-ticker.C:
+			send(ctx)
+		}
+	}
+}
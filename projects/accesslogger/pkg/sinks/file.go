@@ -0,0 +1,136 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+)
+
+const (
+	defaultMaxSizeMb  = 100
+	defaultMaxBackups = 3
+)
+
+var (
+	OpenFileErr = func(err error, path string) error {
+		return eris.Wrapf(err, "opening access log file %v", path)
+	}
+	RotateFileErr = func(err error, path string) error {
+		return eris.Wrapf(err, "rotating access log file %v", path)
+	}
+	FileClosedErr = func(path string) error {
+		return eris.Errorf("access log file %v is closed", path)
+	}
+)
+
+// fileSink writes each entry as a line of json. When the file would grow past its max size, it is renamed to path.1,
+// with any older files moved along to path.2 and so on, and the oldest removed. If the file cannot be reopened after
+// rotating it, it is reopened by the next write.
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	lock sync.Mutex
+	// nil when the file needs to be reopened
+	file   *os.File
+	size   int64
+	closed bool
+}
+
+func NewFileSink(ctx context.Context, opts *config.FileOptions) (Sink, error) {
+	sink := &fileSink{
+		path:       opts.Path,
+		maxSize:    int64(opts.MaxSizeMb) * 1024 * 1024,
+		maxBackups: opts.MaxBackups,
+	}
+	if sink.maxSize <= 0 {
+		sink.maxSize = defaultMaxSizeMb * 1024 * 1024
+	}
+	if sink.maxBackups <= 0 {
+		sink.maxBackups = defaultMaxBackups
+	}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		sink.lock.Lock()
+		defer sink.lock.Unlock()
+		sink.closed = true
+		if sink.file != nil {
+			sink.file.Close()
+			sink.file = nil
+		}
+	}()
+	return sink, nil
+}
+
+func (s *fileSink) Write(_ context.Context, e *entry.Entry) error {
+	line, err := json.Marshal(e.FieldMap())
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return FileClosedErr(s.path)
+	}
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	return err
+}
+
+func (s *fileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return OpenFileErr(err, s.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return OpenFileErr(err, s.path)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *fileSink) rotate() error {
+	err := s.file.Close()
+	s.file = nil
+	if err != nil {
+		return RotateFileErr(err, s.path)
+	}
+	for i := s.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backupPath(s.path, i), backupPath(s.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return RotateFileErr(err, s.path)
+		}
+	}
+	if err := os.Rename(s.path, backupPath(s.path, 1)); err != nil {
+		return RotateFileErr(err, s.path)
+	}
+	return s.open()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%v.%v", path, i)
+}
@@ -0,0 +1,148 @@
+package sinks
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sort"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	otlpServiceName        = "gloo-access-logger"
+	otlpInstrumentationLib = "github.com/solo-io/gloo/projects/accesslogger"
+)
+
+var (
+	OtlpDialErr = func(err error, endpoint string) error {
+		return eris.Wrapf(err, "connecting to otlp collector %v", endpoint)
+	}
+)
+
+// otlpSink exports batches of entries to an OpenTelemetry collector as logs, with the fields of each entry as attributes
+type otlpSink struct {
+	client   collogspb.LogsServiceClient
+	resource *resourcepb.Resource
+}
+
+func NewOtlpSink(ctx context.Context, opts *config.OtlpOptions) (Sink, error) {
+	creds := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	if opts.Insecure {
+		creds = grpc.WithInsecure()
+	}
+	// the connection is made in the background, so an unavailable collector does not stop the access logger starting
+	conn, err := grpc.DialContext(ctx, opts.Endpoint, creds)
+	if err != nil {
+		return nil, OtlpDialErr(err, opts.Endpoint)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	attributes := map[string]interface{}{"service.name": otlpServiceName}
+	for key, value := range opts.ResourceAttributes {
+		attributes[key] = value
+	}
+	sink := &otlpSink{
+		client:   collogspb.NewLogsServiceClient(conn),
+		resource: &resourcepb.Resource{Attributes: keyValues(attributes)},
+	}
+	return newBatcher(ctx, config.OtlpSink, opts.Batch, sink.send), nil
+}
+
+func (s *otlpSink) send(ctx context.Context, entries []*entry.Entry) error {
+	logs := make([]*logspb.LogRecord, 0, len(entries))
+	for _, e := range entries {
+		record := &logspb.LogRecord{
+			SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:   "INFO",
+			Body:           stringValue(fmt.Sprintf("received %v request", e.Type)),
+			Attributes:     keyValues(e.FieldMap()),
+		}
+		if !e.StartTime.IsZero() {
+			record.TimeUnixNano = uint64(e.StartTime.UnixNano())
+		}
+		logs = append(logs, record)
+	}
+	_, err := s.client.Export(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: s.resource,
+			InstrumentationLibraryLogs: []*logspb.InstrumentationLibraryLogs{{
+				InstrumentationLibrary: &commonpb.InstrumentationLibrary{Name: otlpInstrumentationLib},
+				Logs:                   logs,
+			}},
+		}},
+	})
+	return err
+}
+
+func keyValues(values map[string]interface{}) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	keyValues := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		keyValues = append(keyValues, &commonpb.KeyValue{Key: key, Value: anyValue(values[key])})
+	}
+	return keyValues
+}
+
+func anyValue(value interface{}) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return stringValue(v)
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return intValue(int64(v))
+	case int64:
+		return intValue(v)
+	case uint32:
+		return intValue(int64(v))
+	case uint64:
+		return intValue(int64(v))
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case map[string]string:
+		values := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			values[key] = value
+		}
+		return kvlistValue(values)
+	case map[string]interface{}:
+		return kvlistValue(v)
+	case []interface{}:
+		array := &commonpb.ArrayValue{}
+		for _, value := range v {
+			array.Values = append(array.Values, anyValue(value))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: array}}
+	case nil:
+		return &commonpb.AnyValue{}
+	}
+	return stringValue(fmt.Sprint(value))
+}
+
+func stringValue(value string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}
+}
+
+func intValue(value int64) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}}
+}
+
+func kvlistValue(values map[string]interface{}) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: keyValues(values)}}}
+}
@@ -0,0 +1,100 @@
+package sinks
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+)
+
+// Sink writes access log entries somewhere. Sinks which buffer entries, or hold connections, release them when the
+// context they were created with is done.
+type Sink interface {
+	Write(ctx context.Context, e *entry.Entry) error
+}
+
+// NewSinks creates the sinks in the config, each of which only writes the entries which match its filter and sample rate.
+func NewSinks(ctx context.Context, cfg *config.Config) ([]Sink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	var sinks []Sink
+	for _, sinkConfig := range cfg.Sinks {
+		sink, err := newSink(ctx, sinkConfig)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, newFilteredSink(sink, sinkConfig))
+	}
+	return sinks, nil
+}
+
+func newSink(ctx context.Context, sinkConfig *config.Sink) (Sink, error) {
+	switch sinkConfig.Type {
+	case config.FileSink:
+		return NewFileSink(ctx, sinkConfig.File)
+	case config.OtlpSink:
+		return NewOtlpSink(ctx, sinkConfig.Otlp)
+	case config.WebhookSink:
+		return NewWebhookSink(ctx, sinkConfig.Webhook), nil
+	}
+	return NewStdoutSink(), nil
+}
+
+type responseCodeRange struct {
+	min, max uint32
+}
+
+type filteredSink struct {
+	sink          Sink
+	sampleRate    float64
+	responseCodes []responseCodeRange
+	clusters      map[string]bool
+}
+
+func newFilteredSink(sink Sink, sinkConfig *config.Sink) *filteredSink {
+	filtered := &filteredSink{
+		sink:       sink,
+		sampleRate: sinkConfig.GetSampleRate(),
+	}
+	for _, code := range sinkConfig.Filter.GetResponseCodes() {
+		// the codes have already been validated
+		min, max, _ := config.ParseResponseCode(code)
+		filtered.responseCodes = append(filtered.responseCodes, responseCodeRange{min: min, max: max})
+	}
+	if clusters := sinkConfig.Filter.GetClusters(); len(clusters) > 0 {
+		filtered.clusters = map[string]bool{}
+		for _, cluster := range clusters {
+			filtered.clusters[cluster] = true
+		}
+	}
+	return filtered
+}
+
+func (s *filteredSink) Write(ctx context.Context, e *entry.Entry) error {
+	if !s.matches(e) {
+		return nil
+	}
+	return s.sink.Write(ctx, e)
+}
+
+func (s *filteredSink) matches(e *entry.Entry) bool {
+	if len(s.responseCodes) > 0 && !s.matchesResponseCode(e.ResponseCode) {
+		return false
+	}
+	if s.clusters != nil && !s.clusters[e.Cluster] {
+		return false
+	}
+	// sample last, so the sample rate applies to the entries which match the filter
+	return s.sampleRate >= 1 || rand.Float64() < s.sampleRate
+}
+
+func (s *filteredSink) matchesResponseCode(code uint32) bool {
+	for _, codeRange := range s.responseCodes {
+		if code >= codeRange.min && code <= codeRange.max {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package sinks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Sinks", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		dir    string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		dir, err = os.MkdirTemp("", "accesslogger-sinks")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.RemoveAll(dir)
+	})
+
+	newEntry := func(responseCode uint32, cluster string) *entry.Entry {
+		return &entry.Entry{
+			Type:         entry.Http,
+			NodeId:       "gateway-proxy-1.gloo-system",
+			ResponseCode: responseCode,
+			Cluster:      cluster,
+			Fields: []entry.Field{
+				{Name: "response_code", Value: responseCode},
+				{Name: "cluster", Value: cluster},
+			},
+		}
+	}
+
+	newSink := func(sink *config.Sink) sinks.Sink {
+		created, err := sinks.NewSinks(ctx, &config.Config{Sinks: []*config.Sink{sink}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(HaveLen(1))
+		return created[0]
+	}
+
+	readLines := func(path string) []string {
+		b, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(b)), "\n")
+	}
+
+	Context("file", func() {
+
+		It("writes entries as json lines", func() {
+			path := filepath.Join(dir, "access.log")
+			sink := newSink(&config.Sink{Type: config.FileSink, File: &config.FileOptions{Path: path}})
+			Expect(sink.Write(ctx, newEntry(200, "petstore_gloo-system"))).NotTo(HaveOccurred())
+			Expect(sink.Write(ctx, newEntry(503, "petstore_gloo-system"))).NotTo(HaveOccurred())
+
+			lines := readLines(path)
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[1]).To(MatchJSON(`{"cluster":"petstore_gloo-system","logger_name":"","node_id":"gateway-proxy-1.gloo-system","response_code":503}`))
+		})
+
+		It("rotates the file when it reaches its max size", func() {
+			path := filepath.Join(dir, "access.log")
+			sink := newSink(&config.Sink{Type: config.FileSink, File: &config.FileOptions{Path: path, MaxSizeMb: 1, MaxBackups: 1}})
+			large := newEntry(200, strings.Repeat("a", 600*1024))
+			for i := 0; i < 3; i++ {
+				Expect(sink.Write(ctx, large)).NotTo(HaveOccurred())
+			}
+
+			Expect(readLines(path)).To(HaveLen(1))
+			Expect(readLines(path + ".1")).To(HaveLen(1))
+			Expect(path + ".2").NotTo(BeAnExistingFile())
+		})
+
+		It("reopens the file on the next write when rotating it fails", func() {
+			path := filepath.Join(dir, "access.log")
+			sink := newSink(&config.Sink{Type: config.FileSink, File: &config.FileOptions{Path: path, MaxSizeMb: 1, MaxBackups: 1}})
+			large := newEntry(200, strings.Repeat("a", 600*1024))
+			Expect(sink.Write(ctx, large)).NotTo(HaveOccurred())
+
+			// the file cannot be renamed over a directory which is not empty
+			Expect(os.MkdirAll(filepath.Join(path+".1", "blocker"), 0755)).To(Succeed())
+			Expect(sink.Write(ctx, large)).To(MatchError(ContainSubstring("rotating access log file")))
+			Expect(sink.Write(ctx, large)).To(MatchError(ContainSubstring("rotating access log file")))
+
+			Expect(os.RemoveAll(path + ".1")).To(Succeed())
+			Expect(sink.Write(ctx, large)).NotTo(HaveOccurred())
+			Expect(readLines(path)).To(HaveLen(1))
+			Expect(readLines(path + ".1")).To(HaveLen(1))
+		})
+
+		It("does not write once its context is done", func() {
+			path := filepath.Join(dir, "access.log")
+			sink := newSink(&config.Sink{Type: config.FileSink, File: &config.FileOptions{Path: path}})
+			cancel()
+			Eventually(func() error {
+				return sink.Write(ctx, newEntry(200, "petstore_gloo-system"))
+			}).Should(MatchError(sinks.FileClosedErr(path).Error()))
+		})
+	})
+
+	Context("filters", func() {
+
+		It("only writes entries which match the filter", func() {
+			path := filepath.Join(dir, "access.log")
+			sink := newSink(&config.Sink{
+				Type: config.FileSink,
+				File: &config.FileOptions{Path: path},
+				Filter: &config.Filter{
+					ResponseCodes: []string{"5xx", "429"},
+					Clusters:      []string{"petstore_gloo-system"},
+				},
+			})
+			for _, e := range []*entry.Entry{
+				newEntry(200, "petstore_gloo-system"),
+				newEntry(503, "petstore_gloo-system"),
+				newEntry(429, "petstore_gloo-system"),
+				newEntry(503, "other_gloo-system"),
+			} {
+				Expect(sink.Write(ctx, e)).NotTo(HaveOccurred())
+			}
+
+			lines := readLines(path)
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(ContainSubstring(`"response_code":503`))
+			Expect(lines[1]).To(ContainSubstring(`"response_code":429`))
+		})
+
+		It("does not write entries when the sample rate is 0", func() {
+			path := filepath.Join(dir, "access.log")
+			sampleRate := 0.0
+			sink := newSink(&config.Sink{Type: config.FileSink, File: &config.FileOptions{Path: path}, SampleRate: &sampleRate})
+			Expect(sink.Write(ctx, newEntry(200, "petstore_gloo-system"))).NotTo(HaveOccurred())
+
+			b, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b).To(BeEmpty())
+		})
+	})
+
+	Context("webhook", func() {
+
+		var (
+			server  *httptest.Server
+			lock    sync.Mutex
+			batches [][]map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			batches = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+				b, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				var batch []map[string]interface{}
+				Expect(json.Unmarshal(b, &batch)).NotTo(HaveOccurred())
+				lock.Lock()
+				defer lock.Unlock()
+				batches = append(batches, batch)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		batchSizes := func() []int {
+			lock.Lock()
+			defer lock.Unlock()
+			var sizes []int
+			for _, batch := range batches {
+				sizes = append(sizes, len(batch))
+			}
+			return sizes
+		}
+
+		It("sends full batches, and the remaining entries after the flush interval", func() {
+			sink := newSink(&config.Sink{Type: config.WebhookSink, Webhook: &config.WebhookOptions{
+				Url:     server.URL,
+				Headers: map[string]string{"Authorization": "Bearer token"},
+				Batch: config.BatchOptions{
+					MaxSize:       2,
+					FlushInterval: config.Duration{Duration: 200 * time.Millisecond},
+				},
+			}})
+			for i := 0; i < 3; i++ {
+				Expect(sink.Write(ctx, newEntry(200, "petstore_gloo-system"))).NotTo(HaveOccurred())
+			}
+
+			Eventually(batchSizes, "1s").Should(Equal([]int{2, 1}))
+			lock.Lock()
+			defer lock.Unlock()
+			Expect(batches[0][0]).To(HaveKeyWithValue("cluster", "petstore_gloo-system"))
+		})
+	})
+
+	Context("otlp", func() {
+
+		var (
+			grpcServer *grpc.Server
+			collector  *fakeCollector
+			endpoint   string
+		)
+
+		BeforeEach(func() {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			endpoint = lis.Addr().String()
+			collector = &fakeCollector{}
+			grpcServer = grpc.NewServer()
+			collogspb.RegisterLogsServiceServer(grpcServer, collector)
+			go grpcServer.Serve(lis)
+		})
+
+		AfterEach(func() {
+			grpcServer.Stop()
+		})
+
+		It("exports entries as logs", func() {
+			sink := newSink(&config.Sink{Type: config.OtlpSink, Otlp: &config.OtlpOptions{
+				Endpoint:           endpoint,
+				Insecure:           true,
+				ResourceAttributes: map[string]string{"cluster.name": "test"},
+				Batch:              config.BatchOptions{MaxSize: 1},
+			}})
+			Expect(sink.Write(ctx, newEntry(503, "petstore_gloo-system"))).NotTo(HaveOccurred())
+
+			Eventually(collector.Logs, "5s").Should(HaveLen(1))
+			resourceLogs := collector.Logs()[0]
+			Expect(resourceLogs.GetResource().GetAttributes()).To(HaveLen(2))
+			record := resourceLogs.GetInstrumentationLibraryLogs()[0].GetLogs()[0]
+			Expect(record.GetBody().GetStringValue()).To(Equal("received http request"))
+			attributes := map[string]interface{}{}
+			for _, attribute := range record.GetAttributes() {
+				if attribute.GetValue().GetStringValue() != "" {
+					attributes[attribute.GetKey()] = attribute.GetValue().GetStringValue()
+				} else {
+					attributes[attribute.GetKey()] = attribute.GetValue().GetIntValue()
+				}
+			}
+			Expect(attributes).To(HaveKeyWithValue("cluster", "petstore_gloo-system"))
+			Expect(attributes).To(HaveKeyWithValue("response_code", int64(503)))
+		})
+	})
+})
+
+type fakeCollector struct {
+	collogspb.UnimplementedLogsServiceServer
+	lock sync.Mutex
+	logs []*logspb.ResourceLogs
+}
+
+func (c *fakeCollector) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.logs = append(c.logs, req.GetResourceLogs()...)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (c *fakeCollector) Logs() []*logspb.ResourceLogs {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.logs
+}
@@ -0,0 +1,25 @@
+package sinks
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+type stdoutSink struct{}
+
+// NewStdoutSink logs entries with the logger in the context, which writes json to stdout
+func NewStdoutSink() Sink {
+	return &stdoutSink{}
+}
+
+func (s *stdoutSink) Write(ctx context.Context, e *entry.Entry) error {
+	fields := make([]interface{}, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		fields = append(fields, zap.Any(field.Name, field.Value))
+	}
+	contextutils.LoggerFrom(ctx).With(fields...).Infof("received %v request", e.Type)
+	return nil
+}
@@ -0,0 +1,72 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/config"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/entry"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+var (
+	WebhookStatusErr = func(url string, status int) error {
+		return eris.Errorf("webhook %v responded with status %v", url, status)
+	}
+)
+
+// webhookSink sends batches of entries to a url, as a json array of objects with the fields of each entry
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewWebhookSink(ctx context.Context, opts *config.WebhookOptions) Sink {
+	sink := &webhookSink{
+		url:     opts.Url,
+		headers: opts.Headers,
+		client:  &http.Client{Timeout: opts.Timeout.Duration},
+	}
+	if sink.client.Timeout <= 0 {
+		sink.client.Timeout = defaultWebhookTimeout
+	}
+	return newBatcher(ctx, config.WebhookSink, opts.Batch, sink.send)
+}
+
+func (s *webhookSink) send(ctx context.Context, entries []*entry.Entry) error {
+	body := make([]map[string]interface{}, 0, len(entries))
+	for _, e := range entries {
+		body = append(body, e.FieldMap())
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// read the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return WebhookStatusErr(s.url, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+)
+
+const (
+	// DescriptorSetAnnotation can be set on an upstream to discover its functions from a FileDescriptorSet
+	// rather than with gRPC reflection. Its value is either an http(s) URL or the `namespace/name` of an Artifact.
+	DescriptorSetAnnotation = "gloo.solo.io/grpc-descriptor-set"
+
+	// DescriptorSetArtifactKey is the key of the FileDescriptorSet in the data of an Artifact
+	DescriptorSetArtifactKey = "descriptors"
+
+	// DescriptorSetFetchTimeout bounds the time to fetch a FileDescriptorSet from a url, so that an unresponsive
+	// server does not stall the discovery of the upstream
+	DescriptorSetFetchTimeout = 30 * time.Second
+)
+
+var descriptorSetHttpClient = &http.Client{Timeout: DescriptorSetFetchTimeout}
+
+var (
+	NoArtifactClientError = errors.New("no artifact client is available to read the descriptor set")
+
+	NoDescriptorSetInArtifactError = func(ref *core.ResourceRef) error {
+		return errors.Errorf("artifact %v does not contain a descriptor set under the key %v", ref.Key(), DescriptorSetArtifactKey)
+	}
+
+	InvalidDescriptorSetError = func(err error) error {
+		return errors.Wrapf(err, "invalid descriptor set")
+	}
+)
+
+// getDescriptorSetSource returns where to load the descriptors of an upstream from, or nil if it uses gRPC reflection
+func getDescriptorSetSource(u *v1.Upstream) *grpc_plugins.ServiceSpec_DescriptorSetSource {
+	if source := getGrpcspec(u).GetDescriptorSetSource(); source != nil {
+		return source
+	}
+	annotation := u.GetMetadata().GetAnnotations()[DescriptorSetAnnotation]
+	if annotation == "" {
+		return nil
+	}
+	if strings.HasPrefix(annotation, "http://") || strings.HasPrefix(annotation, "https://") {
+		return &grpc_plugins.ServiceSpec_DescriptorSetSource{
+			Source: &grpc_plugins.ServiceSpec_DescriptorSetSource_Url{
+				Url: annotation,
+			},
+		}
+	}
+	ref := &core.ResourceRef{
+		Namespace: u.GetMetadata().GetNamespace(),
+		Name:      annotation,
+	}
+	if parts := strings.SplitN(annotation, "/", 2); len(parts) == 2 {
+		ref.Namespace = parts[0]
+		ref.Name = parts[1]
+	}
+	return &grpc_plugins.ServiceSpec_DescriptorSetSource{
+		Source: &grpc_plugins.ServiceSpec_DescriptorSetSource_Artifact{
+			Artifact: ref,
+		},
+	}
+}
+
+func (f *UpstreamFunctionDiscovery) detectFunctionsFromDescriptorSet(ctx context.Context, source *grpc_plugins.ServiceSpec_DescriptorSetSource, updatecb func(fds.UpstreamMutator) error) error {
+	descriptors, err := f.loadDescriptorSet(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	var grpcServices []*grpc_plugins.ServiceSpec_GrpcService
+	for _, file := range descriptors.GetFile() {
+		for _, svc := range file.GetService() {
+			grpcService := &grpc_plugins.ServiceSpec_GrpcService{
+				PackageName: file.GetPackage(),
+				ServiceName: svc.GetName(),
+			}
+			for _, method := range svc.GetMethod() {
+				grpcService.FunctionNames = append(grpcService.GetFunctionNames(), method.GetName())
+			}
+			grpcServices = append(grpcServices, grpcService)
+		}
+	}
+
+	return updateServiceSpec(descriptors, grpcServices, updatecb)
+}
+
+func (f *UpstreamFunctionDiscovery) loadDescriptorSet(ctx context.Context, source *grpc_plugins.ServiceSpec_DescriptorSetSource) (*descriptor.FileDescriptorSet, error) {
+	var data []byte
+	switch typedSource := source.GetSource().(type) {
+	case *grpc_plugins.ServiceSpec_DescriptorSetSource_Artifact:
+		if f.artifacts == nil {
+			return nil, NoArtifactClientError
+		}
+		ref := typedSource.Artifact
+		artifact, err := f.artifacts.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading artifact %v", ref.Key())
+		}
+		encoded, ok := artifact.GetData()[DescriptorSetArtifactKey]
+		if !ok && len(artifact.GetData()) == 1 {
+			for _, value := range artifact.GetData() {
+				encoded, ok = value, true
+			}
+		}
+		if !ok {
+			return nil, NoDescriptorSetInArtifactError(ref)
+		}
+		data = []byte(encoded)
+	case *grpc_plugins.ServiceSpec_DescriptorSetSource_Url:
+		var err error
+		data, err = loadDescriptorSetFromUrl(ctx, typedSource.Url)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("descriptor set source must be either an artifact or a url")
+	}
+	return parseDescriptorSet(data)
+}
+
+func loadDescriptorSetFromUrl(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req = req.WithContext(ctx)
+	res, err := descriptorSetHttpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP GET on %v", url)
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			contextutils.LoggerFrom(ctx).Debug(err)
+		}
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("could not access descriptor set at %v: %v", url, res.Status)
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+// parseDescriptorSet parses a FileDescriptorSet which is either raw or base64 encoded
+func parseDescriptorSet(data []byte) (*descriptor.FileDescriptorSet, error) {
+	descriptors := &descriptor.FileDescriptorSet{}
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data))); err == nil {
+		if err := proto.Unmarshal(decoded, descriptors); err == nil {
+			return descriptors, nil
+		}
+		descriptors.Reset()
+	}
+	if err := proto.Unmarshal(data, descriptors); err != nil {
+		return nil, InvalidDescriptorSetError(err)
+	}
+	return descriptors, nil
+}
@@ -0,0 +1,188 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+)
+
+var _ = Describe("Descriptor set discovery", func() {
+
+	var (
+		ctx            context.Context
+		artifactClient v1.ArtifactClient
+		upstream       *v1.Upstream
+		rawDescriptors []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		artifactClient, err = v1.NewArtifactClient(ctx, &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		descriptors := &descriptor.FileDescriptorSet{
+			File: []*descriptor.FileDescriptorProto{
+				{
+					Name:    proto.String("bookstore.proto"),
+					Package: proto.String("bookstore"),
+					MessageType: []*descriptor.DescriptorProto{
+						{Name: proto.String("Book")},
+					},
+					Service: []*descriptor.ServiceDescriptorProto{{
+						Name: proto.String("Bookstore"),
+						Method: []*descriptor.MethodDescriptorProto{
+							{Name: proto.String("GetBook"), InputType: proto.String(".bookstore.Book"), OutputType: proto.String(".bookstore.Book")},
+							{Name: proto.String("CreateBook"), InputType: proto.String(".bookstore.Book"), OutputType: proto.String(".bookstore.Book")},
+						},
+					}},
+				},
+			},
+		}
+		rawDescriptors, err = proto.Marshal(descriptors)
+		Expect(err).NotTo(HaveOccurred())
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "bookstore", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}
+	})
+
+	newDiscovery := func() fds.UpstreamFunctionDiscovery {
+		return NewFunctionDiscoveryFactory().NewFunctionDiscovery(upstream, fds.AdditionalClients{ArtifactClient: artifactClient})
+	}
+
+	detectFunctions := func() *grpc_plugins.ServiceSpec {
+		discovery := newDiscovery().(*UpstreamFunctionDiscovery)
+		source := getDescriptorSetSource(upstream)
+		Expect(source).NotTo(BeNil())
+		err := discovery.detectFunctionsFromDescriptorSet(ctx, source, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return getGrpcspec(upstream)
+	}
+
+	expectBookstore := func(spec *grpc_plugins.ServiceSpec) {
+		Expect(spec.GetGrpcServices()).To(HaveLen(1))
+		Expect(spec.GetGrpcServices()[0].GetPackageName()).To(Equal("bookstore"))
+		Expect(spec.GetGrpcServices()[0].GetServiceName()).To(Equal("Bookstore"))
+		Expect(spec.GetGrpcServices()[0].GetFunctionNames()).To(ConsistOf("GetBook", "CreateBook"))
+		// descriptors are stored base64 encoded, as they are for reflection
+		decoded, err := base64.StdEncoding.DecodeString(string(spec.GetDescriptors()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decoded).To(Equal(rawDescriptors))
+	}
+
+	setGrpcSpec := func(spec *grpc_plugins.ServiceSpec) {
+		upstream.GetStatic().ServiceSpec = &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Grpc{Grpc: spec},
+		}
+	}
+
+	It("discovers functions from a base64 encoded artifact", func() {
+		_, err := artifactClient.Write(&v1.Artifact{
+			Metadata: &core.Metadata{Name: "bookstore-descriptors", Namespace: "gloo-system"},
+			Data:     map[string]string{DescriptorSetArtifactKey: base64.StdEncoding.EncodeToString(rawDescriptors)},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		setGrpcSpec(&grpc_plugins.ServiceSpec{
+			DescriptorSetSource: &grpc_plugins.ServiceSpec_DescriptorSetSource{
+				Source: &grpc_plugins.ServiceSpec_DescriptorSetSource_Artifact{
+					Artifact: &core.ResourceRef{Name: "bookstore-descriptors", Namespace: "gloo-system"},
+				},
+			},
+		})
+		expectBookstore(detectFunctions())
+	})
+
+	It("discovers functions from a raw artifact referenced by an annotation", func() {
+		_, err := artifactClient.Write(&v1.Artifact{
+			Metadata: &core.Metadata{Name: "bookstore-descriptors", Namespace: "gloo-system"},
+			Data:     map[string]string{"bookstore.pb": string(rawDescriptors)},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		upstream.Metadata.Annotations = map[string]string{DescriptorSetAnnotation: "bookstore-descriptors"}
+		setGrpcSpec(&grpc_plugins.ServiceSpec{})
+		expectBookstore(detectFunctions())
+	})
+
+	It("discovers functions from a url", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(rawDescriptors)
+		}))
+		defer server.Close()
+
+		upstream.Metadata.Annotations = map[string]string{DescriptorSetAnnotation: server.URL + "/bookstore.pb"}
+		setGrpcSpec(&grpc_plugins.ServiceSpec{})
+		expectBookstore(detectFunctions())
+	})
+
+	It("times out fetching from an unresponsive url", func() {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+
+		client := descriptorSetHttpClient
+		descriptorSetHttpClient = &http.Client{Timeout: 100 * time.Millisecond}
+		defer func() { descriptorSetHttpClient = client }()
+
+		_, err := loadDescriptorSetFromUrl(ctx, server.URL+"/bookstore.pb")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Client.Timeout exceeded"))
+	})
+
+	It("detects upstreams with an annotation as grpc without reflection", func() {
+		upstream.Metadata.Annotations = map[string]string{DescriptorSetAnnotation: "other-namespace/bookstore-descriptors"}
+		discovery := newDiscovery()
+		Expect(discovery.IsFunctional()).To(BeFalse())
+		spec, err := discovery.DetectType(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetGrpc()).NotTo(BeNil())
+
+		source := getDescriptorSetSource(upstream)
+		Expect(source.GetArtifact().GetNamespace()).To(Equal("other-namespace"))
+		Expect(source.GetArtifact().GetName()).To(Equal("bookstore-descriptors"))
+	})
+
+	It("errors when the artifact does not contain a descriptor set", func() {
+		_, err := artifactClient.Write(&v1.Artifact{
+			Metadata: &core.Metadata{Name: "bookstore-descriptors", Namespace: "gloo-system"},
+			Data:     map[string]string{"a": "b", "c": "d"},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		ref := &core.ResourceRef{Name: "bookstore-descriptors", Namespace: "gloo-system"}
+		discovery := newDiscovery().(*UpstreamFunctionDiscovery)
+		_, err = discovery.loadDescriptorSet(ctx, &grpc_plugins.ServiceSpec_DescriptorSetSource{
+			Source: &grpc_plugins.ServiceSpec_DescriptorSetSource_Artifact{Artifact: ref},
+		})
+		Expect(err).To(MatchError(NoDescriptorSetInArtifactError(ref).Error()))
+	})
+})
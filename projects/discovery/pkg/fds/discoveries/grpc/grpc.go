@@ -55,20 +55,21 @@ type FunctionDiscoveryFactory struct {
 	DetectionTimeout   time.Duration
 	DetectionRetryBase time.Duration
 	FunctionPollTime   time.Duration
-	// TODO: move over to ArtifactClient
-	Artifacts v1.ArtifactClient
 }
 
 // NewFunctionDiscovery returns a FunctionDiscovery that can be used to discover functions
-func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
+func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, clients fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
-		upstream: u,
+		upstream:  u,
+		artifacts: clients.ArtifactClient,
 	}
 }
 
 // UpstreamFunctionDiscovery represents a function discovery for upstream
 type UpstreamFunctionDiscovery struct {
 	upstream *v1.Upstream
+	// used to read descriptor sets stored in artifacts
+	artifacts v1.ArtifactClient
 }
 
 // IsFunctional returns true if the upstream is functional
@@ -80,6 +81,15 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.GetMetadata().GetName())
 
+	// upstreams which point at their descriptors do not need to implement reflection
+	if getDescriptorSetSource(f.upstream) != nil {
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Grpc{
+				Grpc: &grpc_plugins.ServiceSpec{},
+			},
+		}, nil
+	}
+
 	refClient, closeConn, err := getClient(ctx, url)
 	if err != nil {
 		return nil, err
@@ -103,6 +113,9 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	// TODO: get backoff values from config?
 	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+		if source := getDescriptorSetSource(f.upstream); source != nil {
+			return f.detectFunctionsFromDescriptorSet(ctx, source, updatecb)
+		}
 		return f.DetectFunctionsOnce(ctx, url, updatecb)
 	})
 	if err != nil {
@@ -179,6 +192,10 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 		grpcServices = append(grpcServices, grpcService)
 	}
 
+	return updateServiceSpec(descriptors, grpcServices, updatecb)
+}
+
+func updateServiceSpec(descriptors *descriptor.FileDescriptorSet, grpcServices []*grpc_plugins.ServiceSpec_GrpcService, updatecb func(fds.UpstreamMutator) error) error {
 	rawDescriptors, err := proto.Marshal(descriptors)
 	if err != nil {
 		return errors.Wrap(err, "marshalling proto descriptors")
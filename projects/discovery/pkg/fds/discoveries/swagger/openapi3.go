@@ -0,0 +1,229 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/log"
+
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+)
+
+// the methods for which functions are created, in the same order as for Swagger 2.0 documents
+var openApi3Methods = []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"}
+
+var serverVariableRegex = regexp.MustCompile(`{([^}]*)}`)
+
+// openApi3Version returns the OpenAPI version declared by a json document, which is empty for Swagger 2.0 documents
+func openApi3Version(jsonDoc []byte) string {
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsonDoc, &version); err != nil {
+		return ""
+	}
+	if !strings.HasPrefix(version.OpenAPI, "3.") {
+		return ""
+	}
+	return version.OpenAPI
+}
+
+func parseOpenApi3Doc(jsonDoc []byte) (*openapi3.T, error) {
+	if strings.HasPrefix(openApi3Version(jsonDoc), "3.1") {
+		var err error
+		jsonDoc, err = downgradeOpenApi31Schemas(jsonDoc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	doc, err := openapi3.NewLoader().LoadFromData(jsonDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+	return doc, nil
+}
+
+// downgradeOpenApi31Schemas rewrites the JSON Schema keywords of OpenAPI 3.1 whose types differ from those of
+// OpenAPI 3.0, so that the document can be parsed as the latter. This is lossy, but keeps everything needed
+// to discover functions.
+func downgradeOpenApi31Schemas(jsonDoc []byte) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(jsonDoc, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+	var downgrade func(node interface{})
+	downgrade = func(node interface{}) {
+		switch typed := node.(type) {
+		case []interface{}:
+			for _, child := range typed {
+				downgrade(child)
+			}
+		case map[string]interface{}:
+			// type may be a list of types, which may include null
+			if types, ok := typed["type"].([]interface{}); ok {
+				delete(typed, "type")
+				for _, t := range types {
+					if t == "null" {
+						typed["nullable"] = true
+					} else if _, ok := typed["type"]; !ok {
+						typed["type"] = t
+					}
+				}
+			}
+			// exclusive bounds are numbers rather than flags on the bounds
+			for exclusive, bound := range map[string]string{"exclusiveMinimum": "minimum", "exclusiveMaximum": "maximum"} {
+				if value, ok := typed[exclusive].(float64); ok {
+					typed[bound] = value
+					typed[exclusive] = true
+				}
+			}
+			for _, child := range typed {
+				downgrade(child)
+			}
+		}
+	}
+	downgrade(doc)
+	return json.Marshal(doc)
+}
+
+func createFunctionsForOpenApi3Doc(doc *openapi3.T) map[string]*transformation_plugins.TransformationTemplate {
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for functionPath, pathItem := range doc.Paths {
+		for _, method := range openApi3Methods {
+			operation := pathItem.GetOperation(method)
+			if operation == nil {
+				continue
+			}
+			// the most specific servers apply
+			servers := doc.Servers
+			if len(pathItem.Servers) > 0 {
+				servers = pathItem.Servers
+			}
+			if operation.Servers != nil && len(*operation.Servers) > 0 {
+				servers = *operation.Servers
+			}
+			name, trans, ok := createFunctionForOpenApi3Operation(method, basePathForServers(servers), functionPath, pathItem.Parameters, operation)
+			if ok {
+				funcs[name] = trans
+			}
+		}
+	}
+	return funcs
+}
+
+func createFunctionForOpenApi3Operation(method, basePath, functionPath string, pathParams openapi3.Parameters, operation *openapi3.Operation) (string, *transformation_plugins.TransformationTemplate, bool) {
+	var queryParams, headerParams []string
+	for _, param := range mergeOpenApi3Parameters(pathParams, operation.Parameters) {
+		// sort parameters by the template they will go into
+		switch param.In {
+		case openapi3.ParameterInQuery:
+			queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+		case openapi3.ParameterInHeader:
+			headerParams = append(headerParams, param.Name)
+		case openapi3.ParameterInPath:
+			// nothing to do here, we already get the template
+		case openapi3.ParameterInCookie:
+			log.Warnf("cookie params not currently supported; ignoring")
+		}
+	}
+
+	var body *string
+	if requestBody := operation.RequestBody; requestBody != nil && requestBody.Value != nil && len(requestBody.Value.Content) > 0 {
+		mediaType := requestBody.Value.Content.Get("application/json")
+		if mediaType == nil {
+			log.Warnf("operation %v %v does not consume content type application/json; ignoring", method, functionPath)
+			return "", nil, false
+		}
+		if mediaType.Schema != nil && mediaType.Schema.Value != nil {
+			tmp := getOpenApi3BodyTemplate("", mediaType.Schema.Value, map[*openapi3.Schema]bool{})
+			body = &tmp
+		}
+	}
+
+	name, trans := createFunction(method, basePath, functionPath, operation.OperationID, queryParams, headerParams, body)
+	return name, trans, true
+}
+
+// mergeOpenApi3Parameters returns the parameters of an operation, including those of its path which it does not override
+func mergeOpenApi3Parameters(pathParams, operationParams openapi3.Parameters) []*openapi3.Parameter {
+	var params []*openapi3.Parameter
+	overridden := map[string]bool{}
+	for _, paramRef := range operationParams {
+		if paramRef == nil || paramRef.Value == nil {
+			continue
+		}
+		params = append(params, paramRef.Value)
+		overridden[paramRef.Value.In+"/"+paramRef.Value.Name] = true
+	}
+	for _, paramRef := range pathParams {
+		if paramRef == nil || paramRef.Value == nil || overridden[paramRef.Value.In+"/"+paramRef.Value.Name] {
+			continue
+		}
+		params = append(params, paramRef.Value)
+	}
+	return params
+}
+
+// basePathForServers returns the path of the url of the first server, with its variables set to their defaults
+func basePathForServers(servers openapi3.Servers) string {
+	if len(servers) == 0 || servers[0] == nil {
+		return ""
+	}
+	server := servers[0]
+	serverUrl := serverVariableRegex.ReplaceAllStringFunc(server.URL, func(variable string) string {
+		if value, ok := server.Variables[strings.Trim(variable, "{}")]; ok && value != nil {
+			return value.Default
+		}
+		return ""
+	})
+	parsed, err := url.Parse(serverUrl)
+	if err != nil {
+		log.Warnf("invalid server url %v; ignoring", serverUrl)
+		return ""
+	}
+	return strings.TrimSuffix(parsed.Path, "/")
+}
+
+func getOpenApi3BodyTemplate(parent string, schema *openapi3.Schema, visited map[*openapi3.Schema]bool) string {
+	// recursive schemas cannot be templated
+	if visited[schema] {
+		return "{}"
+	}
+	visited[schema] = true
+	defer delete(visited, schema)
+
+	var fields []string
+	for key, prop := range schema.Properties {
+		if prop == nil || prop.Value == nil {
+			continue
+		}
+		paramName := key
+		if parent != "" {
+			paramName = parent + "." + key
+		}
+		var defaultValue string
+		if prop.Value.Default != nil {
+			defaultValue = fmt.Sprintf("%v", prop.Value.Default)
+		}
+		switch {
+		case len(prop.Value.Properties) > 0 && (prop.Value.Type == "" || prop.Value.Type == openapi3.TypeObject):
+			fields = append(fields, fmt.Sprintf(`"%v": %v`, key, getOpenApi3BodyTemplate(paramName, prop.Value, visited)))
+		case prop.Value.Type == openapi3.TypeString:
+			// string needs escaping
+			fields = append(fields, fmt.Sprintf(`"%v": "{{ default(%v, "%v")}}"`, key, paramName, defaultValue))
+		default:
+			fields = append(fields, fmt.Sprintf(`"%v": {{ default(%v, "%v") }}`, key, paramName, defaultValue))
+		}
+	}
+	// idempotency
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	return "{" + strings.Join(fields, ",") + "}"
+}
@@ -0,0 +1,202 @@
+package swagger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+)
+
+const openApi30Doc = `
+openapi: 3.0.3
+info:
+  title: petstore
+  version: 1.0.0
+servers:
+- url: "{scheme}://petstore.example.com/{version}/"
+  variables:
+    scheme:
+      default: https
+    version:
+      default: v1
+paths:
+  /pets/{petId}:
+    parameters:
+    - name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+    - name: x-tenant
+      in: header
+      schema:
+        type: string
+    get:
+      operationId: getPet
+      parameters:
+      - name: verbose
+        in: query
+        schema:
+          type: boolean
+    put:
+      operationId: updatePet
+      servers:
+      - url: /admin
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+  /pets/{petId}/photo:
+    post:
+      requestBody:
+        content:
+          image/png:
+            schema:
+              type: string
+              format: binary
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+          default: rex
+        age:
+          type: integer
+        owner:
+          properties:
+            name:
+              type: string
+`
+
+const openApi31Doc = `{
+  "openapi": "3.1.0",
+  "info": {"title": "petstore", "version": "1.0.0"},
+  "paths": {
+    "/pets": {
+      "post": {
+        "operationId": "addPet",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "name": {"type": ["string", "null"]},
+                  "age": {"type": "integer", "exclusiveMinimum": 0}
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+var _ = Describe("OpenAPI 3", func() {
+
+	It("detects the version of documents", func() {
+		Expect(openApi3Version([]byte(`{"openapi": "3.0.1"}`))).To(Equal("3.0.1"))
+		Expect(openApi3Version([]byte(`{"swagger": "2.0"}`))).To(BeEmpty())
+	})
+
+	It("creates functions for the operations of a yaml document", func() {
+		doc, err := parseDoc([]byte(openApi30Doc))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.swagger).To(BeNil())
+		Expect(doc.openApi3).NotTo(BeNil())
+
+		funcs := createFunctionsForOpenApi3Doc(doc.openApi3)
+		// the operation which does not consume json is ignored
+		Expect(funcs).To(HaveLen(2))
+
+		getPet := funcs["getPet"]
+		Expect(getPet).NotTo(BeNil())
+		Expect(getPet.GetHeaders()[":method"].GetText()).To(Equal("GET"))
+		Expect(getPet.GetHeaders()[":path"].GetText()).To(Equal(`/v1/pets/{{ default(petId, "") }}?verbose={{default(verbose, "")}}`))
+		Expect(getPet.GetHeaders()["x-tenant"].GetText()).To(Equal(`{{default(x-tenant, "")}}`))
+
+		updatePet := funcs["updatePet"]
+		Expect(updatePet).NotTo(BeNil())
+		Expect(updatePet.GetHeaders()[":method"].GetText()).To(Equal("PUT"))
+		Expect(updatePet.GetHeaders()[":path"].GetText()).To(Equal(`/admin/pets/{{ default(petId, "") }}`))
+		Expect(updatePet.GetHeaders()["content-type"].GetText()).To(Equal("application/json"))
+		Expect(updatePet.GetBody().GetText()).To(Equal(
+			`{"age": {{ default(age, "") }},"name": "{{ default(name, "rex")}}","owner": {"name": "{{ default(owner.name, "")}}"}}`))
+	})
+
+	It("parses OpenAPI 3.1 schemas", func() {
+		doc, err := parseDoc([]byte(openApi31Doc))
+		Expect(err).NotTo(HaveOccurred())
+
+		funcs := createFunctionsForOpenApi3Doc(doc.openApi3)
+		Expect(funcs).To(HaveKey("addPet"))
+		Expect(funcs["addPet"].GetBody().GetText()).To(Equal(
+			`{"age": {{ default(age, "") }},"name": "{{ default(name, "")}}"}`))
+	})
+
+	It("detects and discovers the functions of a document served at a common url", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/openapi.json" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(openApi31Doc))
+		}))
+		defer server.Close()
+
+		upstream := &v1.Upstream{
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}
+		discovery := NewFunctionDiscoveryFactory().NewFunctionDiscovery(upstream, fds.AdditionalClients{})
+
+		baseUrl, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		serviceSpec, err := discovery.DetectType(context.Background(), baseUrl)
+		Expect(err).NotTo(HaveOccurred())
+		swaggerInfo := serviceSpec.GetRest().GetSwaggerInfo()
+		Expect(swaggerInfo.GetUrl()).To(Equal(server.URL + "/openapi.json"))
+
+	})
+
+	It("discovers the functions of an inline document", func() {
+		upstream := &v1.Upstream{
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{
+					ServiceSpec: &plugins.ServiceSpec{
+						PluginType: &plugins.ServiceSpec_Rest{
+							Rest: &rest_plugins.ServiceSpec{
+								SwaggerInfo: &rest_plugins.ServiceSpec_SwaggerInfo{
+									SwaggerSpec: &rest_plugins.ServiceSpec_SwaggerInfo_Inline{
+										Inline: openApi30Doc,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		discovery := NewFunctionDiscoveryFactory().NewFunctionDiscovery(upstream, fds.AdditionalClients{})
+		err := discovery.DetectFunctions(context.Background(), nil, nil, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		funcs := upstream.GetStatic().GetServiceSpec().GetRest().GetTransformations()
+		Expect(funcs).To(HaveKey("getPet"))
+		Expect(funcs).To(HaveKey("updatePet"))
+	})
+})
@@ -2,12 +2,14 @@ package swagger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/loads"
 	openapi "github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
@@ -30,6 +32,9 @@ var commonSwaggerURIs = []string{
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.json",
+	"/openapi.yaml",
+	"/v3/api-docs",
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -128,7 +133,7 @@ func (f *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 		}
 		// might have found a swagger service
 		if res.StatusCode == http.StatusOK {
-			if _, err := RetrieveSwaggerDocFromUrl(ctx, url); err != nil {
+			if _, err := retrieveDocFromUrl(ctx, url); err != nil {
 				// first check if this is a context error
 				if ctx.Err() != nil {
 					return nil, multierror.Append(err, ctx.Err())
@@ -181,11 +186,11 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, _ *url.U
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
 	err := contextutils.NewExponentialBackoff(contextutils.ExponentialBackoff{}).Backoff(ctx, func(ctx context.Context) error {
 
-		spec, err := RetrieveSwaggerDocFromUrl(ctx, url)
+		doc, err := retrieveDocFromUrl(ctx, url)
 		if err != nil {
 			return err
 		}
-		err = f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+		err = f.detectFunctionsFromDoc(ctx, doc, in, updatecb)
 		if err != nil {
 			return err
 		}
@@ -209,11 +214,18 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context, document string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
-	spec, err := parseSwaggerDoc([]byte(document))
+	doc, err := parseDoc([]byte(document))
 	if err != nil {
 		return err
 	}
-	return f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+	return f.detectFunctionsFromDoc(ctx, doc, in, updatecb)
+}
+
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromDoc(ctx context.Context, doc *document, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	if doc.openApi3 != nil {
+		return f.updateFunctions(createFunctionsForOpenApi3Doc(doc.openApi3), updatecb)
+	}
+	return f.detectFunctionsFromSpec(ctx, doc.swagger, in, updatecb)
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context, swaggerSpec *openapi.Swagger, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
@@ -243,6 +255,10 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
 
+	return f.updateFunctions(funcs, updatecb)
+}
+
+func (f *SwaggerFunctionDiscovery) updateFunctions(funcs map[string]*transformation_plugins.TransformationTemplate, updatecb func(fds.UpstreamMutator) error) error {
 	return updatecb(func(u *v1.Upstream) error {
 		upstreamSpec, ok := u.GetUpstreamType().(v1.ServiceSpecMutator)
 		if !ok {
@@ -267,6 +283,39 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 	})
 }
 
+// document is either a Swagger 2.0 or an OpenAPI 3.x document
+type document struct {
+	swagger  *openapi.Swagger
+	openApi3 *openapi3.T
+}
+
+func retrieveDocFromUrl(ctx context.Context, url string) (*document, error) {
+	docBytes, err := LoadFromFileOrHTTP(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading swagger doc from url")
+	}
+	return parseDoc(docBytes)
+}
+
+func parseDoc(docBytes []byte) (*document, error) {
+	jsonDoc, err := docToJson(docBytes)
+	if err != nil {
+		return nil, err
+	}
+	if openApi3Version(jsonDoc) != "" {
+		doc, err := parseOpenApi3Doc(jsonDoc)
+		if err != nil {
+			return nil, err
+		}
+		return &document{openApi3: doc}, nil
+	}
+	spec, err := parseSwaggerDoc(jsonDoc)
+	if err != nil {
+		return nil, err
+	}
+	return &document{swagger: spec}, nil
+}
+
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
 	docBytes, err := LoadFromFileOrHTTP(ctx, url)
 	if err != nil {
@@ -307,17 +356,30 @@ func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
 }
 
 func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
-	doc, err := loads.Analyzed(docBytes, "")
+	jsonDoc, err := docToJson(docBytes)
 	if err != nil {
-		log.Debugf("parsing doc as json failed, falling back to yaml")
-		jsn, err := swag.YAMLToJSON(docBytes)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
-		}
-		doc, err = loads.Analyzed(jsn, "")
-		if err != nil {
-			return nil, errors.Wrap(err, "invalid swagger doc")
-		}
+		return nil, err
+	}
+	doc, err := loads.Analyzed(jsonDoc, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid swagger doc")
 	}
 	return doc.Spec(), nil
 }
+
+// docToJson converts a document, which may be either json or yaml, to json
+func docToJson(docBytes []byte) ([]byte, error) {
+	if json.Valid(docBytes) {
+		return docBytes, nil
+	}
+	log.Debugf("parsing doc as json failed, falling back to yaml")
+	yamlDoc, err := swag.BytesToYAMLDoc(docBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse doc as yaml (after falling back to yaml parsing)")
+	}
+	jsn, err := swag.YAMLToJSON(yamlDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
+	}
+	return jsn, nil
+}
@@ -61,6 +61,12 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		}
 	}
 
+	return createFunction(method, basePath, functionPath, operation.ID, queryParams, headerParams, body)
+}
+
+// createFunction builds the transformation for a function from the parameters of its operation, which are
+// described the same way by Swagger 2.0 and OpenAPI 3.x documents
+func createFunction(method string, basePath, functionPath, operationId string, queryParams, headerParams []string, body *string) (string, *transformation_plugins.TransformationTemplate) {
 	path := swaggerPathToJinjaTemplate(basePath + functionPath)
 	if len(queryParams) > 0 {
 		path += "?" + strings.Join(queryParams, "&")
@@ -72,7 +78,7 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		headersTemplate[name] = fmt.Sprintf("{{default(%v, \"\")}}", name)
 	}
 
-	fnName := operation.ID
+	fnName := operationId
 	if fnName == "" {
 		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
 	}
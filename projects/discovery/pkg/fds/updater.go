@@ -44,6 +44,7 @@ type Updater struct {
 
 	upstreamWriter UpstreamWriterClient
 	graphqlClient  v1beta1.GraphQLApiClient
+	artifactClient v1.ArtifactClient
 
 	maxInParallelSemaphore chan struct{}
 
@@ -64,7 +65,7 @@ func getConcurrencyChan(maxOnCurrency uint) chan struct{} {
 
 }
 
-func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.GraphQLApiClient, upstreamclient UpstreamWriterClient, maxconncurrency uint, functionalPlugins []FunctionDiscoveryFactory) *Updater {
+func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.GraphQLApiClient, artifactClient v1.ArtifactClient, upstreamclient UpstreamWriterClient, maxconncurrency uint, functionalPlugins []FunctionDiscoveryFactory) *Updater {
 	ctx = contextutils.WithLogger(ctx, "function-discovery-updater")
 	return &Updater{
 		logger:                 contextutils.LoggerFrom(ctx),
@@ -75,6 +76,7 @@ func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.Gr
 		maxInParallelSemaphore: getConcurrencyChan(maxconncurrency),
 		upstreamWriter:         upstreamclient,
 		graphqlClient:          graphqlClient,
+		artifactClient:         artifactClient,
 	}
 }
 
@@ -101,7 +103,8 @@ func (u *Updater) createDiscoveries(upstream *v1.Upstream) []UpstreamFunctionDis
 	var ret []UpstreamFunctionDiscovery
 	for _, e := range u.functionalPlugins {
 		ret = append(ret, e.NewFunctionDiscovery(upstream, AdditionalClients{
-			GraphqlClient: u.graphqlClient,
+			GraphqlClient:  u.graphqlClient,
+			ArtifactClient: u.artifactClient,
 		}))
 	}
 	return ret
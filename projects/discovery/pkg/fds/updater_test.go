@@ -127,7 +127,7 @@ var _ = Describe("Updater", func() {
 		}
 		testDiscovery1 = NewTestDiscovery()
 		testDiscovery2 = NewTestDiscovery()
-		updater = NewUpdater(ctx, resolver, nil, upstreamWriterClient, 0, []FunctionDiscoveryFactory{testDiscovery1, testDiscovery2})
+		updater = NewUpdater(ctx, resolver, nil, nil, upstreamWriterClient, 0, []FunctionDiscoveryFactory{testDiscovery1, testDiscovery2})
 		up = &v1.Upstream{
 			Metadata: &core_solo_io.Metadata{
 				Namespace: "ns",
@@ -6,8 +6,10 @@ import (
 	"bytes"
 	"log"
 	"os"
+	"strconv"
 	"syscall"
 
+	"github.com/solo-io/gloo/projects/envoyinit/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/envoyinit/pkg/downward"
 )
 
@@ -23,30 +25,50 @@ const (
 	// Environment variable for the path to the envoy executable
 	envoyExecutableEnv     = "ENVOY"
 	defaultEnvoyExecutable = "/usr/local/bin/envoy"
+
+	// Environment variable for the directory containing bootstrap overlay fragments, which are merged into the
+	// bootstrap configuration
+	overlayDirEnv     = "BOOTSTRAP_OVERLAY_DIR"
+	defaultOverlayDir = "/etc/envoy/overlays/"
+
+	// Environment variable which, when false, disables validating the bootstrap configuration before executing envoy
+	validateEnv = "VALIDATE_BOOTSTRAP"
 )
 
 func main() {
 	envoyExecutable := GetEnvoyExecutable()
 	inputPath := GetInputConfigPath()
 	outputPath := GetOutputConfigPath()
+	overlayDir := GetOverlayDir()
 
-	RunEnvoy(envoyExecutable, inputPath, outputPath)
+	RunEnvoy(envoyExecutable, inputPath, outputPath, overlayDir)
 }
 
 // RunEnvoy run Envoy with bootstrap configuration injected from a file
-func RunEnvoy(envoyExecutable, inputPath, outputPath string) {
-	// 1. Transform the configuration using the Kubernetes Downward API
-	bootstrapConfig, err := getAndTransformConfig(inputPath)
+func RunEnvoy(envoyExecutable, inputPath, outputPath, overlayDir string) {
+	// 1. Transform the configuration using the Kubernetes Downward API, environment variables, files and secrets,
+	// and merge in the overlays, which are transformed in the same way
+	bootstrapConfig, err := getAndTransformConfig(inputPath, overlayDir, downward.NewInterpolator())
 	if err != nil {
 		log.Fatalf("initializer failed: %v", err)
 	}
 
-	// 2. Write to a file for debug purposes
+	// 2. Validate the configuration unless disabled, so that we exit with a clear error rather than envoy crash-looping
+	if GetValidate() {
+		if err := bootstrap.Validate(bootstrapConfig); err != nil {
+			log.Fatalf("initializer failed: %v", err)
+		}
+	}
+
+	// 3. Write to a file for debug purposes, with the values of secrets redacted
 	// since this operation is meant only for debug purposes, we ignore the error
 	// this might fail if root fs is read only
-	_ = os.WriteFile(outputPath, []byte(bootstrapConfig), 0444)
+	redactingInterpolator := downward.NewInterpolatorWithFuncs(downward.RedactSecrets(downward.DefaultTemplateFuncs()))
+	if debugConfig, err := getAndTransformConfig(inputPath, overlayDir, redactingInterpolator); err == nil {
+		_ = os.WriteFile(outputPath, []byte(debugConfig), 0444)
+	}
 
-	// 3. Execute Envoy with the provided configuration
+	// 4. Execute Envoy with the provided configuration
 	args := []string{envoyExecutable, "--config-yaml", bootstrapConfig}
 	if len(os.Args) > 1 {
 		args = append(args, os.Args[1:]...)
@@ -69,6 +91,18 @@ func GetOutputConfigPath() string {
 	return getEnvOrDefault(outputConfigPathEnv, defaultOutputConfigPath)
 }
 
+// GetOverlayDir returns the path to a directory containing bootstrap configuration fragments, which are merged into
+// the bootstrap configuration in lexical order
+func GetOverlayDir() string {
+	return getEnvOrDefault(overlayDirEnv, defaultOverlayDir)
+}
+
+// GetValidate returns whether to validate the bootstrap configuration, which is true unless disabled
+func GetValidate() bool {
+	validate, err := strconv.ParseBool(os.Getenv(validateEnv))
+	return err != nil || validate
+}
+
 // GetEnvoyExecutable returns the Envoy executable
 func GetEnvoyExecutable() string {
 	return getEnvOrDefault(envoyExecutableEnv, defaultEnvoyExecutable)
@@ -83,9 +117,9 @@ func getEnvOrDefault(envName, defaultValue string) string {
 	return defaultValue
 }
 
-// getAndTransformConfig reads a file, transforms it using the Downward API
-// and returns the transformed configuration
-func getAndTransformConfig(inputFile string) (string, error) {
+// getAndTransformConfig reads a file, transforms it using the Downward API, and merges in the overlays in the
+// directory, which are transformed in the same way
+func getAndTransformConfig(inputFile, overlayDir string, interpolator downward.Interpolator) (string, error) {
 	inReader, err := os.Open(inputFile)
 	if err != nil {
 		return "", err
@@ -93,9 +127,26 @@ func getAndTransformConfig(inputFile string) (string, error) {
 	defer inReader.Close()
 
 	var buffer bytes.Buffer
-	err = downward.Transform(inReader, &buffer)
+	err = interpolator.InterpolateIO(inReader, &buffer, downward.RetrieveDownwardAPI())
 	if err != nil {
 		return "", err
 	}
-	return buffer.String(), nil
+	return mergeOverlays(buffer.String(), overlayDir, interpolator)
+}
+
+// mergeOverlays reads the overlays in a directory, transforms them using the Downward API and merges them into the
+// configuration
+func mergeOverlays(bootstrapConfig, overlayDir string, interpolator downward.Interpolator) (string, error) {
+	overlays, err := bootstrap.ReadOverlays(overlayDir)
+	if err != nil {
+		return "", err
+	}
+	for i, overlay := range overlays {
+		var buffer bytes.Buffer
+		if err := interpolator.Interpolate(overlay.Config, &buffer, downward.RetrieveDownwardAPI()); err != nil {
+			return "", bootstrap.InvalidOverlayErr(err, overlay.Name)
+		}
+		overlays[i].Config = buffer.String()
+	}
+	return bootstrap.MergeOverlays(bootstrapConfig, overlays)
 }
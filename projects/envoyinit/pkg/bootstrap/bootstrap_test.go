@@ -0,0 +1,160 @@
+package bootstrap_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	// register the types used in typed configs
+	_ "github.com/solo-io/gloo/projects/envoyinit/hack/filter_types"
+
+	. "github.com/solo-io/gloo/projects/envoyinit/pkg/bootstrap"
+)
+
+const baseConfig = `
+node:
+  id: gateway-proxy
+  cluster: gateway
+admin:
+  address:
+    socket_address: { address: 127.0.0.1, port_value: 19000 }
+static_resources:
+  clusters:
+  - name: xds_cluster
+    connect_timeout: 5s
+    type: STRICT_DNS
+    load_assignment:
+      cluster_name: xds_cluster
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address: { address: gloo, port_value: 9977 }
+`
+
+var _ = Describe("Bootstrap", func() {
+
+	Context("overlays", func() {
+
+		It("should append static clusters and replace admin settings", func() {
+			merged, err := MergeOverlays(baseConfig, []Overlay{
+				{
+					Name: "admin.yaml",
+					Config: `
+admin:
+  address:
+    socket_address: { address: 0.0.0.0, port_value: 19001 }
+`,
+				},
+				{
+					Name: "clusters.yaml",
+					Config: `
+static_resources:
+  clusters:
+  - name: extra_cluster
+    connect_timeout: 1s
+    type: STATIC
+`,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			bootstrap, err := Parse(merged)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bootstrap.GetNode().GetId()).To(Equal("gateway-proxy"))
+			Expect(bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetAddress()).To(Equal("0.0.0.0"))
+			Expect(bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue()).To(BeEquivalentTo(19001))
+			clusters := bootstrap.GetStaticResources().GetClusters()
+			Expect(clusters).To(HaveLen(2))
+			Expect(clusters[0].GetName()).To(Equal("xds_cluster"))
+			Expect(clusters[1].GetName()).To(Equal("extra_cluster"))
+		})
+
+		It("should leave the config unchanged without overlays", func() {
+			merged, err := MergeOverlays(baseConfig, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal(baseConfig))
+		})
+
+		It("should name the overlay which is invalid", func() {
+			_, err := MergeOverlays(baseConfig, []Overlay{{Name: "bad.yaml", Config: "admin: { unknown_field: true }"}})
+			Expect(err).To(MatchError(ContainSubstring("parsing bootstrap overlay bad.yaml")))
+		})
+
+		It("should read the yaml and json files in a directory in order", func() {
+			dir, err := ioutil.TempDir("", "overlays")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			for name, contents := range map[string]string{
+				"2-clusters.yaml": "clusters",
+				"1-admin.json":    "admin",
+				"README.md":       "ignored",
+			} {
+				Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644)).To(Succeed())
+			}
+			Expect(os.Mkdir(filepath.Join(dir, "..data"), 0755)).To(Succeed())
+
+			overlays, err := ReadOverlays(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overlays).To(Equal([]Overlay{
+				{Name: "1-admin.json", Config: "admin"},
+				{Name: "2-clusters.yaml", Config: "clusters"},
+			}))
+		})
+
+		It("should not read overlays from a directory which does not exist", func() {
+			overlays, err := ReadOverlays("/does/not/exist")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overlays).To(BeEmpty())
+		})
+	})
+
+	Context("validation", func() {
+
+		It("should accept a valid config", func() {
+			config, err := ioutil.ReadFile("../../hack/envoy.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(Validate(string(config))).To(Succeed())
+		})
+
+		It("should reject unknown fields", func() {
+			err := Validate(baseConfig + "unknown_field: true\n")
+			Expect(err).To(MatchError(ContainSubstring("invalid bootstrap config")))
+		})
+
+		It("should reject typed configs whose type is not compiled in", func() {
+			err := Validate(baseConfig + `
+  listeners:
+  - name: listener
+    address:
+      socket_address: { address: 0.0.0.0, port_value: 8080 }
+    filter_chains:
+    - filters:
+      - name: unknown
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.unknown.v3.Unknown
+`)
+			Expect(err).To(MatchError(ContainSubstring("envoy.extensions.filters.network.unknown.v3.Unknown")))
+		})
+
+		It("should reject invalid values", func() {
+			err := Validate(baseConfig + `
+  - name: ""
+    connect_timeout: 1s
+`)
+			Expect(err).To(MatchError(ContainSubstring("invalid bootstrap config")))
+		})
+
+		It("should reject duplicate static clusters", func() {
+			err := Validate(baseConfig + `
+  - name: xds_cluster
+    connect_timeout: 1s
+    type: STATIC
+`)
+			Expect(err).To(MatchError(DuplicateStaticResourceErr("clusters", "xds_cluster")))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	envoy_config_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+)
+
+var (
+	InvalidOverlayErr = func(err error, name string) error {
+		return eris.Wrapf(err, "parsing bootstrap overlay %v", name)
+	}
+)
+
+// Overlay is a fragment of bootstrap configuration which is merged into the bootstrap
+type Overlay struct {
+	// Name identifies the overlay in errors, such as the name of the file it was read from
+	Name   string
+	Config string
+}
+
+// ReadOverlays returns the .yaml, .yml and .json files in the directory, in lexical order. Returns no overlays if the
+// directory does not exist.
+func ReadOverlays(dir string) ([]Overlay, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var overlays []Overlay
+	for _, entry := range entries {
+		// mounted ConfigMaps contain symlinked ..data directories, which are skipped
+		if entry.IsDir() || entry.Name()[0] == '.' {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		config, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		overlays = append(overlays, Overlay{Name: entry.Name(), Config: string(config)})
+	}
+	return overlays, nil
+}
+
+// MergeOverlays merges the overlays into the bootstrap config, in order, and returns the merged config as yaml.
+// Fields which are set in an overlay replace those in the config, while lists such as static clusters are appended to.
+func MergeOverlays(config string, overlays []Overlay) (string, error) {
+	if len(overlays) == 0 {
+		return config, nil
+	}
+
+	bootstrap, err := Parse(config)
+	if err != nil {
+		return "", err
+	}
+	for _, overlay := range overlays {
+		var fragment envoy_config_bootstrap.Bootstrap
+		if err := protoutils.UnmarshalYaml([]byte(overlay.Config), &fragment); err != nil {
+			return "", InvalidOverlayErr(err, overlay.Name)
+		}
+		proto.Merge(bootstrap, &fragment)
+	}
+
+	jsn, err := protoutils.MarshalBytes(bootstrap)
+	if err != nil {
+		return "", err
+	}
+	merged, err := yaml.JSONToYAML(jsn)
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
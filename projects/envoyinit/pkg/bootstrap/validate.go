@@ -0,0 +1,59 @@
+package bootstrap
+
+import (
+	envoy_config_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+)
+
+var (
+	InvalidBootstrapErr = func(err error) error {
+		return eris.Wrap(err, "invalid bootstrap config")
+	}
+	DuplicateStaticResourceErr = func(kind, name string) error {
+		return eris.Errorf("invalid bootstrap config: multiple static %v are named %v", kind, name)
+	}
+)
+
+// Parse parses the yaml or json bootstrap config. The typed configs within it may only use types which are registered
+// with the proto registry, such as those registered by importing projects/envoyinit/hack/filter_types.
+func Parse(config string) (*envoy_config_bootstrap.Bootstrap, error) {
+	var bootstrap envoy_config_bootstrap.Bootstrap
+	if err := protoutils.UnmarshalYaml([]byte(config), &bootstrap); err != nil {
+		return nil, InvalidBootstrapErr(err)
+	}
+	return &bootstrap, nil
+}
+
+// Validate returns an error describing why Envoy would reject the bootstrap config, so that it can be reported
+// rather than Envoy crash-looping. This includes fields with unknown names or invalid values, typed configs whose
+// type is not registered, and static listeners or clusters with duplicate names.
+func Validate(config string) error {
+	bootstrap, err := Parse(config)
+	if err != nil {
+		return err
+	}
+	if err := bootstrap.Validate(); err != nil {
+		return InvalidBootstrapErr(err)
+	}
+
+	listeners := map[string]bool{}
+	for _, listener := range bootstrap.GetStaticResources().GetListeners() {
+		// envoy generates names for static listeners which are not named
+		if listener.GetName() == "" {
+			continue
+		}
+		if listeners[listener.GetName()] {
+			return DuplicateStaticResourceErr("listeners", listener.GetName())
+		}
+		listeners[listener.GetName()] = true
+	}
+	clusters := map[string]bool{}
+	for _, cluster := range bootstrap.GetStaticResources().GetClusters() {
+		if clusters[cluster.GetName()] {
+			return DuplicateStaticResourceErr("clusters", cluster.GetName())
+		}
+		clusters[cluster.GetName()] = true
+	}
+	return nil
+}
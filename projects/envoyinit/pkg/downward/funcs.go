@@ -0,0 +1,95 @@
+package downward
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	// Environment variable for the directory which Kubernetes Secrets are mounted in, one subdirectory per secret
+	secretsDirEnv     = "SECRETS_DIR"
+	defaultSecretsDir = "/etc/envoy/secrets/"
+
+	// RedactedSecret is what {{ secret "name" "key" }} returns in place of a secret when secrets are redacted
+	RedactedSecret = "[REDACTED]"
+)
+
+var (
+	ReadFileErr = func(err error, path string) error {
+		return eris.Wrapf(err, "reading file %v for bootstrap template", path)
+	}
+	ReadSecretErr = func(err error, name, key string) error {
+		return eris.Wrapf(err, "reading key %v of secret %v for bootstrap template", key, name)
+	}
+)
+
+// DefaultTemplateFuncs returns the TemplateFuncs which read the environment of the process, and the secrets mounted in
+// the directory named by the SECRETS_DIR environment variable
+func DefaultTemplateFuncs() template.FuncMap {
+	secretsDir := os.Getenv(secretsDirEnv)
+	if secretsDir == "" {
+		secretsDir = defaultSecretsDir
+	}
+	return TemplateFuncs(os.Getenv, ioutil.ReadFile, secretsDir)
+}
+
+// TemplateFuncs returns the functions which templates may use in addition to the Downward API values:
+//
+//	{{ env "NAME" }} and {{ env "NAME" "default" }} return the value of an environment variable
+//	{{ file "/path/to/file" }} returns the contents of a file, without trailing newlines
+//	{{ secret "name" "key" }} returns a key of a Kubernetes Secret mounted in a subdirectory of secretsDir
+//	{{ quote "value" }} returns the value as a quoted string, so that multi-line values such as PEMs can be inlined
+func TemplateFuncs(getenv func(string) string, readFile func(string) ([]byte, error), secretsDir string) template.FuncMap {
+	read := func(path string) (string, error) {
+		data, err := readFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return template.FuncMap{
+		"env": func(name string, defaultValue ...string) string {
+			if value := getenv(name); value != "" || len(defaultValue) == 0 {
+				return value
+			}
+			return defaultValue[0]
+		},
+		"file": func(path string) (string, error) {
+			contents, err := read(path)
+			if err != nil {
+				return "", ReadFileErr(err, path)
+			}
+			return contents, nil
+		},
+		"secret": func(name, key string) (string, error) {
+			contents, err := read(filepath.Join(secretsDir, name, key))
+			if err != nil {
+				return "", ReadSecretErr(err, name, key)
+			}
+			return contents, nil
+		},
+		"quote": func(value string) (string, error) {
+			quoted, err := json.Marshal(value)
+			return string(quoted), err
+		},
+	}
+}
+
+// RedactSecrets returns the functions with {{ secret "name" "key" }} replaced by one which returns RedactedSecret, so
+// that a template can be rendered, such as for debugging, without the values of the secrets it reads
+func RedactSecrets(funcs template.FuncMap) template.FuncMap {
+	redacted := template.FuncMap{}
+	for name, fn := range funcs {
+		redacted[name] = fn
+	}
+	redacted["secret"] = func(name, key string) string {
+		return RedactedSecret
+	}
+	return redacted
+}
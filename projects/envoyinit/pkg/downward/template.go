@@ -13,11 +13,19 @@ type Interpolator interface {
 	InterpolateString(*string, DownwardAPI) error
 }
 
+// NewInterpolator returns an interpolator whose templates may use the DefaultTemplateFuncs
 func NewInterpolator() Interpolator {
-	return &interpolator{}
+	return NewInterpolatorWithFuncs(DefaultTemplateFuncs())
 }
 
-type interpolator struct{}
+// NewInterpolatorWithFuncs returns an interpolator whose templates may use the given functions
+func NewInterpolatorWithFuncs(funcs template.FuncMap) Interpolator {
+	return &interpolator{funcs: funcs}
+}
+
+type interpolator struct {
+	funcs template.FuncMap
+}
 
 func (i *interpolator) InterpolateIO(in io.Reader, out io.Writer, data DownwardAPI) error {
 	inbyte, err := ioutil.ReadAll(in)
@@ -28,8 +36,8 @@ func (i *interpolator) InterpolateIO(in io.Reader, out io.Writer, data DownwardA
 	return i.Interpolate(string(inbyte), out, data)
 }
 
-func (*interpolator) Interpolate(tmpl string, out io.Writer, data DownwardAPI) error {
-	t, err := template.New("template").Option("missingkey=zero").Parse(tmpl)
+func (i *interpolator) Interpolate(tmpl string, out io.Writer, data DownwardAPI) error {
+	t, err := template.New("template").Option("missingkey=zero").Funcs(i.funcs).Parse(tmpl)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,8 @@
 package downward_test
 
 import (
+	"os"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -89,4 +91,67 @@ var _ = Describe("Template", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	Context("template funcs", func() {
+		var (
+			getenv   func(string) string
+			readFile func(string) ([]byte, error)
+		)
+
+		BeforeEach(func() {
+			env := map[string]string{"CLUSTER": "mock-cluster"}
+			files := map[string]string{
+				"/etc/config/region":             "us-east-1\n",
+				"/etc/envoy/secrets/tls/tls.crt": "line1\nline2\n",
+			}
+			getenv = func(name string) string { return env[name] }
+			readFile = func(path string) ([]byte, error) {
+				contents, ok := files[path]
+				if !ok {
+					return nil, os.ErrNotExist
+				}
+				return []byte(contents), nil
+			}
+			interpolator = NewInterpolatorWithFuncs(TemplateFuncs(getenv, readFile, "/etc/envoy/secrets"))
+		})
+
+		It("should interpolate env vars", func() {
+			s := `{{ env "CLUSTER" }}.{{ env "MISSING" }}.{{ env "MISSING" "default" }}`
+			err := interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(Equal("mock-cluster..default"))
+		})
+
+		It("should interpolate files without trailing newlines", func() {
+			s := `{{ file "/etc/config/region" }}`
+			err := interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(Equal("us-east-1"))
+		})
+
+		It("should interpolate quoted secrets", func() {
+			s := `{{ secret "tls" "tls.crt" | quote }}`
+			err := interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(Equal(`"line1\nline2"`))
+		})
+
+		It("should error on missing files and secrets", func() {
+			s := `{{ file "/missing" }}`
+			err := interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).To(MatchError(ContainSubstring("reading file /missing for bootstrap template")))
+
+			s = `{{ secret "tls" "missing" }}`
+			err = interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).To(MatchError(ContainSubstring("reading key missing of secret tls for bootstrap template")))
+		})
+
+		It("should redact secrets", func() {
+			interpolator = NewInterpolatorWithFuncs(RedactSecrets(TemplateFuncs(getenv, readFile, "/etc/envoy/secrets")))
+			s := `{{ env "CLUSTER" }}: {{ secret "tls" "tls.crt" | quote }}, {{ secret "tls" "missing" }}`
+			err := interpolator.InterpolateString(&s, downwardMock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(Equal(`mock-cluster: "[REDACTED]", [REDACTED]`))
+		})
+	})
+
 })
@@ -325,7 +325,13 @@ func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, r
 		dryRun = *req.DryRun
 	}
 
-	reports, validationErrs := wh.validate(ctx, gvk, ref, req.Object.Raw, isDelete, dryRun)
+	rawJson := req.Object.Raw
+	if isDelete {
+		// the object is only set on the old object for DELETE operations
+		rawJson = req.OldObject.Raw
+	}
+
+	reports, validationErrs := wh.validate(ctx, gvk, ref, rawJson, isDelete, dryRun)
 
 	hasUnmarshalErr := false
 	if validationErrs != nil {
@@ -424,12 +430,40 @@ func (wh *gatewayValidationWebhook) validate(
 			return wh.validateUpstream(ctx, rawJson, dryRun)
 		}
 	case gloov1.SecretGVK:
-		// We only support validation of secrets for DELETE operations
 		if isDelete {
 			err := wh.validator.ValidateDeleteSecret(ctx, ref, dryRun)
 			if err != nil {
 				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
 			}
+		} else {
+			return wh.validateGlooResource(ctx, gvk, rawJson, dryRun)
+		}
+	case validation.KubeSecretGVK, validation.KubeConfigMapGVK:
+		if isDelete {
+			// only validate the deletion of secrets which gloo reads, e.g. skip service account tokens
+			if len(rawJson) > 0 {
+				if resource, err := validation.UnmarshalGlooResource(gvk, rawJson); err != nil || resource == nil {
+					break
+				}
+			}
+			err := wh.validator.ValidateDeleteGlooResource(ctx, gvk, ref, dryRun)
+			if err != nil {
+				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
+			}
+		} else {
+			return wh.validateGlooResource(ctx, gvk, rawJson, dryRun)
+		}
+	default:
+		if !validation.IsGlooResource(gvk) {
+			break
+		}
+		if isDelete {
+			err := wh.validator.ValidateDeleteGlooResource(ctx, gvk, ref, dryRun)
+			if err != nil {
+				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
+			}
+		} else {
+			return wh.validateGlooResource(ctx, gvk, rawJson, dryRun)
 		}
 	}
 	return &validation.Reports{}, nil
@@ -522,3 +556,25 @@ func (wh *gatewayValidationWebhook) validateUpstream(ctx context.Context, rawJso
 	}
 	return reports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateGlooResource(ctx context.Context, gvk schema.GroupVersionKind, rawJson []byte, dryRun bool) (*validation.Reports, *multierror.Error) {
+	var (
+		reports *validation.Reports
+		err     error
+	)
+	resource, err := validation.UnmarshalGlooResource(gvk, rawJson)
+	if err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if resource == nil {
+		// not a resource read by gloo, e.g. a kubernetes secret of a type gloo ignores
+		return nil, nil
+	}
+	if skipValidationCheck(resource.GetMetadata().GetAnnotations()) {
+		return nil, nil
+	}
+	if reports, err = wh.validator.ValidateGlooResource(ctx, resource, dryRun); err != nil {
+		return reports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", resource)}}
+	}
+	return reports, nil
+}
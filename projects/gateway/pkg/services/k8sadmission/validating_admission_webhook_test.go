@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
@@ -29,6 +30,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -79,6 +81,32 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		},
 	}
 
+	upstreamGroup := &gloov1.UpstreamGroup{
+		Metadata: &core.Metadata{
+			Name:      "ug",
+			Namespace: "namespace",
+		},
+		Destinations: []*gloov1.WeightedDestination{{
+			Destination: &gloov1.Destination{
+				DestinationType: &gloov1.Destination_Upstream{
+					Upstream: upstream.GetMetadata().Ref(),
+				},
+			},
+			Weight: 1,
+		}},
+	}
+	authConfig := &extauth.AuthConfig{
+		Metadata: &core.Metadata{
+			Name:      "ac",
+			Namespace: "namespace",
+		},
+		Configs: []*extauth.AuthConfig_Config{{
+			AuthConfig: &extauth.AuthConfig_Config_BasicAuth{
+				BasicAuth: &extauth.BasicAuth{Realm: "gloo"},
+			},
+		}},
+	}
+
 	unstructuredList := unstructured.UnstructuredList{
 		Object: map[string]interface{}{
 			"kind":    "List",
@@ -122,6 +150,12 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateDeleteSecret = func(ctx context.Context, secret *core.ResourceRef, dryRun bool) error {
 				return fmt.Errorf(errMsg)
 			}
+			mv.fValidateGlooResource = func(ctx context.Context, resource resources.Resource, dryRun bool) (*validation.Reports, error) {
+				return reports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteGlooResource = func(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error {
+				return fmt.Errorf(errMsg)
+			}
 		}
 		req, err := makeReviewRequest(srv.URL, crd, gvk, op, resourceOrRef)
 
@@ -160,8 +194,116 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid upstream deletion", false, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Delete, upstream.GetMetadata().Ref()),
 		Entry("valid secret deletion", true, gloov1.SecretCrd, gloov1.SecretCrd.GroupVersionKind(), v1beta1.Delete, secret.GetMetadata().Ref()),
 		Entry("invalid secret deletion", false, gloov1.SecretCrd, gloov1.SecretCrd.GroupVersionKind(), v1beta1.Delete, secret.GetMetadata().Ref()),
+		Entry("valid upstream group", true, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Create, upstreamGroup),
+		Entry("invalid upstream group", false, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Create, upstreamGroup),
+		Entry("valid upstream group deletion", true, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Delete, upstreamGroup.GetMetadata().Ref()),
+		Entry("invalid upstream group deletion", false, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Delete, upstreamGroup.GetMetadata().Ref()),
+		Entry("valid auth config", true, extauth.AuthConfigCrd, extauth.AuthConfigCrd.GroupVersionKind(), v1beta1.Create, authConfig),
+		Entry("invalid auth config", false, extauth.AuthConfigCrd, extauth.AuthConfigCrd.GroupVersionKind(), v1beta1.Create, authConfig),
+		Entry("valid auth config deletion", true, extauth.AuthConfigCrd, extauth.AuthConfigCrd.GroupVersionKind(), v1beta1.Delete, authConfig.GetMetadata().Ref()),
+		Entry("invalid auth config deletion", false, extauth.AuthConfigCrd, extauth.AuthConfigCrd.GroupVersionKind(), v1beta1.Delete, authConfig.GetMetadata().Ref()),
+		Entry("valid rate limit config deletion", true, nil, ratelimit.RateLimitConfigGVK, v1beta1.Delete, &core.ResourceRef{Name: "rlc", Namespace: "namespace"}),
+		Entry("invalid rate limit config deletion", false, nil, ratelimit.RateLimitConfigGVK, v1beta1.Delete, &core.ResourceRef{Name: "rlc", Namespace: "namespace"}),
 	)
 
+	Context("kubernetes secrets and config maps", func() {
+
+		var (
+			validated []resources.Resource
+			deleted   []schema.GroupVersionKind
+		)
+
+		BeforeEach(func() {
+			validated = nil
+			deleted = nil
+			wh.webhookNamespace = "namespace"
+			mv.fValidateGlooResource = func(ctx context.Context, resource resources.Resource, dryRun bool) (*validation.Reports, error) {
+				validated = append(validated, resource)
+				return reports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteGlooResource = func(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error {
+				deleted = append(deleted, gvk)
+				return fmt.Errorf(errMsg)
+			}
+		})
+
+		tlsSecret := &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "namespace"},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		}
+		tokenSecret := &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "namespace"},
+			Type:       corev1.SecretTypeServiceAccountToken,
+			Data:       map[string][]byte{"token": []byte("token")},
+		}
+		configMap := &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "artifact", Namespace: "namespace"},
+			Data:       map[string]string{"key": "value"},
+		}
+
+		review := func(gvk schema.GroupVersionKind, op v1beta1.Operation, obj metav1.Object) *AdmissionReviewWithProxies {
+			raw, err := json.Marshal(obj)
+			Expect(err).NotTo(HaveOccurred())
+			if op == v1beta1.Delete {
+				// the api server only sets the old object when deleting
+				req, err := makeReviewRequestRawWithOldObject(srv.URL, gvk, op, obj.GetName(), obj.GetNamespace(), raw)
+				Expect(err).NotTo(HaveOccurred())
+				return doReviewRequest(srv, req)
+			}
+			req, err := makeReviewRequestRawJsonEncoded(srv.URL, gvk, op, obj.GetName(), obj.GetNamespace(), raw, false)
+			Expect(err).NotTo(HaveOccurred())
+			return doReviewRequest(srv, req)
+		}
+
+		It("validates kubernetes secrets as gloo secrets", func() {
+			res := review(validation.KubeSecretGVK, v1beta1.Create, tlsSecret)
+			Expect(res.Response.Allowed).To(BeFalse())
+			Expect(res.Response.Result.Message).To(ContainSubstring(errMsg))
+
+			Expect(validated).To(HaveLen(1))
+			secret, ok := validated[0].(*gloov1.Secret)
+			Expect(ok).To(BeTrue())
+			Expect(secret.GetMetadata().Ref()).To(Equal(&core.ResourceRef{Name: "tls", Namespace: "namespace"}))
+			Expect(secret.GetTls().GetCertChain()).To(Equal("cert"))
+			Expect(secret.GetTls().GetPrivateKey()).To(Equal("key"))
+		})
+
+		It("validates the deletion of kubernetes secrets", func() {
+			res := review(validation.KubeSecretGVK, v1beta1.Delete, tlsSecret)
+			Expect(res.Response.Allowed).To(BeFalse())
+			Expect(deleted).To(Equal([]schema.GroupVersionKind{validation.KubeSecretGVK}))
+		})
+
+		It("does not validate kubernetes secrets which gloo does not read", func() {
+			Expect(review(validation.KubeSecretGVK, v1beta1.Create, tokenSecret).Response.Allowed).To(BeTrue())
+			Expect(review(validation.KubeSecretGVK, v1beta1.Delete, tokenSecret).Response.Allowed).To(BeTrue())
+			Expect(validated).To(BeEmpty())
+			Expect(deleted).To(BeEmpty())
+		})
+
+		It("validates kubernetes config maps as artifacts", func() {
+			res := review(validation.KubeConfigMapGVK, v1beta1.Update, configMap)
+			Expect(res.Response.Allowed).To(BeFalse())
+
+			Expect(validated).To(HaveLen(1))
+			artifact, ok := validated[0].(*gloov1.Artifact)
+			Expect(ok).To(BeTrue())
+			Expect(artifact.GetMetadata().Ref()).To(Equal(&core.ResourceRef{Name: "artifact", Namespace: "namespace"}))
+			Expect(artifact.GetData()).To(Equal(map[string]string{"key": "value"}))
+
+			res = review(validation.KubeConfigMapGVK, v1beta1.Delete, configMap)
+			Expect(res.Response.Allowed).To(BeFalse())
+			Expect(deleted).To(Equal([]schema.GroupVersionKind{validation.KubeConfigMapGVK}))
+		})
+	})
+
 	Context("invalid yaml", func() {
 
 		invalidYamlTests := func(useYamlEncoding bool) {
@@ -347,6 +489,44 @@ func makeReviewRequestRaw(url string, gvk schema.GroupVersionKind, operation v1b
 	return req, nil
 }
 
+func makeReviewRequestRawWithOldObject(url string, gvk schema.GroupVersionKind, operation v1beta1.Operation, name, namespace string, oldRaw []byte) (*http.Request, error) {
+	review := v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			UID: "1234",
+			Kind: metav1.GroupVersionKind{
+				Group:   gvk.Group,
+				Version: gvk.Version,
+				Kind:    gvk.Kind,
+			},
+			Name:      name,
+			Namespace: namespace,
+			Operation: operation,
+			OldObject: runtime.RawExtension{
+				Raw: oldRaw,
+			},
+		},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url+"/validation", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-type", ApplicationJson)
+	return req, nil
+}
+
+func doReviewRequest(srv *httptest.Server, req *http.Request) *AdmissionReviewWithProxies {
+	res, err := srv.Client().Do(req)
+	Expect(err).NotTo(HaveOccurred())
+	review, err := parseReviewResponse(res)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(review.Response).NotTo(BeNil())
+	return review
+}
+
 func parseReviewResponse(resp *http.Response) (*AdmissionReviewWithProxies, error) {
 	var review AdmissionReviewWithProxies
 	if err := json.NewDecoder(resp.Body).Decode(&review); err != nil {
@@ -376,6 +556,8 @@ type mockValidator struct {
 	fValidateUpstream             func(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*validation.Reports, error)
 	fValidateDeleteUpstream       func(ctx context.Context, us *core.ResourceRef, dryRun bool) error
 	fValidateDeleteSecret         func(ctx context.Context, secret *core.ResourceRef, dryRun bool) error
+	fValidateGlooResource         func(ctx context.Context, resource resources.Resource, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteGlooResource   func(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *gloov1snap.ApiSnapshot) error {
@@ -449,6 +631,20 @@ func (v *mockValidator) ValidateDeleteSecret(ctx context.Context, secret *core.R
 	return v.fValidateDeleteSecret(ctx, secret, dryRun)
 }
 
+func (v *mockValidator) ValidateGlooResource(ctx context.Context, resource resources.Resource, dryRun bool) (*validation.Reports, error) {
+	if v.fValidateGlooResource == nil {
+		return reports(), nil
+	}
+	return v.fValidateGlooResource(ctx, resource, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteGlooResource(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteGlooResource == nil {
+		return nil
+	}
+	return v.fValidateDeleteGlooResource(ctx, gvk, ref, dryRun)
+}
+
 func reports() *validation.Reports {
 	return &validation.Reports{
 		ProxyReports: &validation.ProxyReports{
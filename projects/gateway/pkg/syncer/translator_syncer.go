@@ -79,6 +79,12 @@ func NewTranslatorSyncer(ctx context.Context, writeNamespace string, proxyWatche
 
 // TODO (ilackarms): make sure that sync happens if proxies get updated as well; may need to resync
 func (s *TranslatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	return s.SyncWithCertExpiryWarnings(ctx, snap, nil)
+}
+
+// SyncWithCertExpiryWarnings is Sync, which also adds the cert expiry warnings, keyed by secret ref, to the reports
+// of the virtual services serving those secrets
+func (s *TranslatorSyncer) SyncWithCertExpiryWarnings(ctx context.Context, snap *v1.ApiSnapshot, certExpiryWarnings map[string]string) error {
 	ctx = contextutils.WithLogger(ctx, "TranslatorSyncer")
 
 	logger := contextutils.LoggerFrom(ctx)
@@ -95,6 +101,12 @@ func (s *TranslatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error
 	}
 
 	desiredProxies, invalidProxies := s.GeneratedDesiredProxies(ctx, snap)
+	for _, reports := range desiredProxies {
+		addVirtualServiceCertExpiryWarnings(reports, certExpiryWarnings)
+	}
+	for _, reports := range invalidProxies {
+		addVirtualServiceCertExpiryWarnings(reports, certExpiryWarnings)
+	}
 
 	return s.reconcile(ctx, desiredProxies, invalidProxies)
 }
@@ -141,6 +153,24 @@ func (s *TranslatorSyncer) GeneratedDesiredProxies(ctx context.Context, snap *v1
 	return desiredProxies, invalidProxies
 }
 
+// addVirtualServiceCertExpiryWarnings warns on each virtual service in the reports whose ssl config uses a secret
+// with a cert expiry warning
+func addVirtualServiceCertExpiryWarnings(reports reporter.ResourceReports, certExpiryWarnings map[string]string) {
+	for resource := range reports {
+		virtualService, ok := resource.(*v1.VirtualService)
+		if !ok {
+			continue
+		}
+		ref := virtualService.GetSslConfig().GetSecretRef()
+		if ref == nil {
+			continue
+		}
+		if warning, ok := certExpiryWarnings[ref.Key()]; ok {
+			reports.AddWarning(virtualService, warning)
+		}
+	}
+}
+
 func (s *TranslatorSyncer) shouldCompresss(ctx context.Context) bool {
 	return settingsutil.MaybeFromContext(ctx).GetGateway().GetCompressedProxySpec()
 }
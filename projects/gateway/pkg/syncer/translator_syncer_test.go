@@ -20,6 +20,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	gloomocks "github.com/solo-io/gloo/projects/gloo/pkg/mocks"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -417,10 +418,44 @@ var _ = Describe("TranslatorSyncer", func() {
 			ts.GeneratedDesiredProxies(ctx, snap)
 			Expect(proxy.Metadata.Annotations).NotTo(HaveKey(compress.ShortenKey))
 		})
+
+		It("adds cert expiry warnings to the reports of the virtual services serving the secrets", func() {
+			secretRef := &core.ResourceRef{Name: "expiring", Namespace: "gloo-system"}
+			tlsVs := &gatewayv1.VirtualService{
+				Metadata:  &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+				SslConfig: &gloov1.SslConfig{SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: secretRef}},
+			}
+			plainVs := &gatewayv1.VirtualService{
+				Metadata: &core.Metadata{Name: "plain", Namespace: "gloo-system"},
+			}
+			reports := reporter.ResourceReports{}
+			reports.Accept(tlsVs, plainVs)
+			snap.Gateways[0].Metadata = &core.Metadata{Name: "gateway", Namespace: "gloo-system"}
+			mockTranslator.EXPECT().Translate(gomock.Any(), "gateway-proxy", "gloo-system", snap, gomock.Any()).
+				Return(proxy, reports)
+			proxyReconciler := &fakeProxyReconciler{}
+			ts.proxyReconciler = proxyReconciler
+			ts.statusSyncer = newStatusSyncer("gloo-system", nil, nil, nil, metrics.ConfigStatusMetrics{})
+
+			err := ts.SyncWithCertExpiryWarnings(ctx, snap, map[string]string{secretRef.Key(): "expiring soon"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(proxyReconciler.proxies[proxy][tlsVs].Warnings).To(ConsistOf("expiring soon"))
+			Expect(proxyReconciler.proxies[proxy][plainVs].Warnings).To(BeEmpty())
+		})
 	})
 
 })
 
+type fakeProxyReconciler struct {
+	proxies reconciler.GeneratedProxies
+}
+
+func (f *fakeProxyReconciler) ReconcileProxies(ctx context.Context, proxiesToWrite reconciler.GeneratedProxies, writeNamespace string, labelSelectorOptions clients.ListOpts) error {
+	f.proxies = proxiesToWrite
+	return nil
+}
+
 type fakeReporter struct {
 	reports  map[string]reporter.Report
 	statuses map[string]map[string]*core.Status
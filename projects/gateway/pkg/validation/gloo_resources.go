@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+
+	errors "github.com/rotisserie/eris"
+	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	graphqlv1beta1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
+	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// Secrets and Artifacts are stored in kubernetes Secrets and ConfigMaps when gloo reads them from kubernetes
+	KubeSecretGVK    = corev1.SchemeGroupVersion.WithKind("Secret")
+	KubeConfigMapGVK = corev1.SchemeGroupVersion.WithKind("ConfigMap")
+
+	UnsupportedGlooResourceErr = func(kind interface{}) error {
+		return errors.Errorf("%v is not a gloo resource supported by the Gloo validation server", kind)
+	}
+)
+
+// IsGlooResource returns true if resources of the given kind are validated by the Gloo validation server.
+func IsGlooResource(gvk schema.GroupVersionKind) bool {
+	switch gvk {
+	case gloov1.UpstreamGVK,
+		gloov1.UpstreamGroupGVK,
+		gloov1.SecretGVK,
+		gloov1.ArtifactGVK,
+		KubeSecretGVK,
+		KubeConfigMapGVK,
+		extauthv1.AuthConfigGVK,
+		ratelimit.RateLimitConfigGVK,
+		graphqlv1beta1.GraphQLApiGVK:
+		return true
+	}
+	return false
+}
+
+// UnmarshalGlooResource unmarshals the raw json of a gloo resource of the given kind.
+// Kubernetes Secrets and ConfigMaps are converted in the same way gloo converts them when reading from kubernetes;
+// nil is returned for Secrets which gloo would not read, e.g. because of their type.
+func UnmarshalGlooResource(gvk schema.GroupVersionKind, jsonBytes []byte) (resources.Resource, error) {
+	var resource resources.Resource
+	switch gvk {
+	case gloov1.UpstreamGVK:
+		resource = &gloov1.Upstream{}
+	case gloov1.UpstreamGroupGVK:
+		resource = &gloov1.UpstreamGroup{}
+	case gloov1.SecretGVK:
+		resource = &gloov1.Secret{}
+	case gloov1.ArtifactGVK:
+		resource = &gloov1.Artifact{}
+	case extauthv1.AuthConfigGVK:
+		resource = &extauthv1.AuthConfig{}
+	case graphqlv1beta1.GraphQLApiGVK:
+		resource = &graphqlv1beta1.GraphQLApi{}
+	case KubeSecretGVK:
+		var kubeSecret corev1.Secret
+		if err := json.Unmarshal(jsonBytes, &kubeSecret); err != nil {
+			return nil, err
+		}
+		secret, err := kubeconverters.KubeSecretToGlooSecret(context.Background(), &kubeSecret)
+		if err != nil || secret == nil {
+			return nil, err
+		}
+		return secret, nil
+	case KubeConfigMapGVK:
+		var configMap corev1.ConfigMap
+		if err := json.Unmarshal(jsonBytes, &configMap); err != nil {
+			return nil, err
+		}
+		return kubeconverters.KubeConfigMapToArtifact(&configMap), nil
+	case ratelimit.RateLimitConfigGVK:
+		// RateLimitConfigs are kubernetes resources rather than solo-kit resources
+		rlc := &ratelimit.RateLimitConfig{}
+		if err := json.Unmarshal(jsonBytes, (*v1alpha1.RateLimitConfig)(&rlc.RateLimitConfig)); err != nil {
+			return nil, err
+		}
+		return rlc, nil
+	default:
+		return nil, UnsupportedGlooResourceErr(gvk)
+	}
+	if err := skprotoutils.UnmarshalResource(jsonBytes, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func modifiedResourcesRequest(resource resources.Resource) (*validation.ModifiedResources, error) {
+	switch typed := resource.(type) {
+	case *gloov1.Upstream:
+		return &validation.ModifiedResources{Upstreams: []*gloov1.Upstream{typed}}, nil
+	case *gloov1.UpstreamGroup:
+		return &validation.ModifiedResources{UpstreamGroups: []*gloov1.UpstreamGroup{typed}}, nil
+	case *gloov1.Secret:
+		return &validation.ModifiedResources{Secrets: []*gloov1.Secret{typed}}, nil
+	case *gloov1.Artifact:
+		return &validation.ModifiedResources{Artifacts: []*gloov1.Artifact{typed}}, nil
+	case *extauthv1.AuthConfig:
+		return &validation.ModifiedResources{AuthConfigs: []*extauthv1.AuthConfig{typed}}, nil
+	case *graphqlv1beta1.GraphQLApi:
+		return &validation.ModifiedResources{GraphqlApis: []*graphqlv1beta1.GraphQLApi{typed}}, nil
+	case *ratelimit.RateLimitConfig:
+		return &validation.ModifiedResources{
+			RatelimitConfigs: []*validation.RateLimitConfigResource{{
+				Ref:  typed.GetMetadata().Ref(),
+				Spec: &typed.Spec,
+			}},
+		}, nil
+	}
+	return nil, UnsupportedGlooResourceErr(resources.Kind(resource))
+}
+
+func deletedResourcesRequest(gvk schema.GroupVersionKind, ref *core.ResourceRef) (*validation.DeletedResources, error) {
+	refs := []*core.ResourceRef{ref}
+	switch gvk {
+	case gloov1.UpstreamGVK:
+		return &validation.DeletedResources{UpstreamRefs: refs}, nil
+	case gloov1.UpstreamGroupGVK:
+		return &validation.DeletedResources{UpstreamGroupRefs: refs}, nil
+	case gloov1.SecretGVK, KubeSecretGVK:
+		return &validation.DeletedResources{SecretRefs: refs}, nil
+	case gloov1.ArtifactGVK, KubeConfigMapGVK:
+		return &validation.DeletedResources{ArtifactRefs: refs}, nil
+	case extauthv1.AuthConfigGVK:
+		return &validation.DeletedResources{AuthConfigRefs: refs}, nil
+	case ratelimit.RateLimitConfigGVK:
+		return &validation.DeletedResources{RatelimitConfigRefs: refs}, nil
+	case graphqlv1beta1.GraphQLApiGVK:
+		return &validation.DeletedResources{GraphqlApiRefs: refs}, nil
+	}
+	return nil, UnsupportedGlooResourceErr(gvk)
+}
@@ -33,6 +33,8 @@ type Reports struct {
 	Proxies         []*gloov1.Proxy
 	ProxyReports    *ProxyReports
 	UpstreamReports *UpstreamReports
+	// reports for the gloo resources other than upstreams which were validated
+	ResourceReports *ResourceReports
 }
 
 func (r *Reports) GetProxies() []*gloov1.Proxy {
@@ -44,6 +46,7 @@ func (r *Reports) GetProxies() []*gloov1.Proxy {
 
 type ProxyReports []*validation.ProxyReport
 type UpstreamReports []*validation.ResourceReport
+type ResourceReports []*validation.ResourceReport
 
 var (
 	NotReadyErr = errors.Errorf("validation is not yet available. Waiting for first snapshot")
@@ -89,6 +92,8 @@ type Validator interface {
 	ValidateUpstream(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*Reports, error)
 	ValidateDeleteUpstream(ctx context.Context, us *core.ResourceRef, dryRun bool) error
 	ValidateDeleteSecret(ctx context.Context, secret *core.ResourceRef, dryRun bool) error
+	ValidateGlooResource(ctx context.Context, resource resources.Resource, dryRun bool) (*Reports, error)
+	ValidateDeleteGlooResource(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error
 }
 
 type ValidatorFunc = func(
@@ -460,9 +465,17 @@ func (v *validator) processItem(ctx context.Context, item unstructured.Unstructu
 			return &Reports{ProxyReports: &ProxyReports{}}, WrappedUnmarshalErr(unmarshalErr)
 		}
 		return v.validateRouteTableInternal(ctx, &rt, false, false)
-
-	case gloov1.UpstreamGVK:
-		// TODO(mitchaman): Handle upstreams
+	}
+	if IsGlooResource(itemGvk) {
+		resource, unmarshalErr := UnmarshalGlooResource(itemGvk, jsonBytes)
+		if unmarshalErr != nil {
+			return &Reports{ProxyReports: &ProxyReports{}}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		if resource == nil {
+			// not a resource read by gloo, e.g. a kubernetes secret of a type gloo ignores
+			return &Reports{ProxyReports: &ProxyReports{}}, nil
+		}
+		return v.ValidateGlooResource(ctx, resource, false)
 	}
 	// should not happen
 	return &Reports{ProxyReports: &ProxyReports{}}, errors.Errorf("Unknown group/version/kind, %v", itemGvk)
@@ -681,13 +694,29 @@ func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway,
 }
 
 func (v *validator) ValidateUpstream(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*Reports, error) {
+	return v.ValidateGlooResource(ctx, us, dryRun)
+}
+
+func (v *validator) ValidateDeleteUpstream(ctx context.Context, upstreamRef *core.ResourceRef, dryRun bool) error {
+	return v.ValidateDeleteGlooResource(ctx, gloov1.UpstreamGVK, upstreamRef, dryRun)
+}
+
+func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef *core.ResourceRef, dryRun bool) error {
+	return v.ValidateDeleteGlooResource(ctx, gloov1.SecretGVK, secretRef, dryRun)
+}
+
+// ValidateGlooResource validates the creation or update of any resource supported by the Gloo validation server
+// (see IsGlooResource) against all proxies in gloo's snapshot.
+func (v *validator) ValidateGlooResource(ctx context.Context, resource resources.Resource, dryRun bool) (*Reports, error) {
+	modified, err := modifiedResourcesRequest(resource)
+	if err != nil {
+		return &Reports{}, err
+	}
 	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
-		// Sending a nil proxy causes the upstream to be translated with all proxies in gloo's snapshot
+		// Sending a nil proxy causes the resource to be translated with all proxies in gloo's snapshot
 		Proxy: nil,
 		Resources: &validation.GlooValidationServiceRequest_ModifiedResources{
-			ModifiedResources: &validation.ModifiedResources{
-				Upstreams: []*gloov1.Upstream{us},
-			},
+			ModifiedResources: modified,
 		},
 	})
 	logger := contextutils.LoggerFrom(ctx)
@@ -703,38 +732,18 @@ func (v *validator) ValidateUpstream(ctx context.Context, us *gloov1.Upstream, d
 	return v.getReportsFromGlooValidationResponse(response)
 }
 
-func (v *validator) ValidateDeleteUpstream(ctx context.Context, upstreamRef *core.ResourceRef, dryRun bool) error {
-	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
-		// Sending a nil proxy causes the remaining upstreams to be translated with all proxies in gloo's snapshot
-		Proxy: nil,
-		Resources: &validation.GlooValidationServiceRequest_DeletedResources{
-			DeletedResources: &validation.DeletedResources{
-				UpstreamRefs: []*core.ResourceRef{upstreamRef},
-			},
-		},
-	})
-	logger := contextutils.LoggerFrom(ctx)
+// ValidateDeleteGlooResource validates the deletion of any resource supported by the Gloo validation server
+// (see IsGlooResource) against all proxies in gloo's snapshot.
+func (v *validator) ValidateDeleteGlooResource(ctx context.Context, gvk schema.GroupVersionKind, ref *core.ResourceRef, dryRun bool) error {
+	deleted, err := deletedResourcesRequest(gvk, ref)
 	if err != nil {
-		if v.ignoreProxyValidationFailure {
-			logger.Error(err)
-		} else {
-			return err
-		}
+		return err
 	}
-	logger.Debugf("Got response from GlooValidationService: %s", response.String())
-
-	_, err = v.getReportsFromGlooValidationResponse(response)
-	return err
-}
-
-func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef *core.ResourceRef, dryRun bool) error {
 	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
-		// Sending a nil proxy causes the remaining secrets to be translated with all proxies in gloo's snapshot
+		// Sending a nil proxy causes the remaining resources to be translated with all proxies in gloo's snapshot
 		Proxy: nil,
 		Resources: &validation.GlooValidationServiceRequest_DeletedResources{
-			DeletedResources: &validation.DeletedResources{
-				SecretRefs: []*core.ResourceRef{secretRef},
-			},
+			DeletedResources: deleted,
 		},
 	})
 	logger := contextutils.LoggerFrom(ctx)
@@ -759,21 +768,29 @@ func (v *validator) getReportsFromGlooValidationResponse(validationResponse *val
 	var (
 		errs            error
 		upstreamReports UpstreamReports
+		resourceReports ResourceReports
 		proxyReports    ProxyReports
 		proxies         []*gloov1.Proxy
 	)
 	for _, report := range validationResponse.GetValidationReports() {
 		// Append upstream errors
-		for _, usRpt := range report.GetUpstreamReports() {
-			upstreamReports = append(upstreamReports, usRpt)
-			if err := resourceReportToMultiErr(usRpt); err != nil {
-				errs = multierr.Append(errs, errors.Wrapf(err, "failed to validate Upstream with Gloo validation server"))
-			}
-			if warnings := usRpt.GetWarnings(); !v.allowWarnings && len(warnings) > 0 {
-				for _, warning := range warnings {
-					errs = multierr.Append(errs, errors.New(warning))
-				}
-			}
+		upstreamReports = append(upstreamReports, report.GetUpstreamReports()...)
+		errs = multierr.Append(errs, v.resourceReportsToErr("Upstream", report.GetUpstreamReports()))
+
+		// Append errors for the remaining gloo resources
+		for _, kindReports := range []struct {
+			kind    string
+			reports []*validation.ResourceReport
+		}{
+			{"UpstreamGroup", report.GetUpstreamGroupReports()},
+			{"AuthConfig", report.GetAuthConfigReports()},
+			{"RateLimitConfig", report.GetRatelimitConfigReports()},
+			{"GraphQLApi", report.GetGraphqlApiReports()},
+			{"Secret", report.GetSecretReports()},
+			{"Artifact", report.GetArtifactReports()},
+		} {
+			resourceReports = append(resourceReports, kindReports.reports...)
+			errs = multierr.Append(errs, v.resourceReportsToErr(kindReports.kind, kindReports.reports))
 		}
 
 		// Append proxies and proxy reports
@@ -795,19 +812,53 @@ func (v *validator) getReportsFromGlooValidationResponse(validationResponse *val
 	return &Reports{
 		ProxyReports:    &proxyReports,
 		UpstreamReports: &upstreamReports,
+		ResourceReports: &resourceReports,
 		Proxies:         proxies,
 	}, errs
 }
 
+// Converts the errors on the given reports into a single error, along with their warnings unless warnings are allowed.
+func (v *validator) resourceReportsToErr(kind string, resourceReports []*validation.ResourceReport) error {
+	var errs error
+	for _, rpt := range resourceReports {
+		if err := resourceReportToMultiErr(rpt); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "failed to validate %v with Gloo validation server", kind))
+		}
+		if warnings := rpt.GetWarnings(); !v.allowWarnings && len(warnings) > 0 {
+			for _, warning := range warnings {
+				errs = multierr.Append(errs, errors.New(warning))
+			}
+		}
+	}
+	return errs
+}
+
 func (v *validator) sendGlooValidationServiceRequest(
 	ctx context.Context,
 	req *validation.GlooValidationServiceRequest,
 ) (*validation.GlooValidationServiceResponse, error) {
 	logger := contextutils.LoggerFrom(ctx)
-	logger.Debugf("Sending request to GlooValidationService: %s", req.String())
+	logger.Debugf("Sending request to GlooValidationService: %s", redactedRequestString(req))
 	return v.validationFunc(ctx, req)
 }
 
+// redactedRequestString returns the request as a string, with only the metadata of the secrets and artifacts in it,
+// so that their data, such as TLS private keys, is not logged.
+func redactedRequestString(req *validation.GlooValidationServiceRequest) string {
+	modified := req.GetModifiedResources()
+	if len(modified.GetSecrets()) == 0 && len(modified.GetArtifacts()) == 0 {
+		return req.String()
+	}
+	redacted := req.Clone().(*validation.GlooValidationServiceRequest)
+	for i, secret := range redacted.GetModifiedResources().GetSecrets() {
+		redacted.GetModifiedResources().GetSecrets()[i] = &gloov1.Secret{Metadata: secret.GetMetadata()}
+	}
+	for i, artifact := range redacted.GetModifiedResources().GetArtifacts() {
+		redacted.GetModifiedResources().GetArtifacts()[i] = &gloov1.Artifact{Metadata: artifact.GetMetadata()}
+	}
+	return redacted.String()
+}
+
 func proxiesForVirtualService(ctx context.Context, gwList v1.GatewayList, httpGwList v1.MatchableHttpGatewayList, vs *v1.VirtualService) []string {
 	gatewaysByProxy := utils.GatewaysByProxyName(gwList)
 
@@ -13,13 +13,17 @@ import (
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/test/samples"
 	"github.com/solo-io/go-utils/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
 	"go.opencensus.io/stats/view"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -277,6 +281,129 @@ var _ = Describe("Validator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
+		Context("other gloo resources", func() {
+			var (
+				requests []*validation.GlooValidationServiceRequest
+			)
+			BeforeEach(func() {
+				requests = nil
+				err := v.Sync(context.TODO(), samples.SimpleGlooSnapshot(ns))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			recordRequests := func(respond ValidatorFunc) ValidatorFunc {
+				return func(ctx context.Context, in *validation.GlooValidationServiceRequest) (*validation.GlooValidationServiceResponse, error) {
+					requests = append(requests, in)
+					return respond(ctx, in)
+				}
+			}
+
+			upstreamGroup := &gloov1.UpstreamGroup{
+				Metadata: &core.Metadata{Name: "ug", Namespace: "namespace"},
+			}
+			authConfig := &extauthv1.AuthConfig{
+				Metadata: &core.Metadata{Name: "ac", Namespace: "namespace"},
+			}
+			rateLimitConfig := ratelimit.NewRateLimitConfig("namespace", "rlc")
+
+			It("sends each kind of modified resource to the gloo validation server", func() {
+				v.validationFunc = recordRequests(acceptProxy)
+
+				for _, resource := range []resources.Resource{upstreamGroup, authConfig, rateLimitConfig} {
+					reports, err := v.ValidateGlooResource(context.TODO(), resource, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(*(reports.ProxyReports)).To(HaveLen(1))
+				}
+
+				Expect(requests).To(HaveLen(3))
+				Expect(requests[0].GetProxy()).To(BeNil())
+				Expect(requests[0].GetModifiedResources().GetUpstreamGroups()).To(ConsistOf(upstreamGroup))
+				Expect(requests[1].GetModifiedResources().GetAuthConfigs()).To(ConsistOf(authConfig))
+				Expect(requests[2].GetModifiedResources().GetRatelimitConfigs()).To(HaveLen(1))
+				Expect(requests[2].GetModifiedResources().GetRatelimitConfigs()[0].GetRef()).To(matchers.MatchProto(rateLimitConfig.GetMetadata().Ref()))
+			})
+			It("sends each kind of deleted resource to the gloo validation server", func() {
+				v.validationFunc = recordRequests(acceptProxy)
+
+				ref := &core.ResourceRef{Name: "name", Namespace: "namespace"}
+				err := v.ValidateDeleteGlooResource(context.TODO(), gloov1.UpstreamGroupGVK, ref, false)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteGlooResource(context.TODO(), extauthv1.AuthConfigGVK, ref, false)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteGlooResource(context.TODO(), ratelimit.RateLimitConfigGVK, ref, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requests).To(HaveLen(3))
+				Expect(requests[0].GetDeletedResources().GetUpstreamGroupRefs()).To(ConsistOf(ref))
+				Expect(requests[1].GetDeletedResources().GetAuthConfigRefs()).To(ConsistOf(ref))
+				Expect(requests[2].GetDeletedResources().GetRatelimitConfigRefs()).To(ConsistOf(ref))
+			})
+			It("rejects unsupported kinds", func() {
+				v.validationFunc = recordRequests(acceptProxy)
+
+				_, err := v.ValidateGlooResource(context.TODO(), &gatewayv1.VirtualService{}, false)
+				Expect(err).To(HaveOccurred())
+				err = v.ValidateDeleteGlooResource(context.TODO(), gatewayv1.VirtualServiceGVK, &core.ResourceRef{}, false)
+				Expect(err).To(HaveOccurred())
+				Expect(requests).To(BeEmpty())
+			})
+			It("rejects a resource when its own report has errors", func() {
+				v.validationFunc = func(ctx context.Context, in *validation.GlooValidationServiceRequest) (*validation.GlooValidationServiceResponse, error) {
+					resp, err := acceptProxy(ctx, in)
+					resp.GetValidationReports()[0].UpstreamGroupReports = []*validation.ResourceReport{{
+						ResourceRef: upstreamGroup.GetMetadata().Ref(),
+						Errors:      []string{"destination upstream not found"},
+					}}
+					return resp, err
+				}
+
+				reports, err := v.ValidateGlooResource(context.TODO(), upstreamGroup, false)
+				Expect(err).To(MatchError(ContainSubstring("failed to validate UpstreamGroup with Gloo validation server")))
+				Expect(*(reports.ResourceReports)).To(HaveLen(1))
+			})
+			It("rejects a secret when its own report has errors", func() {
+				secret := &gloov1.Secret{Metadata: &core.Metadata{Name: "secret", Namespace: "namespace"}}
+				v.validationFunc = func(ctx context.Context, in *validation.GlooValidationServiceRequest) (*validation.GlooValidationServiceResponse, error) {
+					resp, err := acceptProxy(ctx, in)
+					resp.GetValidationReports()[0].SecretReports = []*validation.ResourceReport{{
+						ResourceRef: secret.GetMetadata().Ref(),
+						Errors:      []string{"invalid certificate"},
+					}}
+					return resp, err
+				}
+
+				reports, err := v.ValidateGlooResource(context.TODO(), secret, false)
+				Expect(err).To(MatchError(ContainSubstring("failed to validate Secret with Gloo validation server")))
+				Expect(*(reports.ResourceReports)).To(HaveLen(1))
+			})
+			It("accepts a resource when its own report has warnings only if allowWarnings is true", func() {
+				v.validationFunc = func(ctx context.Context, in *validation.GlooValidationServiceRequest) (*validation.GlooValidationServiceResponse, error) {
+					resp, err := acceptProxy(ctx, in)
+					resp.GetValidationReports()[0].AuthConfigReports = []*validation.ResourceReport{{
+						ResourceRef: authConfig.GetMetadata().Ref(),
+						Warnings:    []string{"auth config is unused"},
+					}}
+					return resp, err
+				}
+
+				v.allowWarnings = true
+				_, err := v.ValidateGlooResource(context.TODO(), authConfig, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				v.allowWarnings = false
+				_, err = v.ValidateGlooResource(context.TODO(), authConfig, false)
+				Expect(err).To(MatchError(ContainSubstring("auth config is unused")))
+			})
+			It("rejects a resource when proxy validation fails", func() {
+				v.validationFunc = failProxy
+
+				reports, err := v.ValidateGlooResource(context.TODO(), rateLimitConfig, false)
+				Expect(err).To(HaveOccurred())
+				Expect(*(reports.ProxyReports)).To(HaveLen(1))
+				err = v.ValidateDeleteGlooResource(context.TODO(), extauthv1.AuthConfigGVK, authConfig.GetMetadata().Ref(), false)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 	Context("validating a route table", func() {
 		Context("proxy validation accepted", func() {
@@ -1247,3 +1374,29 @@ func warnProxy(ctx context.Context, in *validation.GlooValidationServiceRequest)
 		ValidationReports: validationReports,
 	}, nil
 }
+
+var _ = Describe("redactedRequestString", func() {
+	It("does not contain the data of secrets and artifacts", func() {
+		req := &validation.GlooValidationServiceRequest{
+			Resources: &validation.GlooValidationServiceRequest_ModifiedResources{
+				ModifiedResources: &validation.ModifiedResources{
+					Secrets: []*gloov1.Secret{{
+						Metadata: &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+						Kind:     &gloov1.Secret_Tls{Tls: &gloov1.TlsSecret{PrivateKey: "private-key"}},
+					}},
+					Artifacts: []*gloov1.Artifact{{
+						Metadata: &core.Metadata{Name: "config", Namespace: "gloo-system"},
+						Data:     map[string]string{"password": "artifact-password"},
+					}},
+				},
+			},
+		}
+
+		redacted := redactedRequestString(req)
+		Expect(redacted).To(ContainSubstring("tls"))
+		Expect(redacted).To(ContainSubstring("config"))
+		Expect(redacted).NotTo(ContainSubstring("private-key"))
+		Expect(redacted).NotTo(ContainSubstring("artifact-password"))
+		Expect(req.GetModifiedResources().GetSecrets()[0].GetTls().GetPrivateKey()).To(Equal("private-key"))
+	})
+})
@@ -24,6 +24,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	rlopts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -179,6 +180,13 @@ func CheckResources(opts *options.Options) error {
 		}
 	}
 
+	if included := doesNotContain(opts.Top.CheckName, "cert-expiry"); included {
+		err := checkCertExpiry(opts, namespaces, settings)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
 	if included := doesNotContain(opts.Top.CheckName, "virtual-services"); included {
 		err = checkVirtualServices(opts, namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs, knownVirtualHostOptions, knownRouteOptions)
 		if err != nil {
@@ -886,6 +894,58 @@ func checkSecrets(opts *options.Options, namespaces []string) error {
 	return nil
 }
 
+func checkCertExpiry(opts *options.Options, namespaces []string, settings *v1.Settings) error {
+	printer.AppendCheck("Checking certificate expiry... ")
+	var multiErr *multierror.Error
+	client, err := helpers.GetSecretClient(opts.Top.Ctx, opts.Check.SecretClientTimeout, namespaces)
+	if err != nil {
+		multiErr = multierror.Append(multiErr, err)
+		printer.AppendStatus("certificate expiry", fmt.Sprintf("%v Errors!", multiErr.Len()))
+		return multiErr
+	}
+
+	threshold := utils.DefaultCertExpiryWarningThreshold
+	if settings.GetGloo().GetCertExpiryWarningThreshold() != nil {
+		threshold = settings.GetGloo().GetCertExpiryWarningThreshold().AsDuration()
+	}
+	var warnings []string
+	now := time.Now()
+	for _, ns := range namespaces {
+		secrets, err := client.List(ns, clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		for _, secret := range secrets {
+			notAfter, ok := utils.GetTlsSecretExpiry(secret)
+			if !ok {
+				continue
+			}
+			if remaining := notAfter.Sub(now); remaining <= 0 {
+				errMessage := fmt.Sprintf("Found TLS secret with an expired certificate: %s ", renderMetadata(secret.GetMetadata()))
+				errMessage += fmt.Sprintf("(Expired at: %s)", notAfter.UTC().Format(time.RFC3339))
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			} else if remaining <= threshold {
+				warnings = append(warnings, fmt.Sprintf("Found TLS secret with a certificate expiring in %v: %s (Expires at: %s)",
+					remaining.Round(time.Hour), renderMetadata(secret.GetMetadata()), notAfter.UTC().Format(time.RFC3339)))
+			}
+		}
+	}
+	if multiErr != nil {
+		printer.AppendStatus("certificate expiry", fmt.Sprintf("%v Errors!", multiErr.Len()))
+		return multiErr
+	}
+	if len(warnings) > 0 {
+		printer.AppendStatus("certificate expiry", fmt.Sprintf("%v Warnings", len(warnings)))
+		for _, warning := range warnings {
+			printer.AppendMessage(warning)
+		}
+		return nil
+	}
+	printer.AppendStatus("certificate expiry", "OK")
+	return nil
+}
+
 func renderMetadata(metadata *core.Metadata) string {
 	return renderNamespaceName(metadata.GetNamespace(), metadata.GetName())
 }
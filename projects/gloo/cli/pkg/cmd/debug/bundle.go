@@ -0,0 +1,419 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoyadmin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	BundleFilename = "/tmp/gloo-debug-bundle.tgz"
+
+	glooDeployment     = "gloo"
+	glooMetricsPath    = "/metrics"
+	proxyLabelSelector = "gloo=gateway-proxy"
+)
+
+var (
+	BundleFileErr = func(err error, name string) error {
+		return eris.Wrapf(err, "collecting %v", name)
+	}
+)
+
+// DebugBundle writes an archive of the state of Gloo and its proxies to the file given by --file, or to
+// BundleFilename. Anything which cannot be collected is listed in errors.txt within the archive, rather than
+// failing the whole bundle.
+func DebugBundle(opts *options.Options, w io.Writer) error {
+	file := opts.Top.File
+	if file == "" {
+		file = BundleFilename
+	}
+	b := &bundler{
+		namespace:   opts.Metadata.GetNamespace(),
+		kubeCli:     &install.CmdKubectl{},
+		listProxies: listProxyDeployments,
+		listSecrets: listSecrets,
+		getXdsDump: func(ctx context.Context, proxyName, namespace string) (*xdsinspection.XdsDump, error) {
+			return xdsinspection.GetGlooXdsDump(ctx, proxyName, namespace, opts.Top.Verbose)
+		},
+		get: portForwardGet,
+	}
+	if err := b.writeBundle(opts.Top.Ctx, afero.NewOsFs(), file); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "Wrote debug bundle to %v\n", file)
+	return err
+}
+
+// bundler collects the files in a debug bundle. Its dependencies on the cluster are fields, so that they can be
+// replaced in tests.
+type bundler struct {
+	namespace string
+	kubeCli   install.KubeCli
+	// returns the names of the proxy deployments in the namespace, which are also the names of the proxies
+	listProxies func(ctx context.Context, namespace string) ([]string, error)
+	listSecrets func(ctx context.Context) (v1.SecretList, error)
+	getXdsDump  func(ctx context.Context, proxyName, namespace string) (*xdsinspection.XdsDump, error)
+	// returns the response to a GET of the path on a port of a deployment
+	get func(ctx context.Context, namespace, deployment string, port uint32, path string) (string, error)
+}
+
+// writeBundle collects the bundle into a temporary directory, then archives it to file. The archive contains:
+//
+//	resources/<crd>.yaml                 the Gloo resources of each kind, with their statuses, in all namespaces.
+//	                                     These can be replayed with glooctl render -f resources
+//	secrets.txt                          the names of the secrets read by Gloo, with their data redacted
+//	proxies/<name>/xds.yaml              the xDS config served by Gloo to the proxy, with secret values redacted
+//	proxies/<name>/xds-versions.yaml     the version of each type of xDS resource, which is the translator's hash
+//	proxies/<name>/config_dump.json      the Envoy config dump of a pod of the proxy, redacted as the xDS config is
+//	proxies/<name>/stats.txt             the Envoy stats of a pod of the proxy, which are only names and values
+//	gloo/metrics.txt                     the metrics of the gloo deployment
+//	errors.txt                           anything which could not be collected
+func (b *bundler) writeBundle(ctx context.Context, fs afero.Fs, file string) error {
+	dir, err := afero.TempDir(fs, "", "gloo-debug-bundle")
+	if err != nil {
+		return err
+	}
+	defer fs.RemoveAll(dir)
+
+	var errs *multierror.Error
+	write := func(name, contents string, err error) {
+		if err == nil {
+			err = writeFile(fs, filepath.Join(dir, name), contents)
+		}
+		if err != nil {
+			errs = multierror.Append(errs, BundleFileErr(err, name))
+		}
+	}
+
+	for _, crd := range installcmd.GlooCrdNames {
+		output, err := b.kubeCli.KubectlOut(nil, "get", crd, "-oyaml", "--all-namespaces")
+		if err == nil {
+			output, err = redactResources(output)
+		}
+		write(filepath.Join("resources", crd+".yaml"), string(output), err)
+	}
+
+	secrets, err := b.listSecrets(ctx)
+	write("secrets.txt", syncutil.StringifySnapshot(&struct{ Secrets v1.SecretList }{Secrets: secrets}), err)
+
+	proxies, err := b.listProxies(ctx, b.namespace)
+	if err != nil {
+		errs = multierror.Append(errs, BundleFileErr(err, "proxies"))
+	}
+	for _, proxy := range proxies {
+		proxyDir := filepath.Join("proxies", proxy)
+
+		xdsDump, err := b.getXdsDump(ctx, proxy, b.namespace)
+		if err == nil {
+			err = redactXdsDump(xdsDump)
+		}
+		if err == nil {
+			write(filepath.Join(proxyDir, "xds.yaml"), xdsDump.String(), nil)
+			write(filepath.Join(proxyDir, "xds-versions.yaml"), renderVersions(xdsDump.Versions), nil)
+		} else {
+			write(filepath.Join(proxyDir, "xds.yaml"), "", err)
+		}
+
+		configDump, err := b.get(ctx, b.namespace, proxy, defaults.EnvoyAdminPort, "/config_dump")
+		if err == nil {
+			configDump, err = redactConfigDump(configDump)
+		}
+		write(filepath.Join(proxyDir, "config_dump.json"), configDump, err)
+
+		stats, err := b.get(ctx, b.namespace, proxy, defaults.EnvoyAdminPort, "/stats")
+		write(filepath.Join(proxyDir, "stats.txt"), stats, err)
+	}
+
+	metrics, err := b.get(ctx, b.namespace, glooDeployment, defaults.GlooAdminPort, glooMetricsPath)
+	write(filepath.Join("gloo", "metrics.txt"), metrics, err)
+
+	if errs != nil {
+		if err := writeFile(fs, filepath.Join(dir, "errors.txt"), errs.Error()); err != nil {
+			return err
+		}
+	}
+	return zip(fs, dir, file)
+}
+
+func writeFile(fs afero.Fs, path, contents string) error {
+	if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, path, []byte(contents), filePermissions)
+}
+
+func renderVersions(versions map[string]string) string {
+	var typeURLs []string
+	for typeURL := range versions {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs)
+	var sb strings.Builder
+	for _, typeURL := range typeURLs {
+		fmt.Fprintf(&sb, "%v: %q\n", typeURL, versions[typeURL])
+	}
+	return sb.String()
+}
+
+func listProxyDeployments(ctx context.Context, namespace string) ([]string, error) {
+	kubeClient, err := helpers.KubeClient()
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: proxyLabelSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, deployment := range deployments.Items {
+		names = append(names, deployment.GetName())
+	}
+	return names, nil
+}
+
+func listSecrets(ctx context.Context) (v1.SecretList, error) {
+	client, err := helpers.GetSecretClient(ctx, 0, []string{metav1.NamespaceAll})
+	if err != nil {
+		return nil, err
+	}
+	return client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+}
+
+func portForwardGet(ctx context.Context, namespace, deployment string, port uint32, path string) (string, error) {
+	freePort, err := cliutil.GetFreePort()
+	if err != nil {
+		return "", err
+	}
+	response, portFwd, err := cliutil.PortForwardGet(ctx, namespace, "deployment/"+deployment,
+		strconv.Itoa(freePort), strconv.Itoa(int(port)), false, path)
+	if portFwd != nil && portFwd.Process != nil {
+		defer portFwd.Process.Release()
+		defer portFwd.Process.Kill()
+	}
+	return response, err
+}
+
+// the kinds of resource whose specs are redacted, keyed by their GVK
+var redactedResources = map[schema.GroupVersionKind]func() proto.Message{
+	gwv1.GatewayGVK:              func() proto.Message { return &gwv1.Gateway{} },
+	gwv1.MatchableHttpGatewayGVK: func() proto.Message { return &gwv1.MatchableHttpGateway{} },
+	gwv1.VirtualServiceGVK:       func() proto.Message { return &gwv1.VirtualService{} },
+	gwv1.RouteTableGVK:           func() proto.Message { return &gwv1.RouteTable{} },
+	gwv1.VirtualHostOptionGVK:    func() proto.Message { return &gwv1.VirtualHostOption{} },
+	gwv1.RouteOptionGVK:          func() proto.Message { return &gwv1.RouteOption{} },
+	v1.ProxyGVK:                  func() proto.Message { return &v1.Proxy{} },
+	v1.SettingsGVK:               func() proto.Message { return &v1.Settings{} },
+	v1.UpstreamGVK:               func() proto.Message { return &v1.Upstream{} },
+	v1.UpstreamGroupGVK:          func() proto.Message { return &v1.UpstreamGroup{} },
+	extauthv1.AuthConfigGVK:      func() proto.Message { return &extauthv1.AuthConfig{} },
+}
+
+// redactResources zeroes the sensitive fields in the specs of the items of the `kubectl get -oyaml` output, using the
+// same redactor as the logs. Items of other kinds keep their specs. The last applied configuration annotation holds a
+// copy of the spec, so it is redacted for every item, and managed fields are dropped.
+func redactResources(output []byte) ([]byte, error) {
+	var list map[string]interface{}
+	if err := yaml.Unmarshal(output, &list); err != nil {
+		return nil, err
+	}
+	items, _ := list["items"].([]interface{})
+	redactor := syncutil.NewProtoRedactor()
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "managedFields")
+			if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+				if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+					annotations[corev1.LastAppliedConfigAnnotation] = syncutil.Redacted
+				}
+			}
+		}
+		gvk := (&unstructured.Unstructured{Object: obj}).GroupVersionKind()
+		newResource, ok := redactedResources[gvk]
+		spec, hasSpec := obj["spec"].(map[string]interface{})
+		if !ok || !hasSpec {
+			continue
+		}
+		resource := newResource()
+		if err := skprotoutils.UnmarshalMapToProto(spec, resource); err != nil {
+			return nil, err
+		}
+		redacted, err := skprotoutils.MarshalMapFromProto(redactor.Redact(resource))
+		if err != nil {
+			return nil, err
+		}
+		obj["spec"] = redacted
+	}
+	return yaml.Marshal(list)
+}
+
+// redactXdsDump redacts the sensitive values in the dump, as Gloo serves the contents of secrets inline:
+//   - the inline contents of data sources, such as the private keys of TLS contexts
+//   - the values of the headers which are added to requests and responses, such as authorization headers
+//   - the extension protocol options of clusters, such as the credentials of AWS upstreams
+//
+// Typed configs are redacted in the same way, and those whose type is not known are dropped, as they might contain
+// anything. Envoy redacts fewer of these itself in its config dump.
+func redactXdsDump(xdsDump *xdsinspection.XdsDump) error {
+	for i := range xdsDump.Endpoints {
+		if err := redactMessage(xdsDump.Endpoints[i].ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	for i := range xdsDump.Clusters {
+		if err := redactMessage(xdsDump.Clusters[i].ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	for i := range xdsDump.Listeners {
+		if err := redactMessage(xdsDump.Listeners[i].ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	for i := range xdsDump.Routes {
+		if err := redactMessage(xdsDump.Routes[i].ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redactConfigDump redacts the Envoy config dump in the same way as the xDS dump. The dump is parsed leniently, as
+// Envoy may be newer than glooctl: unknown fields are dropped, and so are the contents of configs of unknown types.
+func redactConfigDump(configDump string) (string, error) {
+	resolver := configDumpResolver{Types: protoregistry.GlobalTypes}
+	var dump envoyadmin.ConfigDump
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true, Resolver: resolver}).Unmarshal([]byte(configDump), &dump); err != nil {
+		return "", err
+	}
+	if err := redactMessage(dump.ProtoReflect()); err != nil {
+		return "", err
+	}
+	redacted, err := protojson.MarshalOptions{Multiline: true, UseProtoNames: true, Resolver: resolver}.Marshal(&dump)
+	if err != nil {
+		return "", err
+	}
+	return string(redacted), nil
+}
+
+// configDumpResolver resolves the types of the configs in a config dump. Types which are not known resolve to a message
+// without fields, so that their contents are dropped rather than failing the whole dump.
+type configDumpResolver struct {
+	*protoregistry.Types
+}
+
+func (r configDumpResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	messageType, err := r.Types.FindMessageByURL(url)
+	if errors.Is(err, protoregistry.NotFound) {
+		return unknownConfigType, nil
+	}
+	return messageType, err
+}
+
+var unknownConfigType = func() protoreflect.MessageType {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("glooctl/debug/unknown.proto"),
+		Package:     proto.String("glooctl.debug"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("UnknownConfig")}},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+	return dynamicpb.NewMessageType(file.Messages().Get(0))
+}()
+
+func redactMessage(message protoreflect.Message) error {
+	switch msg := message.Interface().(type) {
+	case *envoycore.DataSource:
+		switch msg.GetSpecifier().(type) {
+		case *envoycore.DataSource_InlineString, *envoycore.DataSource_InlineBytes:
+			msg.Specifier = &envoycore.DataSource_InlineString{InlineString: syncutil.Redacted}
+		}
+		return nil
+	case *envoycore.HeaderValue:
+		msg.Value = syncutil.Redacted
+		return nil
+	case *envoycluster.Cluster:
+		for name, options := range msg.GetTypedExtensionProtocolOptions() {
+			msg.GetTypedExtensionProtocolOptions()[name] = &anypb.Any{TypeUrl: options.GetTypeUrl()}
+		}
+	case *anypb.Any:
+		return redactAny(msg)
+	}
+
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = redactMessage(list.Get(i).Message())
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+				err = redactMessage(mapValue.Message())
+				return err == nil
+			})
+		case field.Message() != nil && !field.IsList() && !field.IsMap():
+			err = redactMessage(value.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+func redactAny(typedConfig *anypb.Any) error {
+	message, err := typedConfig.UnmarshalNew()
+	if err != nil {
+		typedConfig.Value = nil
+		return nil
+	}
+	if err := redactMessage(message.ProtoReflect()); err != nil {
+		return err
+	}
+	return typedConfig.MarshalFrom(message)
+}
@@ -0,0 +1,263 @@
+package debug
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	envoyadmin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/go-utils/tarutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("Bundle", func() {
+
+	const (
+		emptyList = "apiVersion: v1\nitems: []\nkind: List\n"
+		settings  = `apiVersion: v1
+items:
+- apiVersion: gloo.solo.io/v1
+  kind: Settings
+  metadata:
+    annotations:
+      kubectl.kubernetes.io/last-applied-configuration: |
+        {"apiVersion":"gloo.solo.io/v1","kind":"Settings","spec":{"consul":{"password":"consul-password"},"vaultSecretSource":{"token":"vault-token"}}}
+    managedFields:
+    - manager: kubectl-client-side-apply
+      operation: Update
+    name: default
+    namespace: gloo-system
+  spec:
+    discoveryNamespace: gloo-system
+    vaultSecretSource:
+      token: vault-token
+    consul:
+      httpAddress: consul:8500
+      password: consul-password
+kind: List
+`
+	)
+
+	var (
+		ctx        context.Context
+		fs         afero.Fs
+		dir        string
+		b          *bundler
+		gets       []string
+		configDump string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.NewMemMapFs()
+		var err error
+		dir, err = afero.TempDir(fs, "", "bundle-test")
+		Expect(err).NotTo(HaveOccurred())
+
+		var cmds, outputs []string
+		for _, crd := range installcmd.GlooCrdNames {
+			cmds = append(cmds, "get "+crd+" -oyaml --all-namespaces")
+			if crd == "settings.gloo.solo.io" {
+				outputs = append(outputs, settings)
+			} else {
+				outputs = append(outputs, emptyList)
+			}
+		}
+
+		tlsContext, err := ptypes.MarshalAny(&envoyauth.DownstreamTlsContext{
+			CommonTlsContext: &envoyauth.CommonTlsContext{
+				TlsCertificates: []*envoyauth.TlsCertificate{{
+					CertificateChain: &envoycore.DataSource{Specifier: &envoycore.DataSource_InlineString{InlineString: "cert-chain"}},
+					PrivateKey:       &envoycore.DataSource{Specifier: &envoycore.DataSource_InlineString{InlineString: "private-key"}},
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		listener, err := ptypes.MarshalAny(&envoylistener.Listener{
+			Name: "listener-::-8443",
+			FilterChains: []*envoylistener.FilterChain{{
+				TransportSocket: &envoycore.TransportSocket{
+					Name:       "envoy.transport_sockets.tls",
+					ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+				},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		listenersDump, err := ptypes.MarshalAny(&envoyadmin.ListenersConfigDump{
+			DynamicListeners: []*envoyadmin.ListenersConfigDump_DynamicListener{{
+				Name:        "listener-::-8443",
+				ActiveState: &envoyadmin.ListenersConfigDump_DynamicListenerState{Listener: listener},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		listenersDumpJson, err := protojson.Marshal(listenersDump)
+		Expect(err).NotTo(HaveOccurred())
+		configDump = `{"configs": [` + string(listenersDumpJson) + `,
+			{"@type": "type.googleapis.com/example.UnknownConfigDump", "password": "unknown-password"}]}`
+
+		clusters := make([]envoycluster.Cluster, 1)
+		clusters[0].Name = "aws-upstream_gloo-system"
+		err = pluginutils.SetExtensionProtocolOptions(&clusters[0], "io.solo.aws_lambda", &awsapi.AWSLambdaProtocolExtension{
+			Host:         "lambda.us-east-1.amazonaws.com",
+			Region:       "us-east-1",
+			AccessKey:    "aws-access-key",
+			SecretKey:    "aws-secret-key",
+			SessionToken: "aws-session-token",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		gets = nil
+		b = &bundler{
+			namespace: "gloo-system",
+			kubeCli:   install.NewMockKubectl(cmds, outputs),
+			listProxies: func(ctx context.Context, namespace string) ([]string, error) {
+				return []string{"gateway-proxy"}, nil
+			},
+			listSecrets: func(ctx context.Context) (v1.SecretList, error) {
+				return v1.SecretList{{
+					Metadata: &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+					Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert-chain", PrivateKey: "private-key"}},
+				}}, nil
+			},
+			getXdsDump: func(ctx context.Context, proxyName, namespace string) (*xdsinspection.XdsDump, error) {
+				return &xdsinspection.XdsDump{
+					Role: namespace + "~" + proxyName,
+					Listeners: []envoylistener.Listener{{
+						Name: "listener-::-8443",
+						FilterChains: []*envoylistener.FilterChain{{
+							TransportSocket: &envoycore.TransportSocket{
+								Name:       "envoy.transport_sockets.tls",
+								ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+							},
+						}},
+					}},
+					Clusters: clusters,
+					Routes: []envoyroute.RouteConfiguration{{
+						Name: "listener-::-8443-routes",
+						RequestHeadersToAdd: []*envoycore.HeaderValueOption{{
+							Header: &envoycore.HeaderValue{Key: "authorization", Value: "bearer-token"},
+						}},
+					}},
+					Versions: map[string]string{"type.googleapis.com/envoy.config.listener.v3.Listener": "1234"},
+				}, nil
+			},
+			get: func(ctx context.Context, namespace, deployment string, port uint32, path string) (string, error) {
+				gets = append(gets, deployment+path)
+				switch path {
+				case "/stats":
+					return "", eris.New("connection refused")
+				case "/config_dump":
+					return configDump, nil
+				}
+				return deployment + " " + path, nil
+			},
+		}
+	})
+
+	AfterEach(func() {
+		fs.RemoveAll(dir)
+	})
+
+	readBundle := func() map[string]string {
+		file := filepath.Join(dir, "bundle.tgz")
+		Expect(b.writeBundle(ctx, fs, file)).NotTo(HaveOccurred())
+
+		untarred := filepath.Join(dir, "untarred")
+		Expect(tarutils.Untar(untarred, file, fs)).NotTo(HaveOccurred())
+		files := map[string]string{}
+		err := afero.Walk(fs, untarred, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			contents, err := afero.ReadFile(fs, path)
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(untarred, path)
+			files[rel] = string(contents)
+			return err
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return files
+	}
+
+	It("collects the state of gloo and its proxies", func() {
+		files := readBundle()
+
+		for _, crd := range installcmd.GlooCrdNames {
+			Expect(files).To(HaveKey(filepath.Join("resources", crd+".yaml")))
+		}
+		Expect(files[filepath.Join("resources", "settings.gloo.solo.io.yaml")]).To(ContainSubstring("discoveryNamespace: gloo-system"))
+		Expect(files["secrets.txt"]).To(ContainSubstring("tls"))
+		Expect(files[filepath.Join("proxies", "gateway-proxy", "xds.yaml")]).To(ContainSubstring("listener-::-8443"))
+		Expect(files[filepath.Join("proxies", "gateway-proxy", "xds-versions.yaml")]).To(Equal(
+			"type.googleapis.com/envoy.config.listener.v3.Listener: \"1234\"\n"))
+		Expect(files[filepath.Join("proxies", "gateway-proxy", "config_dump.json")]).To(ContainSubstring("listener-::-8443"))
+		Expect(files[filepath.Join("gloo", "metrics.txt")]).To(Equal("gloo /metrics"))
+		Expect(gets).To(ConsistOf("gateway-proxy/config_dump", "gateway-proxy/stats", "gloo/metrics"))
+	})
+
+	It("lists what could not be collected", func() {
+		files := readBundle()
+
+		Expect(files).NotTo(HaveKey(filepath.Join("proxies", "gateway-proxy", "stats.txt")))
+		Expect(files["errors.txt"]).To(ContainSubstring("collecting proxies/gateway-proxy/stats.txt: connection refused"))
+	})
+
+	It("redacts secrets", func() {
+		files := readBundle()
+
+		for name, contents := range files {
+			Expect(contents).NotTo(ContainSubstring("private-key"), name)
+		}
+		for _, value := range []string{"cert-chain", "aws-access-key", "aws-secret-key", "aws-session-token", "bearer-token"} {
+			Expect(files[filepath.Join("proxies", "gateway-proxy", "xds.yaml")]).NotTo(ContainSubstring(value))
+		}
+		Expect(files[filepath.Join("proxies", "gateway-proxy", "xds.yaml")]).To(And(
+			ContainSubstring("aws-upstream_gloo-system"),
+			ContainSubstring("io.solo.aws_lambda"),
+			ContainSubstring("authorization"),
+		))
+		for _, value := range []string{"cert-chain", "unknown-password"} {
+			Expect(files[filepath.Join("proxies", "gateway-proxy", "config_dump.json")]).NotTo(ContainSubstring(value))
+		}
+		Expect(files[filepath.Join("proxies", "gateway-proxy", "config_dump.json")]).To(And(
+			ContainSubstring("envoy.transport_sockets.tls"),
+			ContainSubstring("example.UnknownConfigDump"),
+		))
+
+		settings := files[filepath.Join("resources", "settings.gloo.solo.io.yaml")]
+		Expect(settings).NotTo(ContainSubstring("vault-token"))
+		Expect(settings).NotTo(ContainSubstring("consul-password"))
+		Expect(settings).To(ContainSubstring("httpAddress: consul:8500"))
+	})
+
+	It("redacts the last applied configuration of resources", func() {
+		files := readBundle()
+
+		settings := files[filepath.Join("resources", "settings.gloo.solo.io.yaml")]
+		Expect(settings).To(ContainSubstring("kubectl.kubernetes.io/last-applied-configuration: '" + syncutil.Redacted + "'"))
+		Expect(settings).NotTo(ContainSubstring("managedFields"))
+		Expect(settings).NotTo(ContainSubstring("vault-token"))
+		Expect(settings).NotTo(ContainSubstring("consul-password"))
+	})
+})
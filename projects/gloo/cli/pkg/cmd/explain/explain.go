@@ -0,0 +1,300 @@
+package explain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RouteConfigExplanation explains how Envoy routes a request with one of the RouteConfigurations of a proxy.
+type RouteConfigExplanation struct {
+	RouteConfigName string
+	// the virtual host selected for the request's host, nil if none matched
+	VirtualHost *envoyroute.VirtualHost
+	// the domain of the virtual host which matched the request's host
+	Domain string
+	// the route selected for the request, nil if none matched
+	Route *RouteExplanation
+	// the routes after the selected route which also match the request, and so are shadowed by it
+	ShadowedRoutes []*RouteExplanation
+	// the routes of the virtual host which can never be selected, as an earlier route matches every request they do
+	UnreachableRoutes []*UnreachableRoute
+}
+
+// RouteExplanation relates an Envoy route to the Gloo route and the resources it was translated from.
+type RouteExplanation struct {
+	Route *envoyroute.Route
+	// the Gloo route the Envoy route was translated from, nil if it could not be found on the proxy
+	GlooRoute *v1.Route
+	// the resources the route was defined in, starting with the VirtualService and followed by the RouteTables it
+	// was delegated through
+	Sources []gwtranslator.SourceRef
+	// the options of the route and its virtual host, which Envoy merges
+	Options []*OptionSource
+}
+
+// OptionSource is an option which is applied to a route, and where it was set.
+type OptionSource struct {
+	Name          string
+	OnRoute       bool
+	OnVirtualHost bool
+}
+
+// UnreachableRoute is a route which is shadowed by an earlier route for every request.
+type UnreachableRoute struct {
+	Route      *RouteExplanation
+	ShadowedBy *RouteExplanation
+}
+
+// ExplainRoute simulates Envoy's routing of the request with each of the RouteConfigurations of the proxy, and relates
+// the routes to those of the Gloo proxy they were translated from. The routes are those served to Envoy, so reflect
+// any changes which Gloo makes to the proxy's routes, such as replacing invalid routes.
+func ExplainRoute(ctx context.Context, proxy *v1.Proxy, routeConfigs []*envoyroute.RouteConfiguration, request *Request) []*RouteConfigExplanation {
+	httpListeners := httpListenersByRouteConfigName(proxy)
+	req := request.attributes()
+
+	var explanations []*RouteConfigExplanation
+	for _, routeConfig := range routeConfigs {
+		explanation := &RouteConfigExplanation{RouteConfigName: routeConfig.GetName()}
+		explanations = append(explanations, explanation)
+
+		explanation.VirtualHost, explanation.Domain = selectVirtualHost(routeConfig.GetVirtualHosts(), request.Host)
+		if explanation.VirtualHost == nil {
+			continue
+		}
+		glooVirtualHost := findGlooVirtualHost(ctx, httpListeners[routeConfig.GetName()], explanation.VirtualHost.GetName())
+
+		routes := make([]*RouteExplanation, len(explanation.VirtualHost.GetRoutes()))
+		for i, route := range explanation.VirtualHost.GetRoutes() {
+			routes[i] = relateRoute(route, glooVirtualHost, explanation.VirtualHost.GetName())
+
+			if !matchesRoute(route.GetMatch(), req) {
+				// keep the routes, so that unreachable routes can be explained
+			} else if explanation.Route == nil {
+				explanation.Route = routes[i]
+			} else {
+				explanation.ShadowedRoutes = append(explanation.ShadowedRoutes, routes[i])
+			}
+
+			for _, earlier := range routes[:i] {
+				if shadows(earlier.Route.GetMatch(), route.GetMatch()) {
+					explanation.UnreachableRoutes = append(explanation.UnreachableRoutes, &UnreachableRoute{
+						Route:      routes[i],
+						ShadowedBy: earlier,
+					})
+					break
+				}
+			}
+		}
+	}
+	return explanations
+}
+
+// the http listeners of the proxy, keyed by the name of the RouteConfiguration Gloo translates them to
+func httpListenersByRouteConfigName(proxy *v1.Proxy) map[string]*v1.HttpListener {
+	httpListeners := map[string]*v1.HttpListener{}
+	for _, listener := range proxy.GetListeners() {
+		switch listenerType := listener.GetListenerType().(type) {
+		case *v1.Listener_HttpListener:
+			httpListeners[glooutils.RouteConfigName(listener)] = listenerType.HttpListener
+		case *v1.Listener_HybridListener:
+			for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+				if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+					httpListeners[glooutils.MatchedRouteConfigName(listener, matchedListener.GetMatcher())] = httpListener
+				}
+			}
+		}
+	}
+	return httpListeners
+}
+
+func findGlooVirtualHost(ctx context.Context, httpListener *v1.HttpListener, envoyName string) *v1.VirtualHost {
+	for _, virtualHost := range httpListener.GetVirtualHosts() {
+		if glooutils.SanitizeForEnvoy(ctx, virtualHost.GetName(), "virtual host") == envoyName {
+			return virtualHost
+		}
+	}
+	return nil
+}
+
+func relateRoute(route *envoyroute.Route, glooVirtualHost *v1.VirtualHost, virtualHostName string) *RouteExplanation {
+	explanation := &RouteExplanation{Route: route}
+
+	// the gloo translator names the envoy routes for each matcher of the i-th route of a virtual host
+	// <virtual host>-route-<i>[-<route name>]-matcher-<j>
+	index := strings.TrimPrefix(route.GetName(), virtualHostName+"-route-")
+	if end := strings.Index(index, "-"); end >= 0 {
+		index = index[:end]
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil || i >= len(glooVirtualHost.GetRoutes()) {
+		return explanation
+	}
+	explanation.GlooRoute = glooVirtualHost.GetRoutes()[i]
+
+	// sources are appended as the route is returned up the delegation chain, so the VirtualService is last
+	_ = gwtranslator.ForEachSource(explanation.GlooRoute, func(source gwtranslator.SourceRef) error {
+		explanation.Sources = append([]gwtranslator.SourceRef{source}, explanation.Sources...)
+		return nil
+	})
+
+	explanation.Options = mergeOptions(explanation.GlooRoute.GetOptions(), glooVirtualHost.GetOptions())
+	return explanation
+}
+
+// the names of the options which are set on the route and on its virtual host
+func mergeOptions(routeOptions *v1.RouteOptions, virtualHostOptions *v1.VirtualHostOptions) []*OptionSource {
+	byName := map[string]*OptionSource{}
+	get := func(name string) *OptionSource {
+		if byName[name] == nil {
+			byName[name] = &OptionSource{Name: name}
+		}
+		return byName[name]
+	}
+	for _, name := range setFields(routeOptions) {
+		get(name).OnRoute = true
+	}
+	for _, name := range setFields(virtualHostOptions) {
+		get(name).OnVirtualHost = true
+	}
+
+	options := make([]*OptionSource, 0, len(byName))
+	for _, option := range byName {
+		options = append(options, option)
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Name < options[j].Name
+	})
+	return options
+}
+
+func setFields(message proto.Message) []string {
+	var names []string
+	reflected := proto.MessageReflect(message)
+	if !reflected.IsValid() {
+		return nil
+	}
+	reflected.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		names = append(names, field.JSONName())
+		return true
+	})
+	return names
+}
+
+// PrintExplanations writes the explanations in a form for people to read.
+func PrintExplanations(w io.Writer, request *Request, explanations []*RouteConfigExplanation) {
+	if len(explanations) == 0 {
+		fmt.Fprintln(w, "The proxy has no route configurations")
+		return
+	}
+	for i, explanation := range explanations {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "Route configuration %v:\n", explanation.RouteConfigName)
+		if explanation.VirtualHost == nil {
+			fmt.Fprintf(w, "  No virtual host matches the host %q\n", request.Host)
+			continue
+		}
+		fmt.Fprintf(w, "  Virtual host %v matches the host %q with the domain %q\n",
+			explanation.VirtualHost.GetName(), request.Host, explanation.Domain)
+
+		if explanation.Route == nil {
+			fmt.Fprintln(w, "  No route matches the request, so Envoy responds with a 404")
+		} else {
+			fmt.Fprintln(w, "  Matched route:")
+			printRoute(w, "    ", explanation.Route, true)
+		}
+
+		if len(explanation.ShadowedRoutes) > 0 {
+			fmt.Fprintln(w, "  Later routes which also match the request, but are shadowed by the matched route:")
+			for _, route := range explanation.ShadowedRoutes {
+				printRoute(w, "    ", route, false)
+			}
+		}
+
+		if len(explanation.UnreachableRoutes) > 0 {
+			fmt.Fprintln(w, "  Routes which never match, as an earlier route matches every request they do:")
+			for _, unreachable := range explanation.UnreachableRoutes {
+				printRoute(w, "    ", unreachable.Route, false)
+				fmt.Fprintf(w, "      shadowed by: %v (%v)\n",
+					unreachable.ShadowedBy.Route.GetName(), describeMatch(unreachable.ShadowedBy.Route.GetMatch()))
+			}
+		}
+	}
+}
+
+func printRoute(w io.Writer, indent string, route *RouteExplanation, detailed bool) {
+	fmt.Fprintf(w, "%v%v\n", indent, route.Route.GetName())
+	fmt.Fprintf(w, "%v  match:  %v\n", indent, describeMatch(route.Route.GetMatch()))
+	if detailed {
+		fmt.Fprintf(w, "%v  action: %v\n", indent, describeAction(route.Route))
+	}
+	if len(route.Sources) > 0 {
+		var sources []string
+		for _, source := range route.Sources {
+			sources = append(sources, describeSource(source))
+		}
+		fmt.Fprintf(w, "%v  from:   %v\n", indent, strings.Join(sources, " -> "))
+	}
+	if detailed && len(route.Options) > 0 {
+		fmt.Fprintf(w, "%v  options (those set on the route take precedence over the virtual host's):\n", indent)
+		for _, option := range route.Options {
+			var setOn []string
+			if option.OnRoute {
+				setOn = append(setOn, "route")
+			}
+			if option.OnVirtualHost {
+				setOn = append(setOn, "virtual host")
+			}
+			fmt.Fprintf(w, "%v    %v: %v\n", indent, option.Name, strings.Join(setOn, ", "))
+		}
+	}
+}
+
+func describeSource(source gwtranslator.SourceRef) string {
+	kind := source.ResourceKind
+	if i := strings.LastIndex(kind, "."); i >= 0 {
+		kind = kind[i+1:]
+	}
+	description := kind + " " + source.ResourceRef.Key()
+	// resources read from files have no generation
+	if source.ObservedGeneration != 0 {
+		description += fmt.Sprintf(" (generation %v)", source.ObservedGeneration)
+	}
+	return description
+}
+
+func describeAction(route *envoyroute.Route) string {
+	switch action := route.GetAction().(type) {
+	case *envoyroute.Route_Route:
+		switch clusterSpecifier := action.Route.GetClusterSpecifier().(type) {
+		case *envoyroute.RouteAction_Cluster:
+			return "route to cluster " + clusterSpecifier.Cluster
+		case *envoyroute.RouteAction_ClusterHeader:
+			return "route to the cluster named by the header " + clusterSpecifier.ClusterHeader
+		case *envoyroute.RouteAction_WeightedClusters:
+			var clusters []string
+			for _, cluster := range clusterSpecifier.WeightedClusters.GetClusters() {
+				clusters = append(clusters, fmt.Sprintf("%v (weight %v)", cluster.GetName(), cluster.GetWeight().GetValue()))
+			}
+			return "route to clusters " + strings.Join(clusters, ", ")
+		}
+		return "route"
+	case *envoyroute.Route_Redirect:
+		return "redirect"
+	case *envoyroute.Route_DirectResponse:
+		return fmt.Sprintf("respond with status %v", action.DirectResponse.GetStatus())
+	}
+	return "none"
+}
@@ -0,0 +1,334 @@
+package explain
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+)
+
+// Request is the request to simulate Envoy's routing for.
+type Request struct {
+	Host   string
+	Method string
+	// the path, which may include a query string
+	Path string
+	// header names are matched case-insensitively, as Envoy lower cases them
+	Headers map[string]string
+}
+
+// the parts of the request that Envoy matches routes against
+type requestAttributes struct {
+	path    string
+	query   url.Values
+	headers map[string]string
+}
+
+func (r *Request) attributes() *requestAttributes {
+	path, rawQuery := r.Path, ""
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, rawQuery = path[:i], path[i+1:]
+	}
+	query, _ := url.ParseQuery(rawQuery)
+
+	headers := map[string]string{
+		":authority": r.Host,
+		":method":    r.Method,
+		":path":      r.Path,
+	}
+	for name, value := range r.Headers {
+		headers[strings.ToLower(name)] = value
+	}
+	return &requestAttributes{
+		path:    path,
+		query:   query,
+		headers: headers,
+	}
+}
+
+// selectVirtualHost returns the virtual host that Envoy selects for the host, and the domain of it which matched,
+// in the same order as Envoy: exact domains, then the longest suffix wildcard, then the longest prefix wildcard,
+// then the "*" domain.
+func selectVirtualHost(virtualHosts []*envoyroute.VirtualHost, host string) (*envoyroute.VirtualHost, string) {
+	host = strings.ToLower(host)
+
+	var (
+		best       *envoyroute.VirtualHost
+		bestDomain string
+		bestRank   int
+	)
+	for _, virtualHost := range virtualHosts {
+		for _, domain := range virtualHost.GetDomains() {
+			rank := domainRank(strings.ToLower(domain), host)
+			if rank > bestRank {
+				best, bestDomain, bestRank = virtualHost, domain, rank
+			}
+		}
+	}
+	return best, bestDomain
+}
+
+// ranks how specifically the domain matches the host, 0 meaning that it does not match
+func domainRank(domain, host string) int {
+	const (
+		// wildcards are ranked by their length below these
+		exactRank  = 3 << 16
+		suffixRank = 2 << 16
+		prefixRank = 1 << 16
+		anyRank    = 1
+	)
+	switch {
+	case domain == host:
+		return exactRank
+	case domain == "*":
+		return anyRank
+	case strings.HasPrefix(domain, "*") && len(host) > len(domain)-1 && strings.HasSuffix(host, domain[1:]):
+		return suffixRank + len(domain)
+	case strings.HasSuffix(domain, "*") && len(host) > len(domain)-1 && strings.HasPrefix(host, domain[:len(domain)-1]):
+		return prefixRank + len(domain)
+	}
+	return 0
+}
+
+// matchesRoute returns whether Envoy would select the route for the request, if no earlier route matched it
+func matchesRoute(match *envoyroute.RouteMatch, req *requestAttributes) bool {
+	return matchesPath(match, req.path) &&
+		matchesHeaders(match.GetHeaders(), req.headers) &&
+		matchesQueryParameters(match.GetQueryParameters(), req.query)
+}
+
+func matchesPath(match *envoyroute.RouteMatch, path string) bool {
+	caseSensitive := match.GetCaseSensitive() == nil || match.GetCaseSensitive().GetValue()
+	if !caseSensitive {
+		path = strings.ToLower(path)
+	}
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoyroute.RouteMatch_Prefix:
+		prefix := specifier.Prefix
+		if !caseSensitive {
+			prefix = strings.ToLower(prefix)
+		}
+		return strings.HasPrefix(path, prefix)
+	case *envoyroute.RouteMatch_Path:
+		exact := specifier.Path
+		if !caseSensitive {
+			exact = strings.ToLower(exact)
+		}
+		return path == exact
+	case *envoyroute.RouteMatch_SafeRegex:
+		return matchesRegex(specifier.SafeRegex.GetRegex(), path)
+	}
+	return false
+}
+
+func matchesHeaders(matchers []*envoyroute.HeaderMatcher, headers map[string]string) bool {
+	for _, matcher := range matchers {
+		if matchesHeader(matcher, headers) == matcher.GetInvertMatch() {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesHeader(matcher *envoyroute.HeaderMatcher, headers map[string]string) bool {
+	value, present := headers[strings.ToLower(matcher.GetName())]
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoyroute.HeaderMatcher_PresentMatch:
+		return present == specifier.PresentMatch
+	case nil:
+		return present
+	}
+	if !present {
+		return false
+	}
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoyroute.HeaderMatcher_ExactMatch:
+		return value == specifier.ExactMatch
+	case *envoyroute.HeaderMatcher_SafeRegexMatch:
+		return matchesRegex(specifier.SafeRegexMatch.GetRegex(), value)
+	case *envoyroute.HeaderMatcher_PrefixMatch:
+		return strings.HasPrefix(value, specifier.PrefixMatch)
+	case *envoyroute.HeaderMatcher_SuffixMatch:
+		return strings.HasSuffix(value, specifier.SuffixMatch)
+	case *envoyroute.HeaderMatcher_ContainsMatch:
+		return strings.Contains(value, specifier.ContainsMatch)
+	case *envoyroute.HeaderMatcher_StringMatch:
+		return matchesString(specifier.StringMatch, value)
+	case *envoyroute.HeaderMatcher_RangeMatch:
+		number, err := strconv.ParseInt(value, 10, 64)
+		return err == nil && number >= specifier.RangeMatch.GetStart() && number < specifier.RangeMatch.GetEnd()
+	}
+	return false
+}
+
+func matchesQueryParameters(matchers []*envoyroute.QueryParameterMatcher, query url.Values) bool {
+	for _, matcher := range matchers {
+		values, present := query[matcher.GetName()]
+		switch specifier := matcher.GetQueryParameterMatchSpecifier().(type) {
+		case *envoyroute.QueryParameterMatcher_PresentMatch:
+			if present != specifier.PresentMatch {
+				return false
+			}
+		case *envoyroute.QueryParameterMatcher_StringMatch:
+			if !present || !matchesString(specifier.StringMatch, values[0]) {
+				return false
+			}
+		default:
+			if !present {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchesString(matcher *envoymatcher.StringMatcher, value string) bool {
+	if matcher.GetIgnoreCase() {
+		value = strings.ToLower(value)
+	}
+	fold := func(s string) string {
+		if matcher.GetIgnoreCase() {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+	switch pattern := matcher.GetMatchPattern().(type) {
+	case *envoymatcher.StringMatcher_Exact:
+		return value == fold(pattern.Exact)
+	case *envoymatcher.StringMatcher_Prefix:
+		return strings.HasPrefix(value, fold(pattern.Prefix))
+	case *envoymatcher.StringMatcher_Suffix:
+		return strings.HasSuffix(value, fold(pattern.Suffix))
+	case *envoymatcher.StringMatcher_Contains:
+		return strings.Contains(value, fold(pattern.Contains))
+	case *envoymatcher.StringMatcher_SafeRegex:
+		return matchesRegex(pattern.SafeRegex.GetRegex(), value)
+	}
+	return false
+}
+
+// Envoy's regexes are RE2, which Go's regexp implements, and must match the whole value
+func matchesRegex(expr, value string) bool {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	return err == nil && re.MatchString(value)
+}
+
+// shadows returns whether every request which matches the later route also matches the earlier route, so that the
+// later route can never be selected. Only routes which match on the path alone are considered able to shadow others.
+func shadows(earlier, later *envoyroute.RouteMatch) bool {
+	if len(earlier.GetHeaders()) > 0 || len(earlier.GetQueryParameters()) > 0 ||
+		earlier.GetRuntimeFraction() != nil || earlier.GetGrpc() != nil || earlier.GetTlsContext() != nil {
+		return false
+	}
+	earlierCaseSensitive := earlier.GetCaseSensitive() == nil || earlier.GetCaseSensitive().GetValue()
+	laterCaseSensitive := later.GetCaseSensitive() == nil || later.GetCaseSensitive().GetValue()
+	if earlierCaseSensitive && !laterCaseSensitive {
+		// the later route matches paths in cases that the earlier route does not
+		return false
+	}
+	fold := func(s string) string {
+		if earlierCaseSensitive {
+			return s
+		}
+		return strings.ToLower(s)
+	}
+
+	var earlierPrefix string
+	switch specifier := earlier.GetPathSpecifier().(type) {
+	case *envoyroute.RouteMatch_Prefix:
+		earlierPrefix = fold(specifier.Prefix)
+	case *envoyroute.RouteMatch_Path:
+		exact, ok := later.GetPathSpecifier().(*envoyroute.RouteMatch_Path)
+		return ok && fold(exact.Path) == fold(specifier.Path)
+	default:
+		return false
+	}
+	// every path starts with a /
+	if earlierPrefix == "" || earlierPrefix == "/" {
+		return true
+	}
+	switch specifier := later.GetPathSpecifier().(type) {
+	case *envoyroute.RouteMatch_Prefix:
+		return strings.HasPrefix(fold(specifier.Prefix), earlierPrefix)
+	case *envoyroute.RouteMatch_Path:
+		return strings.HasPrefix(fold(specifier.Path), earlierPrefix)
+	}
+	return false
+}
+
+// describeMatch renders the route match in a single line, in terms close to those of Gloo's matchers
+func describeMatch(match *envoyroute.RouteMatch) string {
+	var parts []string
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoyroute.RouteMatch_Prefix:
+		parts = append(parts, "prefix "+specifier.Prefix)
+	case *envoyroute.RouteMatch_Path:
+		parts = append(parts, "exact "+specifier.Path)
+	case *envoyroute.RouteMatch_SafeRegex:
+		parts = append(parts, "regex "+specifier.SafeRegex.GetRegex())
+	}
+	if len(parts) > 0 && match.GetCaseSensitive() != nil && !match.GetCaseSensitive().GetValue() {
+		parts[0] += " (case insensitive)"
+	}
+	for _, header := range match.GetHeaders() {
+		parts = append(parts, "header "+describeHeaderMatcher(header))
+	}
+	var queryParts []string
+	for _, param := range match.GetQueryParameters() {
+		if param.GetStringMatch() != nil {
+			queryParts = append(queryParts, "query "+param.GetName()+describeStringMatcher(param.GetStringMatch()))
+		} else {
+			queryParts = append(queryParts, "query "+param.GetName()+" present")
+		}
+	}
+	sort.Strings(queryParts)
+	return strings.Join(append(parts, queryParts...), ", ")
+}
+
+func describeHeaderMatcher(matcher *envoyroute.HeaderMatcher) string {
+	name := matcher.GetName()
+	if matcher.GetInvertMatch() {
+		name = "not " + name
+	}
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoyroute.HeaderMatcher_ExactMatch:
+		return name + "=" + specifier.ExactMatch
+	case *envoyroute.HeaderMatcher_SafeRegexMatch:
+		return name + "~=" + specifier.SafeRegexMatch.GetRegex()
+	case *envoyroute.HeaderMatcher_PrefixMatch:
+		return name + " prefix " + specifier.PrefixMatch
+	case *envoyroute.HeaderMatcher_SuffixMatch:
+		return name + " suffix " + specifier.SuffixMatch
+	case *envoyroute.HeaderMatcher_ContainsMatch:
+		return name + " contains " + specifier.ContainsMatch
+	case *envoyroute.HeaderMatcher_StringMatch:
+		return name + describeStringMatcher(specifier.StringMatch)
+	case *envoyroute.HeaderMatcher_RangeMatch:
+		return name + " in [" + strconv.FormatInt(specifier.RangeMatch.GetStart(), 10) + ", " + strconv.FormatInt(specifier.RangeMatch.GetEnd(), 10) + ")"
+	case *envoyroute.HeaderMatcher_PresentMatch:
+		if !specifier.PresentMatch {
+			return name + " absent"
+		}
+	}
+	return name + " present"
+}
+
+func describeStringMatcher(matcher *envoymatcher.StringMatcher) string {
+	switch pattern := matcher.GetMatchPattern().(type) {
+	case *envoymatcher.StringMatcher_Exact:
+		return "=" + pattern.Exact
+	case *envoymatcher.StringMatcher_Prefix:
+		return " prefix " + pattern.Prefix
+	case *envoymatcher.StringMatcher_Suffix:
+		return " suffix " + pattern.Suffix
+	case *envoymatcher.StringMatcher_Contains:
+		return " contains " + pattern.Contains
+	case *envoymatcher.StringMatcher_SafeRegex:
+		return "~=" + pattern.SafeRegex.GetRegex()
+	}
+	return " present"
+}
@@ -0,0 +1,184 @@
+package explain
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Match", func() {
+
+	prefix := func(prefix string) *envoyroute.RouteMatch {
+		return &envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: prefix}}
+	}
+	exact := func(path string) *envoyroute.RouteMatch {
+		return &envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Path{Path: path}}
+	}
+	regex := func(regex string) *envoyroute.RouteMatch {
+		return &envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_SafeRegex{
+			SafeRegex: &envoymatcher.RegexMatcher{Regex: regex},
+		}}
+	}
+	caseInsensitive := func(match *envoyroute.RouteMatch) *envoyroute.RouteMatch {
+		match.CaseSensitive = &wrappers.BoolValue{Value: false}
+		return match
+	}
+	withHeader := func(match *envoyroute.RouteMatch, header *envoyroute.HeaderMatcher) *envoyroute.RouteMatch {
+		match.Headers = append(match.Headers, header)
+		return match
+	}
+	withQuery := func(match *envoyroute.RouteMatch, param *envoyroute.QueryParameterMatcher) *envoyroute.RouteMatch {
+		match.QueryParameters = append(match.QueryParameters, param)
+		return match
+	}
+
+	Context("selectVirtualHost", func() {
+
+		virtualHosts := []*envoyroute.VirtualHost{
+			{Name: "any", Domains: []string{"*"}},
+			{Name: "prefix", Domains: []string{"api.*"}},
+			{Name: "suffix", Domains: []string{"*.example.com"}},
+			{Name: "longer-suffix", Domains: []string{"*.api.example.com"}},
+			{Name: "exact", Domains: []string{"api.example.com", "Other.Example.com"}},
+		}
+
+		DescribeTable("selects virtual hosts in the same order as envoy",
+			func(host, expectedName, expectedDomain string) {
+				virtualHost, domain := selectVirtualHost(virtualHosts, host)
+				Expect(virtualHost.GetName()).To(Equal(expectedName))
+				Expect(domain).To(Equal(expectedDomain))
+			},
+			Entry("exact domain", "api.example.com", "exact", "api.example.com"),
+			Entry("exact domain in another case", "other.example.COM", "exact", "Other.Example.com"),
+			Entry("longest suffix wildcard", "v1.api.example.com", "longer-suffix", "*.api.example.com"),
+			Entry("suffix wildcard before prefix wildcard", "api.v1.example.com", "suffix", "*.example.com"),
+			Entry("prefix wildcard", "api.example.org", "prefix", "api.*"),
+			Entry("suffix wildcard", "www.example.com", "suffix", "*.example.com"),
+			Entry("any domain", "foo.bar", "any", "*"),
+		)
+
+		It("returns nil when no domain matches", func() {
+			virtualHost, _ := selectVirtualHost(virtualHosts[1:], "foo.bar")
+			Expect(virtualHost).To(BeNil())
+		})
+
+		It("requires wildcards to match at least one character", func() {
+			virtualHost, _ := selectVirtualHost(virtualHosts[2:3], ".example.com")
+			Expect(virtualHost).To(BeNil())
+		})
+	})
+
+	Context("matchesRoute", func() {
+
+		request := &Request{
+			Host:   "example.com",
+			Method: "POST",
+			Path:   "/api/Pets?color=brown&sort",
+			Headers: map[string]string{
+				"X-Canary": "true",
+				"x-count":  "5",
+			},
+		}
+
+		DescribeTable("matches the request",
+			func(match *envoyroute.RouteMatch, expected bool) {
+				Expect(matchesRoute(match, request.attributes())).To(Equal(expected))
+			},
+			Entry("prefix", prefix("/api/"), true),
+			Entry("different prefix", prefix("/static"), false),
+			Entry("prefix in another case", prefix("/api/pets"), false),
+			Entry("case insensitive prefix", caseInsensitive(prefix("/API/pets")), true),
+			Entry("exact path without the query string", exact("/api/Pets"), true),
+			Entry("different exact path", exact("/api"), false),
+			Entry("regex matching the whole path", regex("/api/[A-Z][a-z]+"), true),
+			Entry("regex matching part of the path", regex("/api"), false),
+			Entry("exact header", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-canary",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+			}), true),
+			Entry("different header value", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-canary",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "false"},
+			}), false),
+			Entry("inverted header", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-canary",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "false"},
+				InvertMatch:          true,
+			}), true),
+			Entry("present header", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-count",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+			}), true),
+			Entry("missing header", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-missing",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+			}), false),
+			Entry("header range", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 "x-count",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_RangeMatch{RangeMatch: &envoytype.Int64Range{Start: 1, End: 10}},
+			}), true),
+			Entry("method, as gloo matches it", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 ":method",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_SafeRegexMatch{SafeRegexMatch: &envoymatcher.RegexMatcher{Regex: "GET|POST"}},
+			}), true),
+			Entry("authority", withHeader(prefix("/"), &envoyroute.HeaderMatcher{
+				Name:                 ":authority",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_SuffixMatch{SuffixMatch: ".com"},
+			}), true),
+			Entry("query parameter", withQuery(prefix("/"), &envoyroute.QueryParameterMatcher{
+				Name: "color",
+				QueryParameterMatchSpecifier: &envoyroute.QueryParameterMatcher_StringMatch{StringMatch: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "BROWN"},
+					IgnoreCase:   true,
+				}},
+			}), true),
+			Entry("present query parameter without a value", withQuery(prefix("/"), &envoyroute.QueryParameterMatcher{
+				Name:                         "sort",
+				QueryParameterMatchSpecifier: &envoyroute.QueryParameterMatcher_PresentMatch{PresentMatch: true},
+			}), true),
+			Entry("missing query parameter", withQuery(prefix("/"), &envoyroute.QueryParameterMatcher{
+				Name:                         "size",
+				QueryParameterMatchSpecifier: &envoyroute.QueryParameterMatcher_PresentMatch{PresentMatch: true},
+			}), false),
+		)
+	})
+
+	Context("shadows", func() {
+
+		DescribeTable("determines whether the earlier route matches every request the later one does",
+			func(earlier, later *envoyroute.RouteMatch, expected bool) {
+				Expect(shadows(earlier, later)).To(Equal(expected))
+			},
+			Entry("shorter prefix", prefix("/api"), prefix("/api/pets"), true),
+			Entry("longer prefix", prefix("/api/pets"), prefix("/api"), false),
+			Entry("prefix of an exact path", prefix("/api"), exact("/api/pets"), true),
+			Entry("root prefix of a regex", prefix("/"), regex("/api/.*"), true),
+			Entry("prefix of a regex", prefix("/api"), regex("/api/.*"), false),
+			Entry("same exact path", exact("/api"), exact("/api"), true),
+			Entry("exact path of a prefix", exact("/api"), prefix("/api"), false),
+			Entry("earlier route with headers", withHeader(prefix("/"), &envoyroute.HeaderMatcher{Name: "x-canary"}), prefix("/api"), false),
+			Entry("later route with headers", prefix("/"), withHeader(prefix("/api"), &envoyroute.HeaderMatcher{Name: "x-canary"}), true),
+			Entry("case insensitive earlier route", caseInsensitive(prefix("/API")), prefix("/api/pets"), true),
+			Entry("case insensitive later route", prefix("/api"), caseInsensitive(prefix("/api/pets")), false),
+		)
+	})
+
+	Context("describeMatch", func() {
+
+		It("describes the path, headers and query parameters", func() {
+			match := withQuery(withHeader(caseInsensitive(prefix("/api")), &envoyroute.HeaderMatcher{
+				Name:                 "x-canary",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+				InvertMatch:          true,
+			}), &envoyroute.QueryParameterMatcher{
+				Name:                         "sort",
+				QueryParameterMatchSpecifier: &envoyroute.QueryParameterMatcher_PresentMatch{PresentMatch: true},
+			})
+			Expect(describeMatch(match)).To(Equal("prefix /api (case insensitive), header not x-canary=true, query sort present"))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package explain
+
+import (
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.EXPLAIN_COMMAND.Use,
+		Short: constants.EXPLAIN_COMMAND.Short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return constants.SubcommandError
+		},
+	}
+
+	cmd.AddCommand(RouteCmd(opts))
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func RouteCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.EXPLAIN_ROUTE_COMMAND.Use,
+		Short: constants.EXPLAIN_ROUTE_COMMAND.Short,
+		Long:  constants.EXPLAIN_ROUTE_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explainRoute(opts, cmd.OutOrStdout())
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	pflags.StringVar(&opts.Proxy.Name, "name", defaults.GatewayProxyName, "the name of the proxy to explain the routing of")
+	flagutils.AddExplainRouteFlags(pflags, &opts.Explain)
+	flagutils.AddVerboseFlag(pflags, opts)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
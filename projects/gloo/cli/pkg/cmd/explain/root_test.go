@@ -0,0 +1,65 @@
+package explain_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+var _ = Describe("Explain route", func() {
+
+	const (
+		explain = "explain route -f testdata --host petstore.example.com"
+	)
+
+	It("explains the route delegated to through route tables, and the routes it shadows", func() {
+		out, err := testutils.GlooctlOut(explain + " --path /api/pets/1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring(`Virtual host gloo-system_petstore matches the host "petstore.example.com" with the domain "petstore.example.com"`))
+		Expect(out).To(ContainSubstring(`  Matched route:
+    gloo-system_petstore-route-1-matcher-0
+      match:  prefix /api/pets
+      action: route to cluster petstore_gloo-system
+      from:   VirtualService gloo-system.petstore -> RouteTable gloo-system.api -> RouteTable gloo-system.pets
+      options (those set on the route take precedence over the virtual host's):
+        cors: route, virtual host
+        timeout: route
+`))
+		Expect(out).To(ContainSubstring(`  Later routes which also match the request, but are shadowed by the matched route:
+    gloo-system_petstore-route-2-matcher-0
+      match:  prefix /
+`))
+		Expect(out).To(ContainSubstring(`  Routes which never match, as an earlier route matches every request they do:
+    gloo-system_petstore-route-3-matcher-0
+      match:  prefix /static
+      from:   VirtualService gloo-system.petstore
+      shadowed by: gloo-system_petstore-route-2-matcher-0 (prefix /)`))
+	})
+
+	It("matches routes on headers", func() {
+		out, err := testutils.GlooctlOut(explain + " --path /api/pets --header X-Canary=true")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring(`  Matched route:
+    gloo-system_petstore-route-0-matcher-0
+      match:  prefix /api, header x-canary=true
+      action: route to cluster petstore-canary_gloo-system
+`))
+	})
+
+	It("selects the virtual host by the host", func() {
+		out, err := testutils.GlooctlOut("explain route -f testdata --host other.example.com --path /api/pets")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring(`Virtual host gloo-system_default matches the host "other.example.com" with the domain "*"`))
+		Expect(out).To(ContainSubstring("action: respond with status 418"))
+	})
+
+	It("errors on headers without a value", func() {
+		err := testutils.Glooctl(explain + " --header x-canary")
+		Expect(err).To(MatchError(`invalid header "x-canary", headers must be given as name=value`))
+	})
+
+	It("errors when the proxy has no gateways", func() {
+		err := testutils.Glooctl(explain + " --name other-proxy")
+		Expect(err).To(MatchError(ContainSubstring("no gateways are configured for proxy other-proxy")))
+	})
+})
@@ -0,0 +1,91 @@
+package explain
+
+import (
+	"io"
+	"strings"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var (
+	InvalidHeaderErr = func(header string) error {
+		return eris.Errorf("invalid header %q, headers must be given as name=value", header)
+	}
+	ProxyNotFoundErr = func(namespace, name string) error {
+		return eris.Errorf("proxy %v.%v was not found", namespace, name)
+	}
+)
+
+func explainRoute(opts *options.Options, out io.Writer) error {
+	request, err := requestFromOptions(opts.Explain)
+	if err != nil {
+		return err
+	}
+	proxy, xdsDump, err := proxyConfig(opts)
+	if err != nil {
+		return err
+	}
+	routeConfigs := make([]*envoyroute.RouteConfiguration, len(xdsDump.Routes))
+	for i := range xdsDump.Routes {
+		routeConfigs[i] = &xdsDump.Routes[i]
+	}
+	PrintExplanations(out, request, ExplainRoute(opts.Top.Ctx, proxy, routeConfigs, request))
+	return nil
+}
+
+func requestFromOptions(explain options.Explain) (*Request, error) {
+	request := &Request{
+		Host:    explain.Host,
+		Path:    explain.Path,
+		Method:  explain.Method,
+		Headers: map[string]string{},
+	}
+	for _, header := range explain.Headers {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok || name == "" {
+			return nil, InvalidHeaderErr(header)
+		}
+		// envoy joins the values of repeated headers when matching them
+		if existing, ok := request.Headers[strings.ToLower(name)]; ok {
+			value = existing + "," + value
+		}
+		request.Headers[strings.ToLower(name)] = value
+	}
+	return request, nil
+}
+
+// proxyConfig returns the proxy and the xDS resources served to it, rendered from the files given by -f, or else
+// read from Gloo.
+func proxyConfig(opts *options.Options) (*v1.Proxy, *xdsinspection.XdsDump, error) {
+	ctx := opts.Top.Ctx
+	namespace := opts.Metadata.GetNamespace()
+	name := opts.Proxy.Name
+
+	if opts.Top.File != "" {
+		cfg, err := localconfig.Load(ctx, opts.Top.File, namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		proxy, xdsDump, _, err := localconfig.RenderProxy(ctx, cfg, namespace, name)
+		return proxy, xdsDump, err
+	}
+
+	proxies, err := common.GetProxies(name, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(proxies) == 0 {
+		return nil, nil, ProxyNotFoundErr(namespace, name)
+	}
+	xdsDump, err := xdsinspection.GetGlooXdsDump(ctx, name, namespace, opts.Top.Verbose)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proxies[0], xdsDump, nil
+}
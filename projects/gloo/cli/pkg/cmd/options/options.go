@@ -32,6 +32,9 @@ type Options struct {
 	Remove    Remove
 	Cluster   Cluster
 	Check     Check
+	Validate  Validate
+	Render    Render
+	Explain   Explain
 }
 
 type Top struct {
@@ -449,3 +452,26 @@ type Check struct {
 	// The maximum length of time to wait before giving up on a secret request. A value of zero means no timeout.
 	SecretClientTimeout time.Duration
 }
+
+type Validate struct {
+	// Do not fail validation if resources only have warnings.
+	AllowWarnings bool
+}
+
+type Render struct {
+	// File or directory to render and compare the rendered config against.
+	DiffFile string
+	// Git ref at which to render the file or directory and compare the rendered config against.
+	DiffGitRef string
+}
+
+type Explain struct {
+	// Host of the request to explain the routing of.
+	Host string
+	// Path of the request, including any query string.
+	Path string
+	// Method of the request.
+	Method string
+	// Headers of the request, each as name=value.
+	Headers []string
+}
@@ -0,0 +1,76 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	GitErr = func(err error, args []string, stderr string) error {
+		return eris.Wrapf(err, "running git %v: %v", strings.Join(args, " "), strings.TrimSpace(stderr))
+	}
+	NoFilesAtGitRefErr = func(ref, path string) error {
+		return eris.Errorf("no files were found at %v in git ref %v", path, ref)
+	}
+)
+
+// copies the files under path, as they are at the given git ref, into a new temporary directory,
+// which the caller must remove
+func filesAtGitRef(ctx context.Context, ref, path string) (string, error) {
+	// paths are listed relative to the current directory, in the same way as path
+	out, err := git(ctx, "ls-tree", "-r", "--name-only", ref, "--", path)
+	if err != nil {
+		return "", err
+	}
+	files := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if files[0] == "" {
+		files = nil
+	}
+	if len(files) == 0 {
+		return "", NoFilesAtGitRefErr(ref, path)
+	}
+
+	dir, err := os.MkdirTemp("", "glooctl-render")
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		contents, err := git(ctx, "show", ref+":./"+file)
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+		// keep the layout of the files below path, so the loaded files match those at path
+		rel, err := filepath.Rel(path, file)
+		if err != nil || rel == "." {
+			rel = filepath.Base(file)
+		}
+		dest := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+		if err := os.WriteFile(dest, contents, 0644); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+func git(ctx context.Context, args ...string) ([]byte, error) {
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, GitErr(err, args, stderr.String())
+	}
+	return out, nil
+}
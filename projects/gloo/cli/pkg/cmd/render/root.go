@@ -0,0 +1,108 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingFileErr = eris.New("a file or directory to render must be provided with -f")
+	DiffFlagsErr   = eris.New("only one of --diff-file and --diff-git-ref may be provided")
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.RENDER_COMMAND.Use,
+		Short: constants.RENDER_COMMAND.Short,
+		Long:  constants.RENDER_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.File == "" {
+				return MissingFileErr
+			}
+			if opts.Render.DiffFile != "" && opts.Render.DiffGitRef != "" {
+				return DiffFlagsErr
+			}
+			return render(opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	pflags.StringVar(&opts.Proxy.Name, "name", defaults.GatewayProxyName, "the name of the proxy to render the config for")
+	flagutils.AddRenderFlags(pflags, &opts.Render)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func render(opts *options.Options, out, errOut io.Writer) error {
+	ctx := opts.Top.Ctx
+	rendered, err := renderPath(ctx, opts, opts.Top.File, errOut)
+	if err != nil {
+		return err
+	}
+
+	var basePath, baseLabel string
+	switch {
+	case opts.Render.DiffFile != "":
+		basePath = opts.Render.DiffFile
+		baseLabel = opts.Render.DiffFile
+	case opts.Render.DiffGitRef != "":
+		dir, err := filesAtGitRef(ctx, opts.Render.DiffGitRef, opts.Top.File)
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		basePath = dir
+		baseLabel = opts.Render.DiffGitRef + ":" + opts.Top.File
+	default:
+		_, err := fmt.Fprint(out, rendered)
+		return err
+	}
+
+	baseRendered, err := renderPath(ctx, opts, basePath, errOut)
+	if err != nil {
+		return eris.Wrapf(err, "rendering %v", baseLabel)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(baseRendered),
+		B:        difflib.SplitLines(rendered),
+		FromFile: baseLabel,
+		ToFile:   opts.Top.File,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, diff)
+	return err
+}
+
+// renders the config for the proxy from the file or directory at path, and reports any errors in the config
+// to errOut, as Gloo would still serve the rendered config
+func renderPath(ctx context.Context, opts *options.Options, path string, errOut io.Writer) (string, error) {
+	cfg, err := localconfig.Load(ctx, path, opts.Metadata.GetNamespace())
+	if err != nil {
+		return "", err
+	}
+	xdsDump, reports, err := localconfig.Render(ctx, cfg, opts.Metadata.GetNamespace(), opts.Proxy.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := reports.ValidateStrict(); err != nil {
+		fmt.Fprintf(errOut, "the config in %v has errors or warnings, run glooctl validate for details: %v\n", path, err)
+	}
+	return xdsDump.String(), nil
+}
@@ -0,0 +1,128 @@
+package render_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+var _ = Describe("Render", func() {
+
+	const (
+		valid   = "../../localconfig/testdata/valid"
+		warning = "../../localconfig/testdata/warning"
+	)
+
+	It("prints the rendered config", func() {
+		out, err := testutils.GlooctlOut("render -f " + valid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("#role: gloo-system~gateway-proxy"))
+		Expect(out).To(ContainSubstring("name: petstore_gloo-system"))
+		Expect(out).To(ContainSubstring("routeConfigName: listener-::-8080-routes"))
+		Expect(out).To(ContainSubstring("prefix: /api/pets"))
+	})
+
+	It("prints the difference from the config rendered from another file", func() {
+		out, err := testutils.GlooctlOut("render -f " + valid + " --diff-file " + warning)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("--- " + warning + "\n+++ " + valid + "\n"))
+		Expect(out).To(ContainSubstring("-  name: default_missing-upstream\n+  name: default_petstore\n"))
+		Expect(out).To(ContainSubstring("+name: petstore_gloo-system\n"))
+		// errors in the config are reported, but the config is still rendered
+		Expect(out).To(ContainSubstring("the config in " + warning + " has errors or warnings"))
+	})
+
+	It("prints nothing when the rendered config is the same", func() {
+		out, err := testutils.GlooctlOut("render -f " + valid + " --diff-file " + valid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(BeEmpty())
+	})
+
+	It("errors when the proxy has no gateways", func() {
+		err := testutils.Glooctl("render -f " + valid + " --name other-proxy")
+		Expect(err).To(MatchError(ContainSubstring("no gateways are configured for proxy other-proxy")))
+	})
+
+	It("errors when both diff flags are provided", func() {
+		err := testutils.Glooctl("render -f " + valid + " --diff-file " + warning + " --diff-git-ref main")
+		Expect(err).To(MatchError("only one of --diff-file and --diff-git-ref may be provided"))
+	})
+
+	Context("diffing against a git ref", func() {
+
+		var (
+			wd      string
+			repoDir string
+		)
+
+		git := func(args ...string) {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+			cmd.Dir = repoDir
+			out, err := cmd.CombinedOutput()
+			Expect(err).NotTo(HaveOccurred(), string(out))
+		}
+
+		copyFile := func(from, to string) {
+			contents, err := os.ReadFile(from)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(to, contents, 0644)).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			if _, err := exec.LookPath("git"); err != nil {
+				Skip("git is not installed")
+			}
+			var err error
+			wd, err = os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			repoDir, err = os.MkdirTemp("", "render-git")
+			Expect(err).NotTo(HaveOccurred())
+
+			configDir := filepath.Join(repoDir, "config")
+			Expect(os.MkdirAll(configDir, 0755)).NotTo(HaveOccurred())
+			for _, file := range []string{"gateway.yaml", "petstore.yaml", "settings.yaml"} {
+				copyFile(filepath.Join(valid, file), filepath.Join(configDir, file))
+			}
+			git("init", "-q")
+			git("add", "-A")
+			git("commit", "-q", "-m", "initial config")
+
+			// change the route in the working tree
+			petstore := filepath.Join(configDir, "petstore.yaml")
+			contents, err := os.ReadFile(petstore)
+			Expect(err).NotTo(HaveOccurred())
+			changed := strings.Replace(string(contents), "prefix: /api/pets", "prefix: /api/pets/v2", 1)
+			Expect(os.WriteFile(petstore, []byte(changed), 0644)).NotTo(HaveOccurred())
+
+			Expect(os.Chdir(repoDir)).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			if wd != "" {
+				Expect(os.Chdir(wd)).NotTo(HaveOccurred())
+			}
+			os.RemoveAll(repoDir)
+		})
+
+		It("prints the difference from the config rendered from the files at the ref", func() {
+			out, err := testutils.GlooctlOut("render -f config --diff-git-ref HEAD")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("--- HEAD:config\n+++ config\n"))
+			Expect(out).To(ContainSubstring("-      prefix: /api/pets\n+      prefix: /api/pets/v2\n"))
+		})
+
+		It("errors when the files do not exist at the ref", func() {
+			Expect(os.Mkdir("uncommitted", 0755)).NotTo(HaveOccurred())
+			copyFile(filepath.Join("config", "gateway.yaml"), filepath.Join("uncommitted", "gateway.yaml"))
+			copyFile(filepath.Join("config", "petstore.yaml"), filepath.Join("uncommitted", "petstore.yaml"))
+
+			err := testutils.Glooctl("render -f uncommitted --diff-git-ref HEAD")
+			Expect(err).To(MatchError("no files were found at uncommitted in git ref HEAD"))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/multierr"
+)
+
+const (
+	accepted = "Accepted"
+	warning  = "Warning"
+	rejected = "Rejected"
+)
+
+var (
+	ValidationFailedErr = func(rejectedCount, warningCount int) error {
+		return eris.Errorf("validation failed, %v resource(s) rejected and %v resource(s) with warnings", rejectedCount, warningCount)
+	}
+)
+
+// the errors and warnings reported for a single proxy or resource
+type report struct {
+	kind     string
+	ref      *core.ResourceRef
+	errors   []string
+	warnings []string
+}
+
+func (r *report) status() string {
+	switch {
+	case len(r.errors) > 0:
+		return rejected
+	case len(r.warnings) > 0:
+		return warning
+	}
+	return accepted
+}
+
+// prints the proxy and resource reports, and returns an error if any resource was rejected,
+// or if any resource has warnings and warnings are not allowed
+func printResult(w io.Writer, cfg *localconfig.Config, result *localconfig.Result, allowWarnings bool) error {
+	for _, skipped := range cfg.Skipped {
+		fmt.Fprintf(w, "skipping unsupported resource %v\n", skipped)
+	}
+
+	proxyReports := getProxyReports(result)
+	if len(proxyReports) == 0 {
+		fmt.Fprintln(w, "no proxies were generated, no Gateways were found")
+	}
+	resourceReports := getResourceReports(result)
+
+	var rejectedCount, warningCount int
+	for _, reports := range [][]*report{proxyReports, resourceReports} {
+		for _, rpt := range reports {
+			printReport(w, rpt)
+			switch rpt.status() {
+			case rejected:
+				rejectedCount++
+			case warning:
+				warningCount++
+			}
+		}
+	}
+
+	if rejectedCount > 0 || (warningCount > 0 && !allowWarnings) {
+		return ValidationFailedErr(rejectedCount, warningCount)
+	}
+	fmt.Fprintf(w, "validation succeeded, %v resource(s) with warnings\n", warningCount)
+	return nil
+}
+
+func printReport(w io.Writer, rpt *report) {
+	fmt.Fprintf(w, "%v %v: %v\n", rpt.kind, rpt.ref.Key(), rpt.status())
+	for _, err := range rpt.errors {
+		fmt.Fprintf(w, "  error: %v\n", err)
+	}
+	for _, warn := range rpt.warnings {
+		fmt.Fprintf(w, "  warning: %v\n", warn)
+	}
+}
+
+func getProxyReports(result *localconfig.Result) []*report {
+	var reports []*report
+	for _, proxyResult := range result.Proxies {
+		rpt := &report{kind: "Proxy", ref: proxyResult.ProxyRef}
+		if proxyResult.Proxy == nil {
+			rpt.errors = append(rpt.errors, "the gateways for this proxy could not be translated")
+		} else {
+			proxyReport := proxyResult.ValidationReport.GetProxyReport()
+			// the same error is reported by every virtual host which causes it
+			rpt.errors = appendUnique(nil, errorStrings(validationutils.GetProxyError(proxyReport))...)
+			rpt.warnings = validationutils.GetProxyWarning(proxyReport)
+		}
+		reports = append(reports, rpt)
+	}
+	return reports
+}
+
+// returns the reports for the gateway resources and the gloo resources referenced by the proxies,
+// ordered by kind and ref
+func getResourceReports(result *localconfig.Result) []*report {
+	reportsByKey := map[string]*report{}
+	add := func(kind string, ref *core.ResourceRef, errs, warnings []string) {
+		key := kind + " " + ref.Key()
+		rpt, ok := reportsByKey[key]
+		if !ok {
+			rpt = &report{kind: kind, ref: ref}
+			reportsByKey[key] = rpt
+		}
+		// the same gloo resource is reported on by every proxy which references it
+		rpt.errors = appendUnique(rpt.errors, errs...)
+		rpt.warnings = appendUnique(rpt.warnings, warnings...)
+	}
+
+	for resource, rpt := range result.ResourceReports {
+		add(kindName(resource), resource.GetMetadata().Ref(), errorStrings(rpt.Errors), rpt.Warnings)
+	}
+	for _, proxyResult := range result.Proxies {
+		validationReport := proxyResult.ValidationReport
+		for kind, resourceReports := range map[string][]*validation.ResourceReport{
+			"Upstream":      validationReport.GetUpstreamReports(),
+			"UpstreamGroup": validationReport.GetUpstreamGroupReports(),
+		} {
+			for _, resourceReport := range resourceReports {
+				add(kind, resourceReport.GetResourceRef(), resourceReport.GetErrors(), resourceReport.GetWarnings())
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(reportsByKey))
+	for key := range reportsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	reports := make([]*report, 0, len(keys))
+	for _, key := range keys {
+		reports = append(reports, reportsByKey[key])
+	}
+	return reports
+}
+
+// returns the name of the kind of the resource, e.g. VirtualService rather than *v1.VirtualService
+func kindName(resource resources.Resource) string {
+	kind := resources.Kind(resource)
+	return kind[strings.LastIndex(kind, ".")+1:]
+}
+
+func errorStrings(err error) []string {
+	if err == nil {
+		return nil
+	}
+	var errs []error
+	if merr, ok := err.(*multierror.Error); ok {
+		errs = merr.Errors
+	} else {
+		errs = multierr.Errors(err)
+	}
+	var out []string
+	for _, e := range errs {
+		out = append(out, e.Error())
+	}
+	return out
+}
+
+func appendUnique(to []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range to {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			to = append(to, value)
+		}
+	}
+	return to
+}
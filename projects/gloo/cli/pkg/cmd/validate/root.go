@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingFileErr = eris.New("a file or directory to validate must be provided with -f")
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.VALIDATE_COMMAND.Use,
+		Short: constants.VALIDATE_COMMAND.Short,
+		Long:  constants.VALIDATE_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.File == "" {
+				return MissingFileErr
+			}
+			cfg, err := localconfig.Load(opts.Top.Ctx, opts.Top.File, opts.Metadata.GetNamespace())
+			if err != nil {
+				return err
+			}
+			result, err := localconfig.Translate(opts.Top.Ctx, cfg, opts.Metadata.GetNamespace())
+			if err != nil {
+				return err
+			}
+			return printResult(cmd.OutOrStdout(), cfg, result, opts.Validate.AllowWarnings)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	flagutils.AddValidateFlags(pflags, &opts.Validate)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
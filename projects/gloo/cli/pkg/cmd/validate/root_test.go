@@ -0,0 +1,46 @@
+package validate_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+var _ = Describe("Validate", func() {
+
+	It("succeeds for valid config", func() {
+		out, err := testutils.GlooctlOut("validate -f ../../localconfig/testdata/valid")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Proxy gloo-system.gateway-proxy: Accepted"))
+		Expect(out).To(ContainSubstring("VirtualService default.petstore: Accepted"))
+		Expect(out).To(ContainSubstring("RouteTable default.petstore-routes: Accepted"))
+		Expect(out).To(ContainSubstring("skipping unsupported resource ConfigMap"))
+		Expect(out).To(ContainSubstring("validation succeeded, 0 resource(s) with warnings"))
+	})
+
+	It("fails and prints the errors for invalid config", func() {
+		out, err := testutils.GlooctlOut("validate -f ../../localconfig/testdata/invalid")
+		Expect(err).To(MatchError("validation failed, 4 resource(s) rejected and 0 resource(s) with warnings"))
+		Expect(out).To(ContainSubstring("Proxy gloo-system.gateway-proxy: Rejected"))
+		Expect(out).To(ContainSubstring("VirtualService default.conflicting-domain: Rejected"))
+		Expect(out).To(ContainSubstring("error: VirtualHost Error: DomainsNotUniqueError"))
+		Expect(out).To(ContainSubstring("warning: Route Warning: InvalidDestinationWarning"))
+	})
+
+	It("allows warnings by default", func() {
+		out, err := testutils.GlooctlOut("validate -f ../../localconfig/testdata/warning")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("VirtualService default.missing-upstream: Warning"))
+		Expect(out).To(ContainSubstring("validation succeeded, 2 resource(s) with warnings"))
+	})
+
+	It("fails on warnings when warnings are not allowed", func() {
+		_, err := testutils.GlooctlOut("validate -f ../../localconfig/testdata/warning --allow-warnings=false")
+		Expect(err).To(MatchError("validation failed, 0 resource(s) rejected and 2 resource(s) with warnings"))
+	})
+
+	It("requires a file", func() {
+		err := testutils.Glooctl("validate")
+		Expect(err).To(MatchError("a file or directory to validate must be provided with -f"))
+	})
+})
@@ -89,6 +89,11 @@ var (
 		Short: "Dump YAML representing the current Gloo state (requires Gloo running on Kubernetes)",
 	}
 
+	DEBUG_BUNDLE_COMMAND = cobra.Command{
+		Use:   "bundle",
+		Short: "Write an archive of the Gloo resources, xDS and Envoy config, and metrics for support (requires Gloo running on Kubernetes)",
+	}
+
 	DELETE_COMMAND = cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"d"},
@@ -222,4 +227,36 @@ var (
 		Use:   "istio",
 		Short: "Commands for interacting with Istio in Gloo",
 	}
+
+	RENDER_COMMAND = cobra.Command{
+		Use:   "render",
+		Short: "Render the Envoy config for a proxy from local files (does not require Gloo running on Kubernetes)",
+		Long: "Render the Listeners, RouteConfigurations, Clusters and ClusterLoadAssignments which Gloo would serve to a proxy, " +
+			"from Gateways, VirtualServices, RouteTables, Upstreams, UpstreamGroups, Secrets and Settings read from a file or a " +
+			"directory of yaml files. With --diff-file or --diff-git-ref, prints the difference between the config rendered " +
+			"from those files and the config rendered from -f instead.",
+	}
+
+	VALIDATE_COMMAND = cobra.Command{
+		Use:   "validate",
+		Short: "Validate Gloo resources from local files (does not require Gloo running on Kubernetes)",
+		Long: "Validate Gateways, VirtualServices, RouteTables, Upstreams, UpstreamGroups, Secrets and Settings read from " +
+			"a file or a directory of yaml files. The resources are translated in the same way as Gloo translates them, " +
+			"and the resulting proxy and resource reports are printed. Exits with an error if any resource is rejected.",
+	}
+
+	EXPLAIN_COMMAND = cobra.Command{
+		Use:   "explain",
+		Short: "Explain how the Envoy config for a proxy was produced from Gloo resources",
+	}
+
+	EXPLAIN_ROUTE_COMMAND = cobra.Command{
+		Use:   "route",
+		Short: "Explain which route a proxy selects for a request, and which resources the route came from",
+		Long: "Simulate the routing of a request by the RouteConfigurations served to a proxy, and print the route " +
+			"which matches it, the VirtualService and chain of RouteTables which the route was defined in, and where its " +
+			"options were set. Also prints the later routes which match the request but are shadowed by the matched route, " +
+			"and routes which can never match as an earlier route matches every request they do. By default the config " +
+			"is read from Gloo running on Kubernetes; with -f, it is rendered from a file or a directory of yaml files instead.",
+	}
 )
@@ -0,0 +1,13 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddExplainRouteFlags(set *pflag.FlagSet, explain *options.Explain) {
+	set.StringVar(&explain.Host, "host", "", "host of the request, which selects the virtual host")
+	set.StringVar(&explain.Path, "path", "/", "path of the request, which may include a query string")
+	set.StringVar(&explain.Method, "method", "GET", "method of the request")
+	set.StringArrayVar(&explain.Headers, "header", nil, "header of the request, as name=value. May be repeated")
+}
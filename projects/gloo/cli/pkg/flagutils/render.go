@@ -0,0 +1,11 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddRenderFlags(set *pflag.FlagSet, render *options.Render) {
+	set.StringVar(&render.DiffFile, "diff-file", "", "print the difference between the config rendered from this file or directory and the config rendered from -f")
+	set.StringVar(&render.DiffGitRef, "diff-git-ref", "", "print the difference between the config rendered from -f at this git ref and the config rendered from -f")
+}
@@ -0,0 +1,10 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddValidateFlags(set *pflag.FlagSet, validate *options.Validate) {
+	set.BoolVar(&validate.AllowWarnings, "allow-warnings", true, "do not fail validation if resources only have warnings")
+}
@@ -0,0 +1,284 @@
+package localconfig
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	kubeSecretGVK = corev1.SchemeGroupVersion.WithKind("Secret")
+	kubeListGVK   = schema.GroupVersionKind{Version: "v1", Kind: "List"}
+
+	// file extensions which are read when loading a directory
+	configFileExtensions = []string{".yaml", ".yml", ".json"}
+
+	DuplicateResourceErr = func(kind string, ref *core.ResourceRef, source string) error {
+		return eris.Errorf("%v %v in %v was already defined", kind, ref.Key(), source)
+	}
+	MultipleSettingsErr = func(source string) error {
+		return eris.Errorf("Settings in %v was already defined, only one Settings resource may be provided", source)
+	}
+	ParseErr = func(err error, source string) error {
+		return eris.Wrapf(err, "parsing %v", source)
+	}
+)
+
+// Config is the set of Gloo resources read from local files.
+type Config struct {
+	// the gateway and gloo resources which were read
+	Snapshot *v1snap.ApiSnapshot
+	// the Settings which were read, or the default Settings if none were provided
+	Settings *gloov1.Settings
+	// documents which were read, but did not contain a supported resource
+	Skipped []string
+}
+
+// Load reads all supported resources from the yaml or json file at path, or from all yaml and json files in the
+// directory at path and its subdirectories.
+// Resources without a namespace are placed in the defaultNamespace.
+func Load(ctx context.Context, path, defaultNamespace string) (*Config, error) {
+	files, err := configFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &loader{
+		ctx:              ctx,
+		defaultNamespace: defaultNamespace,
+		config:           &Config{Snapshot: &v1snap.ApiSnapshot{}},
+		seen:             map[string]struct{}{},
+	}
+	for _, file := range files {
+		if err := l.loadFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.config.Settings == nil {
+		l.config.Settings = &gloov1.Settings{
+			Metadata: &core.Metadata{Name: "default", Namespace: defaultNamespace},
+			Gloo:     &gloov1.GlooOptions{},
+		}
+	}
+	return l.config, nil
+}
+
+func configFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		for _, ext := range configFileExtensions {
+			if strings.EqualFold(filepath.Ext(file), ext) {
+				files = append(files, file)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+type loader struct {
+	ctx              context.Context
+	defaultNamespace string
+	config           *Config
+	// kind and key of every resource read so far, used to reject duplicates
+	seen map[string]struct{}
+}
+
+func (l *loader) loadFile(file string) error {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(contents)))
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		source := fmt.Sprintf("%v (document %d)", file, i+1)
+		if err != nil {
+			return ParseErr(err, source)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		jsonBytes, err := yaml.ToJSON(doc)
+		if err != nil {
+			return ParseErr(err, source)
+		}
+		if err := l.loadDocument(jsonBytes, source); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *loader) loadDocument(jsonBytes []byte, source string) error {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(jsonBytes, &typeMeta); err != nil {
+		return ParseErr(err, source)
+	}
+	if typeMeta.Kind == "" {
+		// comments or other empty documents
+		return nil
+	}
+	gvk := typeMeta.GroupVersionKind()
+
+	if gvk == kubeListGVK {
+		var list unstructured.UnstructuredList
+		if err := list.UnmarshalJSON(jsonBytes); err != nil {
+			return ParseErr(err, source)
+		}
+		for _, item := range list.Items {
+			itemJson, err := item.MarshalJSON()
+			if err != nil {
+				return ParseErr(err, source)
+			}
+			if err := l.loadDocument(itemJson, source); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	resource, err := l.unmarshalResource(gvk, jsonBytes)
+	if err != nil {
+		return ParseErr(err, source)
+	}
+	if resource == nil {
+		l.config.Skipped = append(l.config.Skipped, gvk.Kind+" in "+source)
+		return nil
+	}
+	if resource.GetMetadata().GetNamespace() == "" {
+		resources.UpdateMetadata(resource, func(meta *core.Metadata) {
+			meta.Namespace = l.defaultNamespace
+		})
+	}
+	return l.addResource(resource, source)
+}
+
+// returns nil if the document is not a supported resource
+func (l *loader) unmarshalResource(gvk schema.GroupVersionKind, jsonBytes []byte) (resources.Resource, error) {
+	var resource resources.Resource
+	switch gvk {
+	case gwv1.GatewayGVK:
+		resource = &gwv1.Gateway{}
+	case gwv1.MatchableHttpGatewayGVK:
+		resource = &gwv1.MatchableHttpGateway{}
+	case gwv1.VirtualServiceGVK:
+		resource = &gwv1.VirtualService{}
+	case gwv1.RouteTableGVK:
+		resource = &gwv1.RouteTable{}
+	case gwv1.VirtualHostOptionGVK:
+		resource = &gwv1.VirtualHostOption{}
+	case gwv1.RouteOptionGVK:
+		resource = &gwv1.RouteOption{}
+	case gloov1.UpstreamGVK:
+		resource = &gloov1.Upstream{}
+	case gloov1.UpstreamGroupGVK:
+		resource = &gloov1.UpstreamGroup{}
+	case gloov1.SettingsGVK:
+		resource = &gloov1.Settings{}
+	case kubeSecretGVK:
+		return l.secretFromKubeSecret(jsonBytes)
+	default:
+		return nil, nil
+	}
+	if err := skprotoutils.UnmarshalResource(jsonBytes, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// converts a kubernetes secret in the same way gloo does when reading secrets from kubernetes
+func (l *loader) secretFromKubeSecret(jsonBytes []byte) (resources.Resource, error) {
+	var kubeSecret corev1.Secret
+	if err := json.Unmarshal(jsonBytes, &kubeSecret); err != nil {
+		return nil, err
+	}
+	secret, err := kubeconverters.KubeSecretToGlooSecret(l.ctx, &kubeSecret)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (l *loader) addResource(resource resources.Resource, source string) error {
+	kind := resources.Kind(resource)
+	ref := resource.GetMetadata().Ref()
+	key := kind + " " + ref.Key()
+	if _, ok := l.seen[key]; ok {
+		return DuplicateResourceErr(kind, ref, source)
+	}
+	l.seen[key] = struct{}{}
+
+	snap := l.config.Snapshot
+	switch typed := resource.(type) {
+	case *gwv1.Gateway:
+		snap.Gateways = append(snap.Gateways, typed)
+	case *gwv1.MatchableHttpGateway:
+		snap.HttpGateways = append(snap.HttpGateways, typed)
+	case *gwv1.VirtualService:
+		snap.VirtualServices = append(snap.VirtualServices, typed)
+	case *gwv1.RouteTable:
+		snap.RouteTables = append(snap.RouteTables, typed)
+	case *gwv1.VirtualHostOption:
+		snap.VirtualHostOptions = append(snap.VirtualHostOptions, typed)
+	case *gwv1.RouteOption:
+		snap.RouteOptions = append(snap.RouteOptions, typed)
+	case *gloov1.Upstream:
+		snap.Upstreams = append(snap.Upstreams, typed)
+	case *gloov1.UpstreamGroup:
+		snap.UpstreamGroups = append(snap.UpstreamGroups, typed)
+	case *gloov1.Secret:
+		snap.Secrets = append(snap.Secrets, typed)
+	case *gloov1.Settings:
+		if l.config.Settings != nil {
+			return MultipleSettingsErr(source)
+		}
+		l.config.Settings = typed
+	}
+	return nil
+}
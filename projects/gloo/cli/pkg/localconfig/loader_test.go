@@ -0,0 +1,167 @@
+package localconfig_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Load", func() {
+
+	var (
+		ctx    context.Context
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "localconfig")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(tmpDir, name)
+		Expect(os.WriteFile(path, []byte(contents), 0644)).NotTo(HaveOccurred())
+		return path
+	}
+
+	It("loads all supported resources from a directory", func() {
+		cfg, err := localconfig.Load(ctx, "testdata/valid", "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		snap := cfg.Snapshot
+		Expect(snap.Gateways).To(HaveLen(1))
+		Expect(snap.Gateways[0].GetMetadata().Ref()).To(Equal(&core.ResourceRef{Name: "gateway-proxy", Namespace: "gloo-system"}))
+		Expect(snap.VirtualServices).To(HaveLen(1))
+		Expect(snap.VirtualServices[0].GetMetadata().GetNamespace()).To(Equal("default"))
+		Expect(snap.RouteTables).To(HaveLen(1))
+		Expect(snap.Upstreams).To(HaveLen(1))
+		Expect(snap.Upstreams[0].GetStatic().GetHosts()).To(HaveLen(1))
+
+		Expect(cfg.Settings.GetMetadata().GetName()).To(Equal("default"))
+		Expect(cfg.Settings.GetGloo().GetInvalidConfigPolicy().GetReplaceInvalidRoutes()).To(BeFalse())
+
+		Expect(cfg.Skipped).To(ConsistOf(ContainSubstring("ConfigMap in testdata/valid/petstore.yaml")))
+	})
+
+	It("loads resources from kubernetes lists", func() {
+		cfg, err := localconfig.Load(ctx, "testdata/invalid/virtualservices.yaml", "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Snapshot.VirtualServices).To(HaveLen(2))
+		Expect(cfg.Skipped).To(BeEmpty())
+	})
+
+	It("uses default settings when none are provided", func() {
+		cfg, err := localconfig.Load(ctx, "testdata/invalid", "my-ns")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Settings.GetMetadata().Ref()).To(Equal(&core.ResourceRef{Name: "default", Namespace: "my-ns"}))
+	})
+
+	It("converts kubernetes secrets into gloo secrets", func() {
+		path := writeFile("secret.yaml", `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: aws-creds
+type: Opaque
+stringData:
+  aws_access_key_id: some-id
+  aws_secret_access_key: some-key
+`)
+		cfg, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Snapshot.Secrets).To(HaveLen(1))
+		secret := cfg.Snapshot.Secrets[0]
+		Expect(secret.GetMetadata().Ref()).To(Equal(&core.ResourceRef{Name: "aws-creds", Namespace: "gloo-system"}))
+		Expect(secret.GetAws().GetAccessKey()).To(Equal("some-id"))
+		Expect(secret.GetAws().GetSecretKey()).To(Equal("some-key"))
+	})
+
+	It("skips secrets which are not gloo secrets", func() {
+		path := writeFile("secret.yaml", `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: unrelated
+type: kubernetes.io/service-account-token
+data:
+  token: YmFy
+`)
+		cfg, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Snapshot.Secrets).To(BeEmpty())
+		Expect(cfg.Skipped).To(ConsistOf(ContainSubstring("Secret in")))
+	})
+
+	It("errors on duplicate resources", func() {
+		path := writeFile("upstreams.yaml", `
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: us
+spec:
+  static:
+    hosts:
+    - addr: example.com
+      port: 80
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: us
+  namespace: gloo-system
+spec:
+  static:
+    hosts:
+    - addr: example.com
+      port: 80
+`)
+		_, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).To(MatchError(ContainSubstring("*v1.Upstream gloo-system.us in " + path + " (document 2) was already defined")))
+	})
+
+	It("errors on multiple settings", func() {
+		path := writeFile("settings.yaml", `
+apiVersion: gloo.solo.io/v1
+kind: Settings
+metadata:
+  name: default
+---
+apiVersion: gloo.solo.io/v1
+kind: Settings
+metadata:
+  name: other
+`)
+		_, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).To(MatchError(ContainSubstring("only one Settings resource may be provided")))
+	})
+
+	It("errors on invalid resources", func() {
+		path := writeFile("vs.yaml", `
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: vs
+spec:
+  virtualHost:
+    notAField: true
+`)
+		_, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).To(MatchError(ContainSubstring("parsing " + path + " (document 1)")))
+	})
+
+	It("errors when the path does not exist", func() {
+		_, err := localconfig.Load(ctx, filepath.Join(tmpDir, "missing"), "gloo-system")
+		Expect(err).To(HaveOccurred())
+	})
+})
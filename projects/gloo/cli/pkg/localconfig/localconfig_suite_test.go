@@ -0,0 +1,30 @@
+package localconfig_test
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/reporters"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/utils/statusutils"
+)
+
+var (
+	_ = BeforeSuite(func() {
+		// resources read from files are unmarshalled with statuses for the gloo namespace, as glooctl does in its prerun
+		err := os.Setenv(statusutils.PodNamespaceEnvName, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	_ = AfterSuite(func() {
+		err := os.Unsetenv(statusutils.PodNamespaceEnvName)
+		Expect(err).NotTo(HaveOccurred())
+	})
+)
+
+func TestLocalConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	junitReporter := reporters.NewJUnitReporter("junit.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "Local Config Suite", []Reporter{junitReporter})
+}
@@ -0,0 +1,76 @@
+package localconfig
+
+import (
+	"context"
+	"sort"
+
+	"github.com/rotisserie/eris"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var (
+	ProxyNotFoundErr = func(proxyName string, proxyNames []string) error {
+		return eris.Errorf("no gateways are configured for proxy %v, gateways are configured for proxies %v", proxyName, proxyNames)
+	}
+	ProxyNotGeneratedErr = func(proxyName string, err error) error {
+		return eris.Wrapf(err, "the gateways for proxy %v could not be translated", proxyName)
+	}
+)
+
+// Render translates the gateways for the named proxy into the xDS resources which Gloo would serve to it.
+// The proxy is translated by the gateway translator, the gloo translator and the xds sanitizers, in the same way
+// as Gloo does, so the resources are those served even if the reports contain errors.
+// Proxies are written to the writeNamespace.
+func Render(ctx context.Context, cfg *Config, writeNamespace, proxyName string) (*xdsinspection.XdsDump, reporter.ResourceReports, error) {
+	_, xdsDump, reports, err := RenderProxy(ctx, cfg, writeNamespace, proxyName)
+	return xdsDump, reports, err
+}
+
+// RenderProxy is Render, but also returns the proxy which the xDS resources were translated from.
+func RenderProxy(ctx context.Context, cfg *Config, writeNamespace, proxyName string) (*gloov1.Proxy, *xdsinspection.XdsDump, reporter.ResourceReports, error) {
+	ctx = translationContext(ctx, cfg.Settings)
+
+	gatewaysByProxy := gwutils.GatewaysByProxyName(cfg.Snapshot.Gateways)
+	gateways, ok := gatewaysByProxy[proxyName]
+	if !ok {
+		var proxyNames []string
+		for name := range gatewaysByProxy {
+			proxyNames = append(proxyNames, name)
+		}
+		sort.Strings(proxyNames)
+		return nil, nil, nil, ProxyNotFoundErr(proxyName, proxyNames)
+	}
+
+	gwTranslator := newGatewayTranslator(cfg.Settings, writeNamespace)
+	proxy, reports := gwTranslator.Translate(ctx, proxyName, writeNamespace, gatewaySnapshot(cfg.Snapshot), gateways)
+	if proxy == nil {
+		return nil, nil, nil, ProxyNotGeneratedErr(proxyName, reports.ValidateStrict())
+	}
+
+	glooTranslator, xdsSanitizer, err := newGlooTranslator(ctx, cfg.Settings)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	params := plugins.Params{
+		Ctx:      ctx,
+		Snapshot: cfg.Snapshot,
+	}
+	xdsSnapshot, glooReports, _, err := glooTranslator.Translate(params, proxy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	xdsSnapshot = xdsSanitizer.SanitizeSnapshot(ctx, cfg.Snapshot, xdsSnapshot, glooReports)
+	xdsSnapshot.MakeConsistent()
+	reports.Merge(glooReports)
+
+	xdsDump, err := xdsinspection.XdsDumpFromSnapshot(xds.SnapshotCacheKey(proxy), xdsSnapshot)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return proxy, xdsDump, reports, nil
+}
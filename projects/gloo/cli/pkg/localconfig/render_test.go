@@ -0,0 +1,64 @@
+package localconfig_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+)
+
+var _ = Describe("Render", func() {
+
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	load := func(path string) *localconfig.Config {
+		cfg, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		return cfg
+	}
+
+	It("renders the xds resources for a proxy", func() {
+		xdsDump, reports, err := localconfig.Render(ctx, load("testdata/valid"), "gloo-system", "gateway-proxy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+		Expect(xdsDump.Role).To(Equal("gloo-system~gateway-proxy"))
+		Expect(xdsDump.Listeners).To(HaveLen(1))
+		Expect(xdsDump.Listeners[0].GetName()).To(Equal("listener-::-8080"))
+		Expect(xdsDump.Clusters).To(HaveLen(1))
+		Expect(xdsDump.Clusters[0].GetName()).To(Equal("petstore_gloo-system"))
+		Expect(xdsDump.Routes).To(HaveLen(1))
+		routes := xdsDump.Routes[0].GetVirtualHosts()[0].GetRoutes()
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].GetMatch().GetPrefix()).To(Equal("/api/pets"))
+	})
+
+	It("renders the sanitized resources for a proxy with errors", func() {
+		xdsDump, reports, err := localconfig.Render(ctx, load("testdata/warning"), "gloo-system", "gateway-proxy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports.Validate()).NotTo(HaveOccurred())
+		Expect(reports.ValidateStrict()).To(MatchError(ContainSubstring("InvalidDestinationWarning")))
+		Expect(xdsDump.Listeners).To(HaveLen(1))
+		Expect(xdsDump.Clusters).To(BeEmpty())
+	})
+
+	It("renders identical dumps for the same config", func() {
+		first, _, err := localconfig.Render(ctx, load("testdata/valid"), "gloo-system", "gateway-proxy")
+		Expect(err).NotTo(HaveOccurred())
+		second, _, err := localconfig.Render(ctx, load("testdata/valid"), "gloo-system", "gateway-proxy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first.String()).To(Equal(second.String()))
+	})
+
+	It("errors when there are no gateways for the proxy", func() {
+		_, _, err := localconfig.Render(ctx, load("testdata/valid"), "gloo-system", "other-proxy")
+		Expect(err).To(MatchError("no gateways are configured for proxy other-proxy, gateways are configured for proxies [gateway-proxy]"))
+	})
+})
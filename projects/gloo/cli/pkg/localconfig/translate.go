@@ -0,0 +1,157 @@
+package localconfig
+
+import (
+	"context"
+	"sort"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/reporting"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	gloovalidation "github.com/solo-io/gloo/projects/gloo/pkg/validation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/zap"
+)
+
+// Result is the outcome of translating a Config in the same way as Gloo does.
+type Result struct {
+	// one result for each proxy which the gateways would produce, ordered by proxy name
+	Proxies []*ProxyResult
+	// the reports for the gateway resources
+	ResourceReports reporter.ResourceReports
+}
+
+// ProxyResult is the outcome of translating a single proxy.
+type ProxyResult struct {
+	// the ref of the proxy
+	ProxyRef *core.ResourceRef
+	// the proxy generated from the gateways, nil if the gateways could not be translated into a proxy
+	Proxy *gloov1.Proxy
+	// the report produced by the gloo translator and sanitizers, nil if Proxy is nil
+	ValidationReport *validation.ValidationReport
+}
+
+// Translate runs the gateway translator over the gateway resources in the Config, and validates the resulting
+// proxies with the gloo translator and xds sanitizers, without requiring a running Gloo.
+// Proxies are written to the writeNamespace.
+func Translate(ctx context.Context, cfg *Config, writeNamespace string) (*Result, error) {
+	ctx = translationContext(ctx, cfg.Settings)
+	gwTranslator := newGatewayTranslator(cfg.Settings, writeNamespace)
+	gwSnap := gatewaySnapshot(cfg.Snapshot)
+
+	glooTranslator, xdsSanitizer, err := newGlooTranslator(ctx, cfg.Settings)
+	if err != nil {
+		return nil, err
+	}
+	glooValidator := gloovalidation.NewValidator(ctx, glooTranslator, xdsSanitizer)
+	if err := glooValidator.Sync(ctx, cfg.Snapshot); err != nil {
+		return nil, err
+	}
+
+	gatewaysByProxy := gwutils.GatewaysByProxyName(cfg.Snapshot.Gateways)
+	proxyNames := make([]string, 0, len(gatewaysByProxy))
+	for proxyName := range gatewaysByProxy {
+		proxyNames = append(proxyNames, proxyName)
+	}
+	sort.Strings(proxyNames)
+
+	result := &Result{ResourceReports: reporter.ResourceReports{}}
+	for _, proxyName := range proxyNames {
+		proxy, reports := gwTranslator.Translate(ctx, proxyName, writeNamespace, gwSnap, gatewaysByProxy[proxyName])
+
+		proxyResult := &ProxyResult{
+			ProxyRef: &core.ResourceRef{Name: proxyName, Namespace: writeNamespace},
+			Proxy:    proxy,
+		}
+		result.Proxies = append(result.Proxies, proxyResult)
+		if proxy == nil {
+			result.ResourceReports.Merge(reports)
+			continue
+		}
+
+		resp, err := glooValidator.Validate(ctx, &validation.GlooValidationServiceRequest{Proxy: proxy})
+		if err != nil {
+			return nil, eris.Wrapf(err, "translating proxy %v", proxyResult.ProxyRef.Key())
+		}
+		if len(resp.GetValidationReports()) != 1 {
+			return nil, eris.Errorf("expected one validation report for proxy %v, got %v", proxyResult.ProxyRef.Key(), len(resp.GetValidationReports()))
+		}
+		proxyResult.ValidationReport = resp.GetValidationReports()[0]
+
+		// attribute the errors and warnings in the proxy report to the gateway resources they came from,
+		// in the same way as the proxy reconciler
+		if err := reporting.AddProxyValidationResult(reports, proxy, proxyResult.ValidationReport.GetProxyReport()); err != nil {
+			return nil, eris.Wrapf(err, "reporting on proxy %v", proxyResult.ProxyRef.Key())
+		}
+		result.ResourceReports.Merge(reports)
+	}
+	return result, nil
+}
+
+func translationContext(ctx context.Context, settings *gloov1.Settings) context.Context {
+	ctx = settingsutil.WithSettings(ctx, settings)
+	// the translators log as they would in a running Gloo, which is only noise for a cli
+	return contextutils.WithExistingLogger(ctx, zap.NewNop().Sugar())
+}
+
+func newGatewayTranslator(settings *gloov1.Settings, writeNamespace string) *gwtranslator.GwTranslator {
+	return gwtranslator.NewDefaultTranslator(gwtranslator.Opts{
+		GlooNamespace:  writeNamespace,
+		WriteNamespace: writeNamespace,
+		Validation: &gwtranslator.ValidationOpts{
+			WarnOnRouteShortCircuiting: settings.GetGateway().GetValidation().GetWarnRouteShortCircuiting().GetValue(),
+		},
+	})
+}
+
+func gatewaySnapshot(snap *v1snap.ApiSnapshot) *gatewayv1.ApiSnapshot {
+	return &gatewayv1.ApiSnapshot{
+		VirtualServices:    snap.VirtualServices,
+		RouteTables:        snap.RouteTables,
+		Gateways:           snap.Gateways,
+		VirtualHostOptions: snap.VirtualHostOptions,
+		RouteOptions:       snap.RouteOptions,
+		HttpGateways:       snap.HttpGateways,
+	}
+}
+
+// builds the gloo translator and xds sanitizers in the same way as the gloo syncer setup,
+// without any plugins which require a cluster
+func newGlooTranslator(ctx context.Context, settings *gloov1.Settings) (translator.Translator, sanitizer.XdsSanitizers, error) {
+	// plugins which watch resources themselves are given in-memory clients, as there is no cluster to read from
+	memoryClientFactory := &factory.MemoryResourceClientFactory{
+		Cache: memory.NewInMemoryResourceCache(),
+	}
+	opts := bootstrap.Opts{
+		Settings:  settings,
+		Secrets:   memoryClientFactory,
+		Upstreams: memoryClientFactory,
+		WatchOpts: clients.WatchOpts{Ctx: ctx},
+	}
+	t := translator.NewTranslator(utils.NewSslConfigTranslator(), settings, registry.GetPluginRegistryFactory(opts))
+
+	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(settings.GetGloo().GetInvalidConfigPolicy())
+	if err != nil {
+		return nil, nil, err
+	}
+	xdsSanitizer := sanitizer.XdsSanitizers{
+		sanitizer.NewUpstreamRemovingSanitizer(),
+		routeReplacingSanitizer,
+	}
+	return t, xdsSanitizer, nil
+}
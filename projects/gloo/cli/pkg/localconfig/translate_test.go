@@ -0,0 +1,66 @@
+package localconfig_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/localconfig"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Translate", func() {
+
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	translate := func(path string) *localconfig.Result {
+		cfg, err := localconfig.Load(ctx, path, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		result, err := localconfig.Translate(ctx, cfg, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("translates valid config into accepted proxies", func() {
+		result := translate("testdata/valid")
+
+		Expect(result.Proxies).To(HaveLen(1))
+		proxyResult := result.Proxies[0]
+		Expect(proxyResult.ProxyRef).To(Equal(&core.ResourceRef{Name: "gateway-proxy", Namespace: "gloo-system"}))
+		Expect(proxyResult.Proxy.GetListeners()).To(HaveLen(1))
+		proxyReport := proxyResult.ValidationReport.GetProxyReport()
+		Expect(validationutils.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+		Expect(validationutils.GetProxyWarning(proxyReport)).To(BeEmpty())
+
+		Expect(result.ResourceReports).To(HaveLen(3))
+		Expect(result.ResourceReports.ValidateStrict()).NotTo(HaveOccurred())
+	})
+
+	It("reports errors and warnings on the proxy and the resources which caused them", func() {
+		result := translate("testdata/invalid")
+
+		Expect(result.Proxies).To(HaveLen(1))
+		proxyReport := result.Proxies[0].ValidationReport.GetProxyReport()
+		Expect(validationutils.GetProxyError(proxyReport)).To(MatchError(ContainSubstring("DomainsNotUniqueError")))
+		Expect(validationutils.GetProxyWarning(proxyReport)).To(ConsistOf(ContainSubstring("gloo-system.does-not-exist } not found")))
+
+		_, report := result.ResourceReports.Find("*v1.VirtualService", &core.ResourceRef{Name: "missing-upstream", Namespace: "default"})
+		Expect(report.Errors).To(MatchError(ContainSubstring("domain conflict")))
+		Expect(report.Warnings).To(ConsistOf(ContainSubstring("gloo-system.does-not-exist } not found")))
+	})
+
+	It("does not produce proxies without gateways", func() {
+		cfg, err := localconfig.Load(ctx, "testdata/invalid/virtualservices.yaml", "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		result, err := localconfig.Translate(ctx, cfg, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Proxies).To(BeEmpty())
+	})
+})
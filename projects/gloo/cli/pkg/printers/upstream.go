@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/file_discovery"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
@@ -77,6 +78,10 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_DnsSrv:
+		return "DNS SRV"
+	case *v1.Upstream_FileDiscovery:
+		return "File"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -150,6 +155,18 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Consul.GetServiceSpec() != nil {
 			add(linesForServiceSpec(usType.Consul.GetServiceSpec())...)
 		}
+	case *v1.Upstream_DnsSrv:
+		add(
+			fmt.Sprintf("record:   %v", usType.DnsSrv.GetRecordName()),
+			fmt.Sprintf("resolver: %v", usType.DnsSrv.GetResolverAddress()),
+		)
+	case *v1.Upstream_FileDiscovery:
+		add(
+			fmt.Sprintf("service: %v", usType.FileDiscovery.GetServiceName()),
+		)
+		if err := up.GetMetadata().GetAnnotations()[file_discovery.ValidationErrorAnnotation]; err != "" {
+			add(fmt.Sprintf("error:   %v", err))
+		}
 	case *v1.Upstream_Kube:
 		add(
 			fmt.Sprintf("svc name:      %v", usType.Kube.GetServiceName()),
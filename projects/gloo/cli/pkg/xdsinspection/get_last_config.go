@@ -27,6 +27,7 @@ import (
 	_ "github.com/solo-io/gloo/projects/envoyinit/hack/filter_types"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"sigs.k8s.io/yaml"
@@ -99,7 +100,10 @@ func GetGlooXdsDump(ctx context.Context, proxyName, namespace string, verboseErr
 }
 
 type XdsDump struct {
-	Role      string
+	Role string
+	// Versions maps the type url of each type of resource to its version, which Gloo computes as a hash of the
+	// resources of that type when it translates the proxy
+	Versions  map[string]string
 	Endpoints []envoyendpoint.ClusterLoadAssignment
 	Clusters  []envoycluster.Cluster
 	Listeners []envoylistener.Listener
@@ -108,7 +112,8 @@ type XdsDump struct {
 
 func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string) (*XdsDump, error) {
 	xdsDump := &XdsDump{
-		Role: fmt.Sprintf("%v~%v", proxyNamespace, proxyName),
+		Role:     fmt.Sprintf("%v~%v", proxyNamespace, proxyName),
+		Versions: map[string]string{},
 	}
 	dr := &discovery_v3.DiscoveryRequest{Node: &envoycore.Node{
 		Metadata: &structpb.Struct{
@@ -120,17 +125,17 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 		return nil, err
 	}
 
-	xdsDump.Endpoints, err = listEndpoints(ctx, dr, conn)
+	xdsDump.Endpoints, xdsDump.Versions[resource.EndpointTypeV3], err = listEndpoints(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
 
-	xdsDump.Clusters, err = listClusters(ctx, dr, conn)
+	xdsDump.Clusters, xdsDump.Versions[resource.ClusterTypeV3], err = listClusters(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
 
-	xdsDump.Listeners, err = listListeners(ctx, dr, conn)
+	xdsDump.Listeners, xdsDump.Versions[resource.ListenerTypeV3], err = listListeners(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +163,7 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 		routes = append(routes, hcm.GetRouteSpecifier().(*envoyhttp.HttpConnectionManager_Rds).Rds.GetRouteConfigName())
 	}
 
-	xdsDump.Routes, err = listRoutes(ctx, conn, dr, routes)
+	xdsDump.Routes, xdsDump.Versions[resource.RouteTypeV3], err = listRoutes(ctx, conn, dr, routes)
 	if err != nil {
 		return nil, err
 	}
@@ -166,31 +171,31 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 	return xdsDump, nil
 }
 
-func listClusters(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoycluster.Cluster, error) {
+func listClusters(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoycluster.Cluster, string, error) {
 
 	// clusters
 	cdsc := envoy_service_cluster_v3.NewClusterDiscoveryServiceClient(conn)
 	dresp, err := cdsc.FetchClusters(ctx, dr)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	var clusters []envoycluster.Cluster
 	for _, anyCluster := range dresp.GetResources() {
 
 		var cluster envoycluster.Cluster
 		if err := ptypes.UnmarshalAny(anyCluster, &cluster); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		clusters = append(clusters, cluster)
 	}
-	return clusters, nil
+	return clusters, dresp.GetVersionInfo(), nil
 }
 
-func listEndpoints(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoyendpoint.ClusterLoadAssignment, error) {
+func listEndpoints(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoyendpoint.ClusterLoadAssignment, string, error) {
 	eds := envoy_service_endpoint_v3.NewEndpointDiscoveryServiceClient(conn)
 	dresp, err := eds.FetchEndpoints(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("endpoints err: %v", err)
+		return nil, "", eris.Errorf("endpoints err: %v", err)
 	}
 	var class []envoyendpoint.ClusterLoadAssignment
 
@@ -198,34 +203,34 @@ func listEndpoints(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn
 
 		var cla envoyendpoint.ClusterLoadAssignment
 		if err := ptypes.UnmarshalAny(anyCla, &cla); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		class = append(class, cla)
 	}
-	return class, nil
+	return class, dresp.GetVersionInfo(), nil
 }
 
-func listListeners(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoylistener.Listener, error) {
+func listListeners(ctx context.Context, dr *discovery_v3.DiscoveryRequest, conn *grpc.ClientConn) ([]envoylistener.Listener, string, error) {
 
 	// listeners
 	ldsc := envoy_service_listener_v3.NewListenerDiscoveryServiceClient(conn)
 	dresp, err := ldsc.FetchListeners(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("listeners err: %v", err)
+		return nil, "", eris.Errorf("listeners err: %v", err)
 	}
 	var listeners []envoylistener.Listener
 
 	for _, anylistener := range dresp.GetResources() {
 		var listener envoylistener.Listener
 		if err := ptypes.UnmarshalAny(anylistener, &listener); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		listeners = append(listeners, listener)
 	}
-	return listeners, nil
+	return listeners, dresp.GetVersionInfo(), nil
 }
 
-func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *discovery_v3.DiscoveryRequest, routenames []string) ([]envoy_config_route_v3.RouteConfiguration, error) {
+func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *discovery_v3.DiscoveryRequest, routenames []string) ([]envoy_config_route_v3.RouteConfiguration, string, error) {
 
 	// routes
 	ldsc := envoy_service_route_v3.NewRouteDiscoveryServiceClient(conn)
@@ -234,18 +239,18 @@ func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *discovery_v3.Dis
 
 	dresp, err := ldsc.FetchRoutes(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("routes err: %v", err)
+		return nil, "", eris.Errorf("routes err: %v", err)
 	}
 	var routes []envoy_config_route_v3.RouteConfiguration
 
 	for _, anyRoute := range dresp.GetResources() {
 		var route envoy_config_route_v3.RouteConfiguration
 		if err := ptypes.UnmarshalAny(anyRoute, &route); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		routes = append(routes, route)
 	}
-	return routes, nil
+	return routes, dresp.GetVersionInfo(), nil
 }
 
 func (xd *XdsDump) String() string {
@@ -0,0 +1,85 @@
+package xdsinspection
+
+import (
+	"sort"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+var (
+	UnexpectedResourceTypeErr = func(name string, expected, actual interface{}) error {
+		return eris.Errorf("expected xds resource %v to be a %T, got %T", name, expected, actual)
+	}
+)
+
+// XdsDumpFromSnapshot builds an XdsDump from an xDS snapshot, rather than by fetching the config served by Gloo.
+// Resources are sorted by name, so that dumps of the same snapshot are identical.
+func XdsDumpFromSnapshot(role string, snap envoycache.Snapshot) (*XdsDump, error) {
+	xdsDump := &XdsDump{
+		Role:     role,
+		Versions: map[string]string{},
+	}
+	for _, typeURL := range []string{resource.EndpointTypeV3, resource.ClusterTypeV3, resource.ListenerTypeV3, resource.RouteTypeV3} {
+		xdsDump.Versions[typeURL] = snap.GetResources(typeURL).Version
+	}
+
+	for _, name := range sortedResourceNames(snap, resource.EndpointTypeV3) {
+		var cla envoyendpoint.ClusterLoadAssignment
+		if err := mergeResource(snap, resource.EndpointTypeV3, name, &cla); err != nil {
+			return nil, err
+		}
+		xdsDump.Endpoints = append(xdsDump.Endpoints, cla)
+	}
+
+	for _, name := range sortedResourceNames(snap, resource.ClusterTypeV3) {
+		var cluster envoycluster.Cluster
+		if err := mergeResource(snap, resource.ClusterTypeV3, name, &cluster); err != nil {
+			return nil, err
+		}
+		xdsDump.Clusters = append(xdsDump.Clusters, cluster)
+	}
+
+	for _, name := range sortedResourceNames(snap, resource.ListenerTypeV3) {
+		var listener envoylistener.Listener
+		if err := mergeResource(snap, resource.ListenerTypeV3, name, &listener); err != nil {
+			return nil, err
+		}
+		xdsDump.Listeners = append(xdsDump.Listeners, listener)
+	}
+
+	for _, name := range sortedResourceNames(snap, resource.RouteTypeV3) {
+		var route envoy_config_route_v3.RouteConfiguration
+		if err := mergeResource(snap, resource.RouteTypeV3, name, &route); err != nil {
+			return nil, err
+		}
+		xdsDump.Routes = append(xdsDump.Routes, route)
+	}
+
+	return xdsDump, nil
+}
+
+func sortedResourceNames(snap envoycache.Snapshot, typeURL string) []string {
+	var names []string
+	for name := range snap.GetResources(typeURL).Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// copies the named resource from the snapshot into out, which must be of the same type
+func mergeResource(snap envoycache.Snapshot, typeURL, name string, out proto.Message) error {
+	in := snap.GetResources(typeURL).Items[name].ResourceProto()
+	if proto.MessageName(in) != proto.MessageName(out) {
+		return UnexpectedResourceTypeErr(name, out, in)
+	}
+	proto.Merge(out, in)
+	return nil
+}
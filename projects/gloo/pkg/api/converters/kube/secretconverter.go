@@ -3,6 +3,7 @@ package kubeconverters
 import (
 	"context"
 
+	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/protoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
@@ -62,6 +63,45 @@ func (t *SecretConverterChain) ToKubeSecret(ctx context.Context, rc *kubesecret.
 	return nil, nil
 }
 
+// KubeSecretToGlooSecret converts a kubernetes secret in the same way gloo does when reading secrets from kubernetes.
+// Returns nil if gloo would not read the secret, e.g. because of its type.
+func KubeSecretToGlooSecret(ctx context.Context, secret *kubev1.Secret) (*v1.Secret, error) {
+	// stringData is merged into data by the kubernetes api server
+	if len(secret.StringData) > 0 {
+		secret = secret.DeepCopy()
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		for key, value := range secret.StringData {
+			secret.Data[key] = []byte(value)
+		}
+	}
+
+	rc, err := kubesecret.NewResourceClientWithSecretConverter(nil, &v1.Secret{}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := GlooSecretConverterChain.FromKubeSecret(ctx, rc, secret)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		resource, err = rc.FromKubeSecret(secret)
+		if err == kubesecret.NotOurResource {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	glooSecret, ok := resource.(*v1.Secret)
+	if !ok {
+		// should never happen
+		return nil, errors.Errorf("expected secret to convert to %T, got %T", &v1.Secret{}, resource)
+	}
+	return glooSecret, nil
+}
+
 type TLSSecretConverter struct{}
 
 var _ kubesecret.SecretConverter = &TLSSecretConverter{}
@@ -15,6 +15,12 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extauth_v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_graphql_v1beta1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
+
+	github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -119,6 +125,107 @@ func (m *ModifiedResources) Clone() proto.Message {
 		}
 	}
 
+	if m.GetUpstreamGroups() != nil {
+		target.UpstreamGroups = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup, len(m.GetUpstreamGroups()))
+		for idx, v := range m.GetUpstreamGroups() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroups[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup)
+			} else {
+				target.UpstreamGroups[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup)
+			}
+
+		}
+	}
+
+	if m.GetSecrets() != nil {
+		target.Secrets = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Secret, len(m.GetSecrets()))
+		for idx, v := range m.GetSecrets() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Secrets[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Secret)
+			} else {
+				target.Secrets[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Secret)
+			}
+
+		}
+	}
+
+	if m.GetArtifacts() != nil {
+		target.Artifacts = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Artifact, len(m.GetArtifacts()))
+		for idx, v := range m.GetArtifacts() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Artifacts[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Artifact)
+			} else {
+				target.Artifacts[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Artifact)
+			}
+
+		}
+	}
+
+	if m.GetAuthConfigs() != nil {
+		target.AuthConfigs = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extauth_v1.AuthConfig, len(m.GetAuthConfigs()))
+		for idx, v := range m.GetAuthConfigs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.AuthConfigs[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extauth_v1.AuthConfig)
+			} else {
+				target.AuthConfigs[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extauth_v1.AuthConfig)
+			}
+
+		}
+	}
+
+	if m.GetRatelimitConfigs() != nil {
+		target.RatelimitConfigs = make([]*RateLimitConfigResource, len(m.GetRatelimitConfigs()))
+		for idx, v := range m.GetRatelimitConfigs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RatelimitConfigs[idx] = h.Clone().(*RateLimitConfigResource)
+			} else {
+				target.RatelimitConfigs[idx] = proto.Clone(v).(*RateLimitConfigResource)
+			}
+
+		}
+	}
+
+	if m.GetGraphqlApis() != nil {
+		target.GraphqlApis = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_graphql_v1beta1.GraphQLApi, len(m.GetGraphqlApis()))
+		for idx, v := range m.GetGraphqlApis() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.GraphqlApis[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_graphql_v1beta1.GraphQLApi)
+			} else {
+				target.GraphqlApis[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_graphql_v1beta1.GraphQLApi)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RateLimitConfigResource) Clone() proto.Message {
+	var target *RateLimitConfigResource
+	if m == nil {
+		return target
+	}
+	target = &RateLimitConfigResource{}
+
+	if h, ok := interface{}(m.GetRef()).(clone.Cloner); ok {
+		target.Ref = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Ref = proto.Clone(m.GetRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetSpec()).(clone.Cloner); ok {
+		target.Spec = h.Clone().(*github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1.RateLimitConfigSpec)
+	} else {
+		target.Spec = proto.Clone(m.GetSpec()).(*github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1.RateLimitConfigSpec)
+	}
+
 	return target
 }
 
@@ -156,6 +263,71 @@ func (m *DeletedResources) Clone() proto.Message {
 		}
 	}
 
+	if m.GetUpstreamGroupRefs() != nil {
+		target.UpstreamGroupRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetUpstreamGroupRefs()))
+		for idx, v := range m.GetUpstreamGroupRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroupRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.UpstreamGroupRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
+	if m.GetArtifactRefs() != nil {
+		target.ArtifactRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetArtifactRefs()))
+		for idx, v := range m.GetArtifactRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ArtifactRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.ArtifactRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
+	if m.GetAuthConfigRefs() != nil {
+		target.AuthConfigRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetAuthConfigRefs()))
+		for idx, v := range m.GetAuthConfigRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.AuthConfigRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.AuthConfigRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
+	if m.GetRatelimitConfigRefs() != nil {
+		target.RatelimitConfigRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetRatelimitConfigRefs()))
+		for idx, v := range m.GetRatelimitConfigRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RatelimitConfigRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.RatelimitConfigRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
+	if m.GetGraphqlApiRefs() != nil {
+		target.GraphqlApiRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetGraphqlApiRefs()))
+		for idx, v := range m.GetGraphqlApiRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.GraphqlApiRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.GraphqlApiRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -192,6 +364,84 @@ func (m *ValidationReport) Clone() proto.Message {
 		target.Proxy = proto.Clone(m.GetProxy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Proxy)
 	}
 
+	if m.GetUpstreamGroupReports() != nil {
+		target.UpstreamGroupReports = make([]*ResourceReport, len(m.GetUpstreamGroupReports()))
+		for idx, v := range m.GetUpstreamGroupReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroupReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.UpstreamGroupReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
+	if m.GetAuthConfigReports() != nil {
+		target.AuthConfigReports = make([]*ResourceReport, len(m.GetAuthConfigReports()))
+		for idx, v := range m.GetAuthConfigReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.AuthConfigReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.AuthConfigReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
+	if m.GetRatelimitConfigReports() != nil {
+		target.RatelimitConfigReports = make([]*ResourceReport, len(m.GetRatelimitConfigReports()))
+		for idx, v := range m.GetRatelimitConfigReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RatelimitConfigReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.RatelimitConfigReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
+	if m.GetGraphqlApiReports() != nil {
+		target.GraphqlApiReports = make([]*ResourceReport, len(m.GetGraphqlApiReports()))
+		for idx, v := range m.GetGraphqlApiReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.GraphqlApiReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.GraphqlApiReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
+	if m.GetSecretReports() != nil {
+		target.SecretReports = make([]*ResourceReport, len(m.GetSecretReports()))
+		for idx, v := range m.GetSecretReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.SecretReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.SecretReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
+	if m.GetArtifactReports() != nil {
+		target.ArtifactReports = make([]*ResourceReport, len(m.GetArtifactReports()))
+		for idx, v := range m.GetArtifactReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ArtifactReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.ArtifactReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -177,6 +177,152 @@ func (m *ModifiedResources) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetUpstreamGroups()) != len(target.GetUpstreamGroups()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroups() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroups()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroups()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetSecrets()) != len(target.GetSecrets()) {
+		return false
+	}
+	for idx, v := range m.GetSecrets() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSecrets()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSecrets()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetArtifacts()) != len(target.GetArtifacts()) {
+		return false
+	}
+	for idx, v := range m.GetArtifacts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifacts()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetArtifacts()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetAuthConfigs()) != len(target.GetAuthConfigs()) {
+		return false
+	}
+	for idx, v := range m.GetAuthConfigs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAuthConfigs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetAuthConfigs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetRatelimitConfigs()) != len(target.GetRatelimitConfigs()) {
+		return false
+	}
+	for idx, v := range m.GetRatelimitConfigs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRatelimitConfigs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRatelimitConfigs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetGraphqlApis()) != len(target.GetGraphqlApis()) {
+		return false
+	}
+	for idx, v := range m.GetGraphqlApis() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGraphqlApis()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetGraphqlApis()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RateLimitConfigResource) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RateLimitConfigResource)
+	if !ok {
+		that2, ok := that.(RateLimitConfigResource)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSpec()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSpec()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSpec(), target.GetSpec()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -235,6 +381,91 @@ func (m *DeletedResources) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetUpstreamGroupRefs()) != len(target.GetUpstreamGroupRefs()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroupRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroupRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroupRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetArtifactRefs()) != len(target.GetArtifactRefs()) {
+		return false
+	}
+	for idx, v := range m.GetArtifactRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifactRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetArtifactRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetAuthConfigRefs()) != len(target.GetAuthConfigRefs()) {
+		return false
+	}
+	for idx, v := range m.GetAuthConfigRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAuthConfigRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetAuthConfigRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetRatelimitConfigRefs()) != len(target.GetRatelimitConfigRefs()) {
+		return false
+	}
+	for idx, v := range m.GetRatelimitConfigRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRatelimitConfigRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRatelimitConfigRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetGraphqlApiRefs()) != len(target.GetGraphqlApiRefs()) {
+		return false
+	}
+	for idx, v := range m.GetGraphqlApiRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGraphqlApiRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetGraphqlApiRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -296,6 +527,108 @@ func (m *ValidationReport) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetUpstreamGroupReports()) != len(target.GetUpstreamGroupReports()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroupReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroupReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroupReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetAuthConfigReports()) != len(target.GetAuthConfigReports()) {
+		return false
+	}
+	for idx, v := range m.GetAuthConfigReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAuthConfigReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetAuthConfigReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetRatelimitConfigReports()) != len(target.GetRatelimitConfigReports()) {
+		return false
+	}
+	for idx, v := range m.GetRatelimitConfigReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRatelimitConfigReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRatelimitConfigReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetGraphqlApiReports()) != len(target.GetGraphqlApiReports()) {
+		return false
+	}
+	for idx, v := range m.GetGraphqlApiReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGraphqlApiReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetGraphqlApiReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetSecretReports()) != len(target.GetSecretReports()) {
+		return false
+	}
+	for idx, v := range m.GetSecretReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSecretReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSecretReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetArtifactReports()) != len(target.GetArtifactReports()) {
+		return false
+	}
+	for idx, v := range m.GetArtifactReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifactReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetArtifactReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -13,6 +13,8 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -66,6 +68,22 @@ func (m *Endpoint) Clone() proto.Message {
 		target.Metadata = proto.Clone(m.GetMetadata()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.Metadata)
 	}
 
+	if h, ok := interface{}(m.GetLocality()).(clone.Cloner); ok {
+		target.Locality = h.Clone().(*Locality)
+	} else {
+		target.Locality = proto.Clone(m.GetLocality()).(*Locality)
+	}
+
+	target.HealthStatus = m.GetHealthStatus()
+
+	if h, ok := interface{}(m.GetLoadBalancingWeight()).(clone.Cloner); ok {
+		target.LoadBalancingWeight = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.LoadBalancingWeight = proto.Clone(m.GetLoadBalancingWeight()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.Priority = m.GetPriority()
+
 	return target
 }
 
@@ -95,6 +95,34 @@ func (m *Endpoint) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocality()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocality(), target.GetLocality()) {
+			return false
+		}
+	}
+
+	if m.GetHealthStatus() != target.GetHealthStatus() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetLoadBalancingWeight()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLoadBalancingWeight()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLoadBalancingWeight(), target.GetLoadBalancingWeight()) {
+			return false
+		}
+	}
+
+	if m.GetPriority() != target.GetPriority() {
+		return false
+	}
+
 	return true
 }
 
@@ -408,6 +408,19 @@ func (m *VirtualHostOptions) Clone() proto.Message {
 		target.StagedTransformations = proto.Clone(m.GetStagedTransformations()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_transformation.TransformationStages)
 	}
 
+	if m.GetRequestMirrorPolicies() != nil {
+		target.RequestMirrorPolicies = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy, len(m.GetRequestMirrorPolicies()))
+		for idx, v := range m.GetRequestMirrorPolicies() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RequestMirrorPolicies[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy)
+			} else {
+				target.RequestMirrorPolicies[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy)
+			}
+
+		}
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -677,6 +690,19 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.RegexRewrite = proto.Clone(m.GetRegexRewrite()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_matcher_v3.RegexMatchAndSubstitute)
 	}
 
+	if m.GetRequestMirrorPolicies() != nil {
+		target.RequestMirrorPolicies = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy, len(m.GetRequestMirrorPolicies()))
+		for idx, v := range m.GetRequestMirrorPolicies() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RequestMirrorPolicies[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy)
+			} else {
+				target.RequestMirrorPolicies[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_shadowing.RequestMirrorPolicy)
+			}
+
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
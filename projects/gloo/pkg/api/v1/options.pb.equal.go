@@ -559,6 +559,23 @@ func (m *VirtualHostOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetRequestMirrorPolicies()) != len(target.GetRequestMirrorPolicies()) {
+		return false
+	}
+	for idx, v := range m.GetRequestMirrorPolicies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRequestMirrorPolicies()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRequestMirrorPolicies()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -973,6 +990,23 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetRequestMirrorPolicies()) != len(target.GetRequestMirrorPolicies()) {
+		return false
+	}
+	for idx, v := range m.GetRequestMirrorPolicies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRequestMirrorPolicies()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRequestMirrorPolicies()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -14,6 +14,12 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_struct "github.com/golang/protobuf/ptypes/struct"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // ensure the imports are used
@@ -59,6 +65,12 @@ func (m *AccessLog) Clone() proto.Message {
 	}
 	target = &AccessLog{}
 
+	if h, ok := interface{}(m.GetFilter()).(clone.Cloner); ok {
+		target.Filter = h.Clone().(*AccessLogFilter)
+	} else {
+		target.Filter = proto.Clone(m.GetFilter()).(*AccessLogFilter)
+	}
+
 	switch m.OutputDestination.(type) {
 
 	case *AccessLog_FileSink:
@@ -85,6 +97,18 @@ func (m *AccessLog) Clone() proto.Message {
 			}
 		}
 
+	case *AccessLog_OpenTelemetryService:
+
+		if h, ok := interface{}(m.GetOpenTelemetryService()).(clone.Cloner); ok {
+			target.OutputDestination = &AccessLog_OpenTelemetryService{
+				OpenTelemetryService: h.Clone().(*OpenTelemetryService),
+			}
+		} else {
+			target.OutputDestination = &AccessLog_OpenTelemetryService{
+				OpenTelemetryService: proto.Clone(m.GetOpenTelemetryService()).(*OpenTelemetryService),
+			}
+		}
+
 	}
 
 	return target
@@ -174,3 +198,374 @@ func (m *GrpcService) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *OpenTelemetryService) Clone() proto.Message {
+	var target *OpenTelemetryService
+	if m == nil {
+		return target
+	}
+	target = &OpenTelemetryService{}
+
+	target.LogName = m.GetLogName()
+
+	if m.GetAdditionalRequestHeadersToLog() != nil {
+		target.AdditionalRequestHeadersToLog = make([]string, len(m.GetAdditionalRequestHeadersToLog()))
+		for idx, v := range m.GetAdditionalRequestHeadersToLog() {
+
+			target.AdditionalRequestHeadersToLog[idx] = v
+
+		}
+	}
+
+	if m.GetAdditionalResponseHeadersToLog() != nil {
+		target.AdditionalResponseHeadersToLog = make([]string, len(m.GetAdditionalResponseHeadersToLog()))
+		for idx, v := range m.GetAdditionalResponseHeadersToLog() {
+
+			target.AdditionalResponseHeadersToLog[idx] = v
+
+		}
+	}
+
+	if m.GetAdditionalResponseTrailersToLog() != nil {
+		target.AdditionalResponseTrailersToLog = make([]string, len(m.GetAdditionalResponseTrailersToLog()))
+		for idx, v := range m.GetAdditionalResponseTrailersToLog() {
+
+			target.AdditionalResponseTrailersToLog[idx] = v
+
+		}
+	}
+
+	switch m.CollectorCluster.(type) {
+
+	case *OpenTelemetryService_CollectorUpstreamRef:
+
+		if h, ok := interface{}(m.GetCollectorUpstreamRef()).(clone.Cloner); ok {
+			target.CollectorCluster = &OpenTelemetryService_CollectorUpstreamRef{
+				CollectorUpstreamRef: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.CollectorCluster = &OpenTelemetryService_CollectorUpstreamRef{
+				CollectorUpstreamRef: proto.Clone(m.GetCollectorUpstreamRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	case *OpenTelemetryService_StaticClusterName:
+
+		target.CollectorCluster = &OpenTelemetryService_StaticClusterName{
+			StaticClusterName: m.GetStaticClusterName(),
+		}
+
+	}
+
+	switch m.OutputFormat.(type) {
+
+	case *OpenTelemetryService_StringFormat:
+
+		target.OutputFormat = &OpenTelemetryService_StringFormat{
+			StringFormat: m.GetStringFormat(),
+		}
+
+	case *OpenTelemetryService_JsonFormat:
+
+		if h, ok := interface{}(m.GetJsonFormat()).(clone.Cloner); ok {
+			target.OutputFormat = &OpenTelemetryService_JsonFormat{
+				JsonFormat: h.Clone().(*github_com_golang_protobuf_ptypes_struct.Struct),
+			}
+		} else {
+			target.OutputFormat = &OpenTelemetryService_JsonFormat{
+				JsonFormat: proto.Clone(m.GetJsonFormat()).(*github_com_golang_protobuf_ptypes_struct.Struct),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *AccessLogFilter) Clone() proto.Message {
+	var target *AccessLogFilter
+	if m == nil {
+		return target
+	}
+	target = &AccessLogFilter{}
+
+	switch m.FilterSpecifier.(type) {
+
+	case *AccessLogFilter_StatusCodeFilter:
+
+		if h, ok := interface{}(m.GetStatusCodeFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: h.Clone().(*StatusCodeFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: proto.Clone(m.GetStatusCodeFilter()).(*StatusCodeFilter),
+			}
+		}
+
+	case *AccessLogFilter_DurationFilter:
+
+		if h, ok := interface{}(m.GetDurationFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_DurationFilter{
+				DurationFilter: h.Clone().(*DurationFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_DurationFilter{
+				DurationFilter: proto.Clone(m.GetDurationFilter()).(*DurationFilter),
+			}
+		}
+
+	case *AccessLogFilter_RuntimeFilter:
+
+		if h, ok := interface{}(m.GetRuntimeFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: h.Clone().(*RuntimeFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: proto.Clone(m.GetRuntimeFilter()).(*RuntimeFilter),
+			}
+		}
+
+	case *AccessLogFilter_HeaderFilter:
+
+		if h, ok := interface{}(m.GetHeaderFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_HeaderFilter{
+				HeaderFilter: h.Clone().(*HeaderFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_HeaderFilter{
+				HeaderFilter: proto.Clone(m.GetHeaderFilter()).(*HeaderFilter),
+			}
+		}
+
+	case *AccessLogFilter_AndFilter:
+
+		if h, ok := interface{}(m.GetAndFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_AndFilter{
+				AndFilter: h.Clone().(*AndFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_AndFilter{
+				AndFilter: proto.Clone(m.GetAndFilter()).(*AndFilter),
+			}
+		}
+
+	case *AccessLogFilter_OrFilter:
+
+		if h, ok := interface{}(m.GetOrFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &AccessLogFilter_OrFilter{
+				OrFilter: h.Clone().(*OrFilter),
+			}
+		} else {
+			target.FilterSpecifier = &AccessLogFilter_OrFilter{
+				OrFilter: proto.Clone(m.GetOrFilter()).(*OrFilter),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *SimpleAccessLogFilter) Clone() proto.Message {
+	var target *SimpleAccessLogFilter
+	if m == nil {
+		return target
+	}
+	target = &SimpleAccessLogFilter{}
+
+	switch m.FilterSpecifier.(type) {
+
+	case *SimpleAccessLogFilter_StatusCodeFilter:
+
+		if h, ok := interface{}(m.GetStatusCodeFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &SimpleAccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: h.Clone().(*StatusCodeFilter),
+			}
+		} else {
+			target.FilterSpecifier = &SimpleAccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: proto.Clone(m.GetStatusCodeFilter()).(*StatusCodeFilter),
+			}
+		}
+
+	case *SimpleAccessLogFilter_DurationFilter:
+
+		if h, ok := interface{}(m.GetDurationFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &SimpleAccessLogFilter_DurationFilter{
+				DurationFilter: h.Clone().(*DurationFilter),
+			}
+		} else {
+			target.FilterSpecifier = &SimpleAccessLogFilter_DurationFilter{
+				DurationFilter: proto.Clone(m.GetDurationFilter()).(*DurationFilter),
+			}
+		}
+
+	case *SimpleAccessLogFilter_RuntimeFilter:
+
+		if h, ok := interface{}(m.GetRuntimeFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &SimpleAccessLogFilter_RuntimeFilter{
+				RuntimeFilter: h.Clone().(*RuntimeFilter),
+			}
+		} else {
+			target.FilterSpecifier = &SimpleAccessLogFilter_RuntimeFilter{
+				RuntimeFilter: proto.Clone(m.GetRuntimeFilter()).(*RuntimeFilter),
+			}
+		}
+
+	case *SimpleAccessLogFilter_HeaderFilter:
+
+		if h, ok := interface{}(m.GetHeaderFilter()).(clone.Cloner); ok {
+			target.FilterSpecifier = &SimpleAccessLogFilter_HeaderFilter{
+				HeaderFilter: h.Clone().(*HeaderFilter),
+			}
+		} else {
+			target.FilterSpecifier = &SimpleAccessLogFilter_HeaderFilter{
+				HeaderFilter: proto.Clone(m.GetHeaderFilter()).(*HeaderFilter),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ComparisonFilter) Clone() proto.Message {
+	var target *ComparisonFilter
+	if m == nil {
+		return target
+	}
+	target = &ComparisonFilter{}
+
+	target.Op = m.GetOp()
+
+	target.Value = m.GetValue()
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	return target
+}
+
+// Clone function
+func (m *StatusCodeFilter) Clone() proto.Message {
+	var target *StatusCodeFilter
+	if m == nil {
+		return target
+	}
+	target = &StatusCodeFilter{}
+
+	if h, ok := interface{}(m.GetComparison()).(clone.Cloner); ok {
+		target.Comparison = h.Clone().(*ComparisonFilter)
+	} else {
+		target.Comparison = proto.Clone(m.GetComparison()).(*ComparisonFilter)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *DurationFilter) Clone() proto.Message {
+	var target *DurationFilter
+	if m == nil {
+		return target
+	}
+	target = &DurationFilter{}
+
+	if h, ok := interface{}(m.GetComparison()).(clone.Cloner); ok {
+		target.Comparison = h.Clone().(*ComparisonFilter)
+	} else {
+		target.Comparison = proto.Clone(m.GetComparison()).(*ComparisonFilter)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RuntimeFilter) Clone() proto.Message {
+	var target *RuntimeFilter
+	if m == nil {
+		return target
+	}
+	target = &RuntimeFilter{}
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	if h, ok := interface{}(m.GetPercentSampled()).(clone.Cloner); ok {
+		target.PercentSampled = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3.FractionalPercent)
+	} else {
+		target.PercentSampled = proto.Clone(m.GetPercentSampled()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3.FractionalPercent)
+	}
+
+	target.UseIndependentRandomness = m.GetUseIndependentRandomness()
+
+	return target
+}
+
+// Clone function
+func (m *HeaderFilter) Clone() proto.Message {
+	var target *HeaderFilter
+	if m == nil {
+		return target
+	}
+	target = &HeaderFilter{}
+
+	if h, ok := interface{}(m.GetHeader()).(clone.Cloner); ok {
+		target.Header = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+	} else {
+		target.Header = proto.Clone(m.GetHeader()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *AndFilter) Clone() proto.Message {
+	var target *AndFilter
+	if m == nil {
+		return target
+	}
+	target = &AndFilter{}
+
+	if m.GetFilters() != nil {
+		target.Filters = make([]*SimpleAccessLogFilter, len(m.GetFilters()))
+		for idx, v := range m.GetFilters() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Filters[idx] = h.Clone().(*SimpleAccessLogFilter)
+			} else {
+				target.Filters[idx] = proto.Clone(v).(*SimpleAccessLogFilter)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *OrFilter) Clone() proto.Message {
+	var target *OrFilter
+	if m == nil {
+		return target
+	}
+	target = &OrFilter{}
+
+	if m.GetFilters() != nil {
+		target.Filters = make([]*SimpleAccessLogFilter, len(m.GetFilters()))
+		for idx, v := range m.GetFilters() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Filters[idx] = h.Clone().(*SimpleAccessLogFilter)
+			} else {
+				target.Filters[idx] = proto.Clone(v).(*SimpleAccessLogFilter)
+			}
+
+		}
+	}
+
+	return target
+}
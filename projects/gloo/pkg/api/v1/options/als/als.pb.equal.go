@@ -87,6 +87,16 @@ func (m *AccessLog) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetFilter()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFilter()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFilter(), target.GetFilter()) {
+			return false
+		}
+	}
+
 	switch m.OutputDestination.(type) {
 
 	case *AccessLog_FileSink:
@@ -119,6 +129,21 @@ func (m *AccessLog) Equal(that interface{}) bool {
 			}
 		}
 
+	case *AccessLog_OpenTelemetryService:
+		if _, ok := target.OutputDestination.(*AccessLog_OpenTelemetryService); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetOpenTelemetryService()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetOpenTelemetryService()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetOpenTelemetryService(), target.GetOpenTelemetryService()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.OutputDestination != target.OutputDestination {
@@ -268,3 +293,608 @@ func (m *GrpcService) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *OpenTelemetryService) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*OpenTelemetryService)
+	if !ok {
+		that2, ok := that.(OpenTelemetryService)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetLogName(), target.GetLogName()) != 0 {
+		return false
+	}
+
+	if len(m.GetAdditionalRequestHeadersToLog()) != len(target.GetAdditionalRequestHeadersToLog()) {
+		return false
+	}
+	for idx, v := range m.GetAdditionalRequestHeadersToLog() {
+
+		if strings.Compare(v, target.GetAdditionalRequestHeadersToLog()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetAdditionalResponseHeadersToLog()) != len(target.GetAdditionalResponseHeadersToLog()) {
+		return false
+	}
+	for idx, v := range m.GetAdditionalResponseHeadersToLog() {
+
+		if strings.Compare(v, target.GetAdditionalResponseHeadersToLog()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetAdditionalResponseTrailersToLog()) != len(target.GetAdditionalResponseTrailersToLog()) {
+		return false
+	}
+	for idx, v := range m.GetAdditionalResponseTrailersToLog() {
+
+		if strings.Compare(v, target.GetAdditionalResponseTrailersToLog()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	switch m.CollectorCluster.(type) {
+
+	case *OpenTelemetryService_CollectorUpstreamRef:
+		if _, ok := target.CollectorCluster.(*OpenTelemetryService_CollectorUpstreamRef); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetCollectorUpstreamRef()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCollectorUpstreamRef()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetCollectorUpstreamRef(), target.GetCollectorUpstreamRef()) {
+				return false
+			}
+		}
+
+	case *OpenTelemetryService_StaticClusterName:
+		if _, ok := target.CollectorCluster.(*OpenTelemetryService_StaticClusterName); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetStaticClusterName(), target.GetStaticClusterName()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.CollectorCluster != target.CollectorCluster {
+			return false
+		}
+	}
+
+	switch m.OutputFormat.(type) {
+
+	case *OpenTelemetryService_StringFormat:
+		if _, ok := target.OutputFormat.(*OpenTelemetryService_StringFormat); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetStringFormat(), target.GetStringFormat()) != 0 {
+			return false
+		}
+
+	case *OpenTelemetryService_JsonFormat:
+		if _, ok := target.OutputFormat.(*OpenTelemetryService_JsonFormat); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetJsonFormat()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetJsonFormat()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetJsonFormat(), target.GetJsonFormat()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.OutputFormat != target.OutputFormat {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessLogFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessLogFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.FilterSpecifier.(type) {
+
+	case *AccessLogFilter_StatusCodeFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_StatusCodeFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetStatusCodeFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetStatusCodeFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetStatusCodeFilter(), target.GetStatusCodeFilter()) {
+				return false
+			}
+		}
+
+	case *AccessLogFilter_DurationFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_DurationFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDurationFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDurationFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDurationFilter(), target.GetDurationFilter()) {
+				return false
+			}
+		}
+
+	case *AccessLogFilter_RuntimeFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_RuntimeFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetRuntimeFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRuntimeFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetRuntimeFilter(), target.GetRuntimeFilter()) {
+				return false
+			}
+		}
+
+	case *AccessLogFilter_HeaderFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_HeaderFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHeaderFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaderFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHeaderFilter(), target.GetHeaderFilter()) {
+				return false
+			}
+		}
+
+	case *AccessLogFilter_AndFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_AndFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetAndFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAndFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetAndFilter(), target.GetAndFilter()) {
+				return false
+			}
+		}
+
+	case *AccessLogFilter_OrFilter:
+		if _, ok := target.FilterSpecifier.(*AccessLogFilter_OrFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetOrFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetOrFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetOrFilter(), target.GetOrFilter()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.FilterSpecifier != target.FilterSpecifier {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *SimpleAccessLogFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*SimpleAccessLogFilter)
+	if !ok {
+		that2, ok := that.(SimpleAccessLogFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.FilterSpecifier.(type) {
+
+	case *SimpleAccessLogFilter_StatusCodeFilter:
+		if _, ok := target.FilterSpecifier.(*SimpleAccessLogFilter_StatusCodeFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetStatusCodeFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetStatusCodeFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetStatusCodeFilter(), target.GetStatusCodeFilter()) {
+				return false
+			}
+		}
+
+	case *SimpleAccessLogFilter_DurationFilter:
+		if _, ok := target.FilterSpecifier.(*SimpleAccessLogFilter_DurationFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDurationFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDurationFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDurationFilter(), target.GetDurationFilter()) {
+				return false
+			}
+		}
+
+	case *SimpleAccessLogFilter_RuntimeFilter:
+		if _, ok := target.FilterSpecifier.(*SimpleAccessLogFilter_RuntimeFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetRuntimeFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRuntimeFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetRuntimeFilter(), target.GetRuntimeFilter()) {
+				return false
+			}
+		}
+
+	case *SimpleAccessLogFilter_HeaderFilter:
+		if _, ok := target.FilterSpecifier.(*SimpleAccessLogFilter_HeaderFilter); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHeaderFilter()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaderFilter()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHeaderFilter(), target.GetHeaderFilter()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.FilterSpecifier != target.FilterSpecifier {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ComparisonFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ComparisonFilter)
+	if !ok {
+		that2, ok := that.(ComparisonFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetOp() != target.GetOp() {
+		return false
+	}
+
+	if m.GetValue() != target.GetValue() {
+		return false
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *StatusCodeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*StatusCodeFilter)
+	if !ok {
+		that2, ok := that.(StatusCodeFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetComparison()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetComparison()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetComparison(), target.GetComparison()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *DurationFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DurationFilter)
+	if !ok {
+		that2, ok := that.(DurationFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetComparison()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetComparison()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetComparison(), target.GetComparison()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RuntimeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RuntimeFilter)
+	if !ok {
+		that2, ok := that.(RuntimeFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPercentSampled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPercentSampled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPercentSampled(), target.GetPercentSampled()) {
+			return false
+		}
+	}
+
+	if m.GetUseIndependentRandomness() != target.GetUseIndependentRandomness() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HeaderFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderFilter)
+	if !ok {
+		that2, ok := that.(HeaderFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetHeader()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHeader()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHeader(), target.GetHeader()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AndFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AndFilter)
+	if !ok {
+		that2, ok := that.(AndFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetFilters()) != len(target.GetFilters()) {
+		return false
+	}
+	for idx, v := range m.GetFilters() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFilters()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetFilters()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *OrFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*OrFilter)
+	if !ok {
+		that2, ok := that.(OrFilter)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetFilters()) != len(target.GetFilters()) {
+		return false
+	}
+	for idx, v := range m.GetFilters() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFilters()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetFilters()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
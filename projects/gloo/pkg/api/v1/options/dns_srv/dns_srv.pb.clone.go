@@ -0,0 +1,63 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns_srv/dns_srv.proto
+
+package dns_srv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *UpstreamSpec) Clone() proto.Message {
+	var target *UpstreamSpec
+	if m == nil {
+		return target
+	}
+	target = &UpstreamSpec{}
+
+	target.RecordName = m.GetRecordName()
+
+	target.ResolverAddress = m.GetResolverAddress()
+
+	if h, ok := interface{}(m.GetPollInterval()).(clone.Cloner); ok {
+		target.PollInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.PollInterval = proto.Clone(m.GetPollInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetRespectTtl()).(clone.Cloner); ok {
+		target.RespectTtl = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.RespectTtl = proto.Clone(m.GetRespectTtl()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	if h, ok := interface{}(m.GetMinTtl()).(clone.Cloner); ok {
+		target.MinTtl = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MinTtl = proto.Clone(m.GetMinTtl()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
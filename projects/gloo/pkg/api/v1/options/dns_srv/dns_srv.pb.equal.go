@@ -0,0 +1,88 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns_srv/dns_srv.proto
+
+package dns_srv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetRecordName(), target.GetRecordName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetResolverAddress(), target.GetResolverAddress()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPollInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPollInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPollInterval(), target.GetPollInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRespectTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRespectTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRespectTtl(), target.GetRespectTtl()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinTtl(), target.GetMinTtl()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/file_discovery/file_discovery.proto
+
+package file_discovery
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *UpstreamSpec) Clone() proto.Message {
+	var target *UpstreamSpec
+	if m == nil {
+		return target
+	}
+	target = &UpstreamSpec{}
+
+	target.ServiceName = m.GetServiceName()
+
+	return target
+}
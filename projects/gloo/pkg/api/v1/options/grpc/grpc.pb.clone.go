@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // ensure the imports are used
@@ -53,6 +55,12 @@ func (m *ServiceSpec) Clone() proto.Message {
 		}
 	}
 
+	if h, ok := interface{}(m.GetDescriptorSetSource()).(clone.Cloner); ok {
+		target.DescriptorSetSource = h.Clone().(*ServiceSpec_DescriptorSetSource)
+	} else {
+		target.DescriptorSetSource = proto.Clone(m.GetDescriptorSetSource()).(*ServiceSpec_DescriptorSetSource)
+	}
+
 	return target
 }
 
@@ -102,3 +110,36 @@ func (m *ServiceSpec_GrpcService) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *ServiceSpec_DescriptorSetSource) Clone() proto.Message {
+	var target *ServiceSpec_DescriptorSetSource
+	if m == nil {
+		return target
+	}
+	target = &ServiceSpec_DescriptorSetSource{}
+
+	switch m.Source.(type) {
+
+	case *ServiceSpec_DescriptorSetSource_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(clone.Cloner); ok {
+			target.Source = &ServiceSpec_DescriptorSetSource_Artifact{
+				Artifact: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.Source = &ServiceSpec_DescriptorSetSource_Artifact{
+				Artifact: proto.Clone(m.GetArtifact()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	case *ServiceSpec_DescriptorSetSource_Url:
+
+		target.Source = &ServiceSpec_DescriptorSetSource_Url{
+			Url: m.GetUrl(),
+		}
+
+	}
+
+	return target
+}
@@ -67,6 +67,16 @@ func (m *ServiceSpec) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetDescriptorSetSource()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDescriptorSetSource()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDescriptorSetSource(), target.GetDescriptorSetSource()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -158,3 +168,60 @@ func (m *ServiceSpec_GrpcService) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *ServiceSpec_DescriptorSetSource) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceSpec_DescriptorSetSource)
+	if !ok {
+		that2, ok := that.(ServiceSpec_DescriptorSetSource)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.Source.(type) {
+
+	case *ServiceSpec_DescriptorSetSource_Artifact:
+		if _, ok := target.Source.(*ServiceSpec_DescriptorSetSource_Artifact); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetArtifact()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifact()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetArtifact(), target.GetArtifact()) {
+				return false
+			}
+		}
+
+	case *ServiceSpec_DescriptorSetSource_Url:
+		if _, ok := target.Source.(*ServiceSpec_DescriptorSetSource_Url); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetUrl(), target.GetUrl()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Source != target.Source {
+			return false
+		}
+	}
+
+	return true
+}
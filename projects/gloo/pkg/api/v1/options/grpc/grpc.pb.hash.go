@@ -66,6 +66,26 @@ func (m *ServiceSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if h, ok := interface{}(m.GetDescriptorSetSource()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("DescriptorSetSource")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDescriptorSetSource(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("DescriptorSetSource")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -148,3 +168,51 @@ func (m *ServiceSpec_GrpcService) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *ServiceSpec_DescriptorSetSource) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("grpc.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc.ServiceSpec_DescriptorSetSource")); err != nil {
+		return 0, err
+	}
+
+	switch m.Source.(type) {
+
+	case *ServiceSpec_DescriptorSetSource_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Artifact")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetArtifact(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Artifact")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *ServiceSpec_DescriptorSetSource_Url:
+
+		if _, err = hasher.Write([]byte(m.GetUrl())); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -45,5 +47,215 @@ func (m *RetryPolicy) Clone() proto.Message {
 		target.PerTryTimeout = proto.Clone(m.GetPerTryTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	if h, ok := interface{}(m.GetRetryBackOff()).(clone.Cloner); ok {
+		target.RetryBackOff = h.Clone().(*RetryBackOff)
+	} else {
+		target.RetryBackOff = proto.Clone(m.GetRetryBackOff()).(*RetryBackOff)
+	}
+
+	if h, ok := interface{}(m.GetRateLimitedRetryBackOff()).(clone.Cloner); ok {
+		target.RateLimitedRetryBackOff = h.Clone().(*RateLimitedRetryBackOff)
+	} else {
+		target.RateLimitedRetryBackOff = proto.Clone(m.GetRateLimitedRetryBackOff()).(*RateLimitedRetryBackOff)
+	}
+
+	if m.GetRetriableStatusCodes() != nil {
+		target.RetriableStatusCodes = make([]uint32, len(m.GetRetriableStatusCodes()))
+		for idx, v := range m.GetRetriableStatusCodes() {
+
+			target.RetriableStatusCodes[idx] = v
+
+		}
+	}
+
+	if m.GetRetriableHeaders() != nil {
+		target.RetriableHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetRetriableHeaders()))
+		for idx, v := range m.GetRetriableHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RetriableHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.RetriableHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if m.GetRetriableRequestHeaders() != nil {
+		target.RetriableRequestHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetRetriableRequestHeaders()))
+		for idx, v := range m.GetRetriableRequestHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RetriableRequestHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.RetriableRequestHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if m.GetRetryHostPredicates() != nil {
+		target.RetryHostPredicates = make([]*RetryHostPredicate, len(m.GetRetryHostPredicates()))
+		for idx, v := range m.GetRetryHostPredicates() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RetryHostPredicates[idx] = h.Clone().(*RetryHostPredicate)
+			} else {
+				target.RetryHostPredicates[idx] = proto.Clone(v).(*RetryHostPredicate)
+			}
+
+		}
+	}
+
+	target.HostSelectionRetryMaxAttempts = m.GetHostSelectionRetryMaxAttempts()
+
+	if h, ok := interface{}(m.GetPreviousPriorities()).(clone.Cloner); ok {
+		target.PreviousPriorities = h.Clone().(*PreviousPriorities)
+	} else {
+		target.PreviousPriorities = proto.Clone(m.GetPreviousPriorities()).(*PreviousPriorities)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RetryBackOff) Clone() proto.Message {
+	var target *RetryBackOff
+	if m == nil {
+		return target
+	}
+	target = &RetryBackOff{}
+
+	if h, ok := interface{}(m.GetBaseInterval()).(clone.Cloner); ok {
+		target.BaseInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.BaseInterval = proto.Clone(m.GetBaseInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(clone.Cloner); ok {
+		target.MaxInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MaxInterval = proto.Clone(m.GetMaxInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RateLimitedRetryBackOff) Clone() proto.Message {
+	var target *RateLimitedRetryBackOff
+	if m == nil {
+		return target
+	}
+	target = &RateLimitedRetryBackOff{}
+
+	if m.GetResetHeaders() != nil {
+		target.ResetHeaders = make([]*ResetHeader, len(m.GetResetHeaders()))
+		for idx, v := range m.GetResetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ResetHeaders[idx] = h.Clone().(*ResetHeader)
+			} else {
+				target.ResetHeaders[idx] = proto.Clone(v).(*ResetHeader)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(clone.Cloner); ok {
+		target.MaxInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MaxInterval = proto.Clone(m.GetMaxInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ResetHeader) Clone() proto.Message {
+	var target *ResetHeader
+	if m == nil {
+		return target
+	}
+	target = &ResetHeader{}
+
+	target.Name = m.GetName()
+
+	target.Format = m.GetFormat()
+
+	return target
+}
+
+// Clone function
+func (m *RetryHostPredicate) Clone() proto.Message {
+	var target *RetryHostPredicate
+	if m == nil {
+		return target
+	}
+	target = &RetryHostPredicate{}
+
+	switch m.HostPredicate.(type) {
+
+	case *RetryHostPredicate_PreviousHosts_:
+
+		if h, ok := interface{}(m.GetPreviousHosts()).(clone.Cloner); ok {
+			target.HostPredicate = &RetryHostPredicate_PreviousHosts_{
+				PreviousHosts: h.Clone().(*RetryHostPredicate_PreviousHosts),
+			}
+		} else {
+			target.HostPredicate = &RetryHostPredicate_PreviousHosts_{
+				PreviousHosts: proto.Clone(m.GetPreviousHosts()).(*RetryHostPredicate_PreviousHosts),
+			}
+		}
+
+	case *RetryHostPredicate_OmitCanaryHosts_:
+
+		if h, ok := interface{}(m.GetOmitCanaryHosts()).(clone.Cloner); ok {
+			target.HostPredicate = &RetryHostPredicate_OmitCanaryHosts_{
+				OmitCanaryHosts: h.Clone().(*RetryHostPredicate_OmitCanaryHosts),
+			}
+		} else {
+			target.HostPredicate = &RetryHostPredicate_OmitCanaryHosts_{
+				OmitCanaryHosts: proto.Clone(m.GetOmitCanaryHosts()).(*RetryHostPredicate_OmitCanaryHosts),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *PreviousPriorities) Clone() proto.Message {
+	var target *PreviousPriorities
+	if m == nil {
+		return target
+	}
+	target = &PreviousPriorities{}
+
+	target.UpdateFrequency = m.GetUpdateFrequency()
+
+	return target
+}
+
+// Clone function
+func (m *RetryHostPredicate_PreviousHosts) Clone() proto.Message {
+	var target *RetryHostPredicate_PreviousHosts
+	if m == nil {
+		return target
+	}
+	target = &RetryHostPredicate_PreviousHosts{}
+
+	return target
+}
+
+// Clone function
+func (m *RetryHostPredicate_OmitCanaryHosts) Clone() proto.Message {
+	var target *RetryHostPredicate_OmitCanaryHosts
+	if m == nil {
+		return target
+	}
+	target = &RetryHostPredicate_OmitCanaryHosts{}
+
 	return target
 }
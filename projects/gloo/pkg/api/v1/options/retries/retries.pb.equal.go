@@ -64,5 +64,367 @@ func (m *RetryPolicy) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRetryBackOff()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRetryBackOff()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRetryBackOff(), target.GetRetryBackOff()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRateLimitedRetryBackOff()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRateLimitedRetryBackOff()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRateLimitedRetryBackOff(), target.GetRateLimitedRetryBackOff()) {
+			return false
+		}
+	}
+
+	if len(m.GetRetriableStatusCodes()) != len(target.GetRetriableStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableStatusCodes() {
+
+		if v != target.GetRetriableStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
+	if len(m.GetRetriableHeaders()) != len(target.GetRetriableHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRetriableHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRetriableHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetRetriableRequestHeaders()) != len(target.GetRetriableRequestHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableRequestHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRetriableRequestHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRetriableRequestHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetRetryHostPredicates()) != len(target.GetRetryHostPredicates()) {
+		return false
+	}
+	for idx, v := range m.GetRetryHostPredicates() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRetryHostPredicates()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRetryHostPredicates()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetHostSelectionRetryMaxAttempts() != target.GetHostSelectionRetryMaxAttempts() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPreviousPriorities()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPreviousPriorities()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPreviousPriorities(), target.GetPreviousPriorities()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RetryBackOff) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RetryBackOff)
+	if !ok {
+		that2, ok := that.(RetryBackOff)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetBaseInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBaseInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBaseInterval(), target.GetBaseInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxInterval(), target.GetMaxInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RateLimitedRetryBackOff) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RateLimitedRetryBackOff)
+	if !ok {
+		that2, ok := that.(RateLimitedRetryBackOff)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetResetHeaders()) != len(target.GetResetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetResetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetResetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetResetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxInterval(), target.GetMaxInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResetHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResetHeader)
+	if !ok {
+		that2, ok := that.(ResetHeader)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if m.GetFormat() != target.GetFormat() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RetryHostPredicate) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RetryHostPredicate)
+	if !ok {
+		that2, ok := that.(RetryHostPredicate)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.HostPredicate.(type) {
+
+	case *RetryHostPredicate_PreviousHosts_:
+		if _, ok := target.HostPredicate.(*RetryHostPredicate_PreviousHosts_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetPreviousHosts()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPreviousHosts()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetPreviousHosts(), target.GetPreviousHosts()) {
+				return false
+			}
+		}
+
+	case *RetryHostPredicate_OmitCanaryHosts_:
+		if _, ok := target.HostPredicate.(*RetryHostPredicate_OmitCanaryHosts_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetOmitCanaryHosts()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetOmitCanaryHosts()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetOmitCanaryHosts(), target.GetOmitCanaryHosts()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.HostPredicate != target.HostPredicate {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *PreviousPriorities) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*PreviousPriorities)
+	if !ok {
+		that2, ok := that.(PreviousPriorities)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetUpdateFrequency() != target.GetUpdateFrequency() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RetryHostPredicate_PreviousHosts) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RetryHostPredicate_PreviousHosts)
+	if !ok {
+		that2, ok := that.(RetryHostPredicate_PreviousHosts)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RetryHostPredicate_OmitCanaryHosts) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RetryHostPredicate_OmitCanaryHosts)
+	if !ok {
+		that2, ok := that.(RetryHostPredicate_OmitCanaryHosts)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
 	return true
 }
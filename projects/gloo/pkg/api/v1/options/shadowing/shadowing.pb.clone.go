@@ -13,6 +13,10 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -45,3 +49,113 @@ func (m *RouteShadowing) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *RequestMirrorPolicy) Clone() proto.Message {
+	var target *RequestMirrorPolicy
+	if m == nil {
+		return target
+	}
+	target = &RequestMirrorPolicy{}
+
+	if h, ok := interface{}(m.GetPercentage()).(clone.Cloner); ok {
+		target.Percentage = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.FloatValue)
+	} else {
+		target.Percentage = proto.Clone(m.GetPercentage()).(*github_com_golang_protobuf_ptypes_wrappers.FloatValue)
+	}
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTraceSampled()).(clone.Cloner); ok {
+		target.TraceSampled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.TraceSampled = proto.Clone(m.GetTraceSampled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	switch m.DestinationType.(type) {
+
+	case *RequestMirrorPolicy_Upstream:
+
+		if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirrorPolicy_Upstream{
+				Upstream: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.DestinationType = &RequestMirrorPolicy_Upstream{
+				Upstream: proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	case *RequestMirrorPolicy_Kube:
+
+		if h, ok := interface{}(m.GetKube()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirrorPolicy_Kube{
+				Kube: h.Clone().(*RequestMirrorPolicy_KubernetesServiceDestination),
+			}
+		} else {
+			target.DestinationType = &RequestMirrorPolicy_Kube{
+				Kube: proto.Clone(m.GetKube()).(*RequestMirrorPolicy_KubernetesServiceDestination),
+			}
+		}
+
+	case *RequestMirrorPolicy_Consul:
+
+		if h, ok := interface{}(m.GetConsul()).(clone.Cloner); ok {
+			target.DestinationType = &RequestMirrorPolicy_Consul{
+				Consul: h.Clone().(*RequestMirrorPolicy_ConsulServiceDestination),
+			}
+		} else {
+			target.DestinationType = &RequestMirrorPolicy_Consul{
+				Consul: proto.Clone(m.GetConsul()).(*RequestMirrorPolicy_ConsulServiceDestination),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RequestMirrorPolicy_KubernetesServiceDestination) Clone() proto.Message {
+	var target *RequestMirrorPolicy_KubernetesServiceDestination
+	if m == nil {
+		return target
+	}
+	target = &RequestMirrorPolicy_KubernetesServiceDestination{}
+
+	if h, ok := interface{}(m.GetRef()).(clone.Cloner); ok {
+		target.Ref = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Ref = proto.Clone(m.GetRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.Port = m.GetPort()
+
+	return target
+}
+
+// Clone function
+func (m *RequestMirrorPolicy_ConsulServiceDestination) Clone() proto.Message {
+	var target *RequestMirrorPolicy_ConsulServiceDestination
+	if m == nil {
+		return target
+	}
+	target = &RequestMirrorPolicy_ConsulServiceDestination{}
+
+	target.ServiceName = m.GetServiceName()
+
+	return target
+}
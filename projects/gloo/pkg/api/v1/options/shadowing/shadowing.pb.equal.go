@@ -62,3 +62,188 @@ func (m *RouteShadowing) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *RequestMirrorPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RequestMirrorPolicy)
+	if !ok {
+		that2, ok := that.(RequestMirrorPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPercentage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPercentage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPercentage(), target.GetPercentage()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTraceSampled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTraceSampled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTraceSampled(), target.GetTraceSampled()) {
+			return false
+		}
+	}
+
+	switch m.DestinationType.(type) {
+
+	case *RequestMirrorPolicy_Upstream:
+		if _, ok := target.DestinationType.(*RequestMirrorPolicy_Upstream); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstream()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+				return false
+			}
+		}
+
+	case *RequestMirrorPolicy_Kube:
+		if _, ok := target.DestinationType.(*RequestMirrorPolicy_Kube); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetKube()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetKube()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetKube(), target.GetKube()) {
+				return false
+			}
+		}
+
+	case *RequestMirrorPolicy_Consul:
+		if _, ok := target.DestinationType.(*RequestMirrorPolicy_Consul); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConsul()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConsul()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConsul(), target.GetConsul()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.DestinationType != target.DestinationType {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RequestMirrorPolicy_KubernetesServiceDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RequestMirrorPolicy_KubernetesServiceDestination)
+	if !ok {
+		that2, ok := that.(RequestMirrorPolicy_KubernetesServiceDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RequestMirrorPolicy_ConsulServiceDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RequestMirrorPolicy_ConsulServiceDestination)
+	if !ok {
+		that2, ok := that.(RequestMirrorPolicy_ConsulServiceDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -415,6 +415,12 @@ func (m *GlooOptions) Clone() proto.Message {
 
 	target.ProxyDebugBindAddr = m.GetProxyDebugBindAddr()
 
+	if h, ok := interface{}(m.GetCertExpiryWarningThreshold()).(clone.Cloner); ok {
+		target.CertExpiryWarningThreshold = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.CertExpiryWarningThreshold = proto.Clone(m.GetCertExpiryWarningThreshold()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
 	return target
 }
 
@@ -645,6 +651,12 @@ func (m *Settings_DiscoveryOptions) Clone() proto.Message {
 		target.UdsOptions = proto.Clone(m.GetUdsOptions()).(*Settings_DiscoveryOptions_UdsOptions)
 	}
 
+	if h, ok := interface{}(m.GetFileOptions()).(clone.Cloner); ok {
+		target.FileOptions = h.Clone().(*Settings_DiscoveryOptions_FileOptions)
+	} else {
+		target.FileOptions = proto.Clone(m.GetFileOptions()).(*Settings_DiscoveryOptions_FileOptions)
+	}
+
 	return target
 }
 
@@ -744,6 +756,8 @@ func (m *Settings_KubernetesConfiguration) Clone() proto.Message {
 		target.RateLimits = proto.Clone(m.GetRateLimits()).(*Settings_KubernetesConfiguration_RateLimits)
 	}
 
+	target.EndpointsSource = m.GetEndpointsSource()
+
 	return target
 }
 
@@ -803,6 +817,25 @@ func (m *Settings_DiscoveryOptions_UdsOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *Settings_DiscoveryOptions_FileOptions) Clone() proto.Message {
+	var target *Settings_DiscoveryOptions_FileOptions
+	if m == nil {
+		return target
+	}
+	target = &Settings_DiscoveryOptions_FileOptions{}
+
+	target.Directory = m.GetDirectory()
+
+	if h, ok := interface{}(m.GetDebounce()).(clone.Cloner); ok {
+		target.Debounce = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Debounce = proto.Clone(m.GetDebounce()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Clone() proto.Message {
 	var target *Settings_ConsulConfiguration_ServiceDiscoveryOptions
@@ -626,6 +626,16 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetCertExpiryWarningThreshold()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCertExpiryWarningThreshold()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCertExpiryWarningThreshold(), target.GetCertExpiryWarningThreshold()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1090,6 +1100,16 @@ func (m *Settings_DiscoveryOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFileOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFileOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFileOptions(), target.GetFileOptions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1282,6 +1302,10 @@ func (m *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetEndpointsSource() != target.GetEndpointsSource() {
+		return false
+	}
+
 	return true
 }
 
@@ -1381,6 +1405,44 @@ func (m *Settings_DiscoveryOptions_UdsOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *Settings_DiscoveryOptions_FileOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Settings_DiscoveryOptions_FileOptions)
+	if !ok {
+		that2, ok := that.(Settings_DiscoveryOptions_FileOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetDirectory(), target.GetDirectory()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDebounce()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDebounce()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDebounce(), target.GetDebounce()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Equal(that interface{}) bool {
 	if that == nil {
@@ -962,6 +962,26 @@ func (m *GlooOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if h, ok := interface{}(m.GetCertExpiryWarningThreshold()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("CertExpiryWarningThreshold")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCertExpiryWarningThreshold(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("CertExpiryWarningThreshold")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1434,6 +1454,26 @@ func (m *Settings_DiscoveryOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFileOptions()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("FileOptions")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFileOptions(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("FileOptions")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1665,6 +1705,11 @@ func (m *Settings_KubernetesConfiguration) Hash(hasher hash.Hash64) (uint64, err
 		}
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetEndpointsSource())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1802,6 +1847,46 @@ func (m *Settings_DiscoveryOptions_UdsOptions) Hash(hasher hash.Hash64) (uint64,
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *Settings_DiscoveryOptions_FileOptions) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.Settings_DiscoveryOptions_FileOptions")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetDirectory())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetDebounce()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Debounce")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDebounce(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Debounce")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
@@ -27,6 +27,10 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_file_discovery "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/file_discovery"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
@@ -244,6 +248,30 @@ func (m *Upstream) Clone() proto.Message {
 			}
 		}
 
+	case *Upstream_DnsSrv:
+
+		if h, ok := interface{}(m.GetDnsSrv()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_DnsSrv{
+				DnsSrv: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_DnsSrv{
+				DnsSrv: proto.Clone(m.GetDnsSrv()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dns_srv.UpstreamSpec),
+			}
+		}
+
+	case *Upstream_FileDiscovery:
+
+		if h, ok := interface{}(m.GetFileDiscovery()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_FileDiscovery{
+				FileDiscovery: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_file_discovery.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_FileDiscovery{
+				FileDiscovery: proto.Clone(m.GetFileDiscovery()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_file_discovery.UpstreamSpec),
+			}
+		}
+
 	}
 
 	return target
@@ -320,6 +320,36 @@ func (m *Upstream) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Upstream_DnsSrv:
+		if _, ok := target.UpstreamType.(*Upstream_DnsSrv); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDnsSrv()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDnsSrv()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDnsSrv(), target.GetDnsSrv()) {
+				return false
+			}
+		}
+
+	case *Upstream_FileDiscovery:
+		if _, ok := target.UpstreamType.(*Upstream_FileDiscovery); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetFileDiscovery()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFileDiscovery()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetFileDiscovery(), target.GetFileDiscovery()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.UpstreamType != target.UpstreamType {
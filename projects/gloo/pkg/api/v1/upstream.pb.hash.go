@@ -498,6 +498,50 @@ func (m *Upstream) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Upstream_DnsSrv:
+
+		if h, ok := interface{}(m.GetDnsSrv()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("DnsSrv")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetDnsSrv(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("DnsSrv")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Upstream_FileDiscovery:
+
+		if h, ok := interface{}(m.GetFileDiscovery()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("FileDiscovery")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetFileDiscovery(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("FileDiscovery")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
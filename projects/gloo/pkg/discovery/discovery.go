@@ -247,7 +247,9 @@ func aggregateEndpoints(endpointsByUds map[DiscoveryPlugin]v1.EndpointList) v1.E
 func txnEndpoint(original, desired *v1.Endpoint) (bool, error) {
 	equal := refsEqual(original.GetUpstreams(), desired.GetUpstreams()) &&
 		original.GetAddress() == desired.GetAddress() &&
-		original.GetPort() == desired.GetPort()
+		original.GetPort() == desired.GetPort() &&
+		original.GetLoadBalancingWeight().GetValue() == desired.GetLoadBalancingWeight().GetValue() &&
+		original.GetPriority() == desired.GetPriority()
 	return !equal, nil
 }
 
@@ -0,0 +1,144 @@
+package als
+
+import (
+	"context"
+
+	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// the runtime keys used when none are configured, as envoy requires them
+const (
+	defaultStatusCodeRuntimeKey = "access_log.status_code_filter"
+	defaultDurationRuntimeKey   = "access_log.duration_filter"
+	defaultRuntimeFilterKey     = "access_log.runtime_filter"
+)
+
+// translateFilter converts the filter of an access log into the envoy filter, which is validated
+func translateFilter(ctx context.Context, filter *als.AccessLogFilter) (*envoyal.AccessLogFilter, error) {
+	var out *envoyal.AccessLogFilter
+	switch typedFilter := filter.GetFilterSpecifier().(type) {
+	case *als.AccessLogFilter_StatusCodeFilter:
+		out = statusCodeFilter(typedFilter.StatusCodeFilter)
+	case *als.AccessLogFilter_DurationFilter:
+		out = durationFilter(typedFilter.DurationFilter)
+	case *als.AccessLogFilter_RuntimeFilter:
+		out = runtimeFilter(typedFilter.RuntimeFilter)
+	case *als.AccessLogFilter_HeaderFilter:
+		out = headerFilter(ctx, typedFilter.HeaderFilter)
+	case *als.AccessLogFilter_AndFilter:
+		out = &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+				AndFilter: &envoyal.AndFilter{
+					Filters: translateSimpleFilters(ctx, typedFilter.AndFilter.GetFilters()),
+				},
+			},
+		}
+	case *als.AccessLogFilter_OrFilter:
+		out = &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+				OrFilter: &envoyal.OrFilter{
+					Filters: translateSimpleFilters(ctx, typedFilter.OrFilter.GetFilters()),
+				},
+			},
+		}
+	default:
+		return nil, eris.New("access log filter must specify a filter")
+	}
+	if err := out.Validate(); err != nil {
+		return nil, eris.Wrapf(err, "invalid access log filter")
+	}
+	return out, nil
+}
+
+func translateSimpleFilters(ctx context.Context, filters []*als.SimpleAccessLogFilter) []*envoyal.AccessLogFilter {
+	var out []*envoyal.AccessLogFilter
+	for _, filter := range filters {
+		switch typedFilter := filter.GetFilterSpecifier().(type) {
+		case *als.SimpleAccessLogFilter_StatusCodeFilter:
+			out = append(out, statusCodeFilter(typedFilter.StatusCodeFilter))
+		case *als.SimpleAccessLogFilter_DurationFilter:
+			out = append(out, durationFilter(typedFilter.DurationFilter))
+		case *als.SimpleAccessLogFilter_RuntimeFilter:
+			out = append(out, runtimeFilter(typedFilter.RuntimeFilter))
+		case *als.SimpleAccessLogFilter_HeaderFilter:
+			out = append(out, headerFilter(ctx, typedFilter.HeaderFilter))
+		default:
+			// an empty filter fails validation
+			out = append(out, &envoyal.AccessLogFilter{})
+		}
+	}
+	return out
+}
+
+func statusCodeFilter(filter *als.StatusCodeFilter) *envoyal.AccessLogFilter {
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoyal.StatusCodeFilter{
+				Comparison: comparisonFilter(filter.GetComparison(), defaultStatusCodeRuntimeKey),
+			},
+		},
+	}
+}
+
+func durationFilter(filter *als.DurationFilter) *envoyal.AccessLogFilter {
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_DurationFilter{
+			DurationFilter: &envoyal.DurationFilter{
+				Comparison: comparisonFilter(filter.GetComparison(), defaultDurationRuntimeKey),
+			},
+		},
+	}
+}
+
+func comparisonFilter(comparison *als.ComparisonFilter, defaultRuntimeKey string) *envoyal.ComparisonFilter {
+	runtimeKey := comparison.GetRuntimeKey()
+	if runtimeKey == "" {
+		runtimeKey = defaultRuntimeKey
+	}
+	return &envoyal.ComparisonFilter{
+		Op: envoyal.ComparisonFilter_Op(comparison.GetOp()),
+		Value: &envoycore.RuntimeUInt32{
+			DefaultValue: comparison.GetValue(),
+			RuntimeKey:   runtimeKey,
+		},
+	}
+}
+
+func runtimeFilter(filter *als.RuntimeFilter) *envoyal.AccessLogFilter {
+	runtimeKey := filter.GetRuntimeKey()
+	if runtimeKey == "" {
+		runtimeKey = defaultRuntimeFilterKey
+	}
+	out := &envoyal.RuntimeFilter{
+		RuntimeKey:               runtimeKey,
+		UseIndependentRandomness: filter.GetUseIndependentRandomness(),
+	}
+	if percentSampled := filter.GetPercentSampled(); percentSampled != nil {
+		out.PercentSampled = &envoytype.FractionalPercent{
+			Numerator:   percentSampled.GetNumerator(),
+			Denominator: envoytype.FractionalPercent_DenominatorType(percentSampled.GetDenominator()),
+		}
+	}
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_RuntimeFilter{
+			RuntimeFilter: out,
+		},
+	}
+}
+
+func headerFilter(ctx context.Context, filter *als.HeaderFilter) *envoyal.AccessLogFilter {
+	matcher := utils.EnvoyHeaderMatchers(ctx, []*matchers.HeaderMatcher{filter.GetHeader()})[0]
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+			HeaderFilter: &envoyal.HeaderFilter{
+				Header: matcher,
+			},
+		},
+	}
+}
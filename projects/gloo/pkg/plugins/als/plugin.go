@@ -1,15 +1,23 @@
 package als
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	envoygrpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoyotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_req_without_query "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/req_without_query/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	otlpcommon "go.opentelemetry.io/proto/otlp/common/v1"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -25,6 +33,9 @@ var (
 const (
 	ExtensionName = "als"
 	ClusterName   = "access_log_cluster"
+
+	// OpenTelemetryAccessLog is the name of envoy's OpenTelemetry access logger, which has no well known name yet
+	OpenTelemetryAccessLog = "envoy.access_loggers.open_telemetry"
 )
 
 type plugin struct{}
@@ -52,7 +63,7 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, parentListener *
 	}
 
 	var err error
-	out.AccessLog, err = ProcessAccessLogPlugins(alsSettings, out.GetAccessLog())
+	out.AccessLog, err = ProcessAccessLogPlugins(params, alsSettings, out.GetAccessLog())
 	return err
 }
 
@@ -61,31 +72,53 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, parentListener *
 // fine grained configuration of the HCM across multiple plugins. However, the TCP proxy is still configured
 // by the TCP plugin only. To keep our access logging translation in a single place, we expose this function
 // and the Tcp plugin calls out to it.
-func ProcessAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal.AccessLog) ([]*envoyal.AccessLog, error) {
+func ProcessAccessLogPlugins(params plugins.Params, service *als.AccessLoggingService, logCfg []*envoyal.AccessLog) ([]*envoyal.AccessLog, error) {
 	results := make([]*envoyal.AccessLog, 0, len(service.GetAccessLog()))
 	for _, al := range service.GetAccessLog() {
+		var newAlsCfg envoyal.AccessLog
 		switch cfgType := al.GetOutputDestination().(type) {
 		case *als.AccessLog_FileSink:
 			var cfg envoyalfile.FileAccessLog
 			if err := copyFileSettings(&cfg, cfgType); err != nil {
 				return nil, err
 			}
-			newAlsCfg, err := translatorutil.NewAccessLogWithConfig(wellknown.FileAccessLog, &cfg)
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(wellknown.FileAccessLog, &cfg)
 			if err != nil {
 				return nil, err
 			}
-			results = append(results, &newAlsCfg)
 		case *als.AccessLog_GrpcService:
 			var cfg envoygrpc.HttpGrpcAccessLogConfig
 			if err := copyGrpcSettings(&cfg, cfgType); err != nil {
 				return nil, err
 			}
-			newAlsCfg, err := translatorutil.NewAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, &cfg)
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, &cfg)
+			if err != nil {
+				return nil, err
+			}
+		case *als.AccessLog_OpenTelemetryService:
+			var cfg envoyotel.OpenTelemetryAccessLogConfig
+			if err := copyOpenTelemetrySettings(params.Snapshot, &cfg, cfgType); err != nil {
+				return nil, err
+			}
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(OpenTelemetryAccessLog, &cfg)
 			if err != nil {
 				return nil, err
 			}
-			results = append(results, &newAlsCfg)
+		default:
+			continue
 		}
+
+		if al.GetFilter() != nil {
+			filter, err := translateFilter(params.Ctx, al.GetFilter())
+			if err != nil {
+				return nil, err
+			}
+			newAlsCfg.Filter = filter
+		}
+		results = append(results, &newAlsCfg)
 	}
 	logCfg = append(logCfg, results...)
 	return logCfg, nil
@@ -114,6 +147,121 @@ func copyGrpcSettings(cfg *envoygrpc.HttpGrpcAccessLogConfig, alsSettings *als.A
 	return cfg.Validate()
 }
 
+func copyOpenTelemetrySettings(snapshot *v1snap.ApiSnapshot, cfg *envoyotel.OpenTelemetryAccessLogConfig, alsSettings *als.AccessLog_OpenTelemetryService) error {
+	if alsSettings.OpenTelemetryService == nil {
+		return eris.New("open telemetry service object cannot be nil")
+	}
+
+	var clusterName string
+	switch collectorCluster := alsSettings.OpenTelemetryService.GetCollectorCluster().(type) {
+	case *als.OpenTelemetryService_CollectorUpstreamRef:
+		upstreamRef := collectorCluster.CollectorUpstreamRef
+		if snapshot == nil {
+			return eris.New("cannot resolve the collector upstream ref without a snapshot")
+		}
+		// make sure the upstream exists
+		if _, err := snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+			return eris.Errorf("invalid collector upstream ref (no upstream found for ref %v)", upstreamRef)
+		}
+		clusterName = translatorutil.UpstreamToClusterName(upstreamRef)
+	case *als.OpenTelemetryService_StaticClusterName:
+		clusterName = collectorCluster.StaticClusterName
+	default:
+		return eris.New("open telemetry service must specify a collector cluster")
+	}
+
+	cfg.CommonConfig = &envoygrpc.CommonGrpcAccessLogConfig{
+		LogName: alsSettings.OpenTelemetryService.GetLogName(),
+		GrpcService: &envoycore.GrpcService{
+			TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+					ClusterName: clusterName,
+				},
+			},
+		},
+		TransportApiVersion: envoycore.ApiVersion_V3,
+	}
+
+	var attributes []*otlpcommon.KeyValue
+	switch outputFormat := alsSettings.OpenTelemetryService.GetOutputFormat().(type) {
+	case *als.OpenTelemetryService_StringFormat:
+		if outputFormat.StringFormat != "" {
+			cfg.Body = &otlpcommon.AnyValue{
+				Value: &otlpcommon.AnyValue_StringValue{StringValue: outputFormat.StringFormat},
+			}
+		}
+	case *als.OpenTelemetryService_JsonFormat:
+		attributes = structToKeyValues(outputFormat.JsonFormat)
+	}
+
+	// the additional headers are logged as attributes, named according to the OpenTelemetry semantic conventions
+	for _, header := range alsSettings.OpenTelemetryService.GetAdditionalRequestHeadersToLog() {
+		attributes = append(attributes, headerAttribute("http.request.header.", "REQ", header))
+	}
+	for _, header := range alsSettings.OpenTelemetryService.GetAdditionalResponseHeadersToLog() {
+		attributes = append(attributes, headerAttribute("http.response.header.", "RESP", header))
+	}
+	for _, trailer := range alsSettings.OpenTelemetryService.GetAdditionalResponseTrailersToLog() {
+		attributes = append(attributes, headerAttribute("http.response.trailer.", "TRAILER", trailer))
+	}
+	if len(attributes) > 0 {
+		cfg.Attributes = &otlpcommon.KeyValueList{Values: attributes}
+	}
+
+	return cfg.Validate()
+}
+
+func headerAttribute(keyPrefix, commandOperator, header string) *otlpcommon.KeyValue {
+	return &otlpcommon.KeyValue{
+		Key: keyPrefix + strings.ToLower(header),
+		Value: &otlpcommon.AnyValue{
+			Value: &otlpcommon.AnyValue_StringValue{StringValue: fmt.Sprintf("%%%v(%v)%%", commandOperator, header)},
+		},
+	}
+}
+
+// structToKeyValues converts a format dictionary into OpenTelemetry attributes, whose string values envoy formats
+func structToKeyValues(in *structpb.Struct) []*otlpcommon.KeyValue {
+	var keys []string
+	for key := range in.GetFields() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var out []*otlpcommon.KeyValue
+	for _, key := range keys {
+		out = append(out, &otlpcommon.KeyValue{
+			Key:   key,
+			Value: valueToAnyValue(in.GetFields()[key]),
+		})
+	}
+	return out
+}
+
+func valueToAnyValue(in *structpb.Value) *otlpcommon.AnyValue {
+	switch value := in.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: value.StringValue}}
+	case *structpb.Value_NumberValue:
+		return &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_DoubleValue{DoubleValue: value.NumberValue}}
+	case *structpb.Value_BoolValue:
+		return &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_BoolValue{BoolValue: value.BoolValue}}
+	case *structpb.Value_StructValue:
+		return &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_KvlistValue{
+			KvlistValue: &otlpcommon.KeyValueList{Values: structToKeyValues(value.StructValue)},
+		}}
+	case *structpb.Value_ListValue:
+		var values []*otlpcommon.AnyValue
+		for _, item := range value.ListValue.GetValues() {
+			values = append(values, valueToAnyValue(item))
+		}
+		return &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_ArrayValue{
+			ArrayValue: &otlpcommon.ArrayValue{Values: values},
+		}}
+	}
+	return &otlpcommon.AnyValue{}
+}
+
 func copyFileSettings(cfg *envoyalfile.FileAccessLog, alsSettings *als.AccessLog_FileSink) error {
 	cfg.Path = alsSettings.FileSink.GetPath()
 
@@ -1,6 +1,8 @@
 package als_test
 
 import (
+	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -18,6 +20,10 @@ import (
 	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 
 	envoygrpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoyotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	envoytype_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	gloomatchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 )
 
 var _ = Describe("Plugin", func() {
@@ -64,7 +70,7 @@ var _ = Describe("Plugin", func() {
 			})
 
 			It("works", func() {
-				accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(accessLogConfigs).To(HaveLen(1))
@@ -125,7 +131,7 @@ var _ = Describe("Plugin", func() {
 				})
 
 				It("works", func() {
-					accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+					accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(accessLogConfigs).To(HaveLen(1))
@@ -160,7 +166,7 @@ var _ = Describe("Plugin", func() {
 				})
 
 				It("works", func() {
-					accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+					accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(accessLogConfigs).To(HaveLen(1))
@@ -179,6 +185,241 @@ var _ = Describe("Plugin", func() {
 
 		})
 
+		Context("open telemetry", func() {
+
+			var (
+				usRef  *core.ResourceRef
+				params plugins.Params
+			)
+
+			BeforeEach(func() {
+				usRef = &core.ResourceRef{
+					Name:      "otel-collector",
+					Namespace: "default",
+				}
+				params = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{
+						Upstreams: v1.UpstreamList{
+							{Metadata: &core.Metadata{Name: usRef.GetName(), Namespace: usRef.GetNamespace()}},
+						},
+					},
+				}
+				alsSettings = &als.AccessLoggingService{
+					AccessLog: []*als.AccessLog{
+						{
+							OutputDestination: &als.AccessLog_OpenTelemetryService{
+								OpenTelemetryService: &als.OpenTelemetryService{
+									LogName: "test",
+									CollectorCluster: &als.OpenTelemetryService_CollectorUpstreamRef{
+										CollectorUpstreamRef: usRef,
+									},
+									OutputFormat: &als.OpenTelemetryService_StringFormat{
+										StringFormat: "%REQ(:METHOD)%",
+									},
+									AdditionalRequestHeadersToLog:   []string{"X-Request-Id"},
+									AdditionalResponseHeadersToLog:  []string{"server"},
+									AdditionalResponseTrailersToLog: []string{"grpc-status"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			parseConfig := func(accessLogConfigs []*envoyal.AccessLog) *envoyotel.OpenTelemetryAccessLogConfig {
+				Expect(accessLogConfigs).To(HaveLen(1))
+				alConfig := accessLogConfigs[0]
+				Expect(alConfig.Name).To(Equal(OpenTelemetryAccessLog))
+				var otelCfg envoyotel.OpenTelemetryAccessLogConfig
+				err := translatorutil.ParseTypedConfig(alConfig, &otelCfg)
+				Expect(err).NotTo(HaveOccurred())
+				return &otelCfg
+			}
+
+			It("works", func() {
+				accessLogConfigs, err := ProcessAccessLogPlugins(params, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				otelCfg := parseConfig(accessLogConfigs)
+				Expect(otelCfg.GetCommonConfig().GetLogName()).To(Equal("test"))
+				Expect(otelCfg.GetCommonConfig().GetGrpcService().GetEnvoyGrpc().GetClusterName()).To(Equal(translatorutil.UpstreamToClusterName(usRef)))
+				Expect(otelCfg.GetBody().GetStringValue()).To(Equal("%REQ(:METHOD)%"))
+
+				attributes := map[string]string{}
+				for _, attribute := range otelCfg.GetAttributes().GetValues() {
+					attributes[attribute.GetKey()] = attribute.GetValue().GetStringValue()
+				}
+				Expect(attributes).To(Equal(map[string]string{
+					"http.request.header.x-request-id":  "%REQ(X-Request-Id)%",
+					"http.response.header.server":       "%RESP(server)%",
+					"http.response.trailer.grpc-status": "%TRAILER(grpc-status)%",
+				}))
+			})
+
+			It("formats json as attributes", func() {
+				otelService := alsSettings.GetAccessLog()[0].GetOpenTelemetryService()
+				otelService.AdditionalRequestHeadersToLog = nil
+				otelService.AdditionalResponseHeadersToLog = nil
+				otelService.AdditionalResponseTrailersToLog = nil
+				otelService.OutputFormat = &als.OpenTelemetryService_JsonFormat{
+					JsonFormat: &structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"status": {Kind: &structpb.Value_StringValue{StringValue: "%RESPONSE_CODE%"}},
+							"upstream": {Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{
+								Fields: map[string]*structpb.Value{
+									"host": {Kind: &structpb.Value_StringValue{StringValue: "%UPSTREAM_HOST%"}},
+								},
+							}}},
+						},
+					},
+				}
+
+				accessLogConfigs, err := ProcessAccessLogPlugins(params, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				otelCfg := parseConfig(accessLogConfigs)
+				Expect(otelCfg.GetBody()).To(BeNil())
+				values := otelCfg.GetAttributes().GetValues()
+				Expect(values).To(HaveLen(2))
+				Expect(values[0].GetKey()).To(Equal("status"))
+				Expect(values[0].GetValue().GetStringValue()).To(Equal("%RESPONSE_CODE%"))
+				Expect(values[1].GetKey()).To(Equal("upstream"))
+				nested := values[1].GetValue().GetKvlistValue().GetValues()
+				Expect(nested).To(HaveLen(1))
+				Expect(nested[0].GetKey()).To(Equal("host"))
+				Expect(nested[0].GetValue().GetStringValue()).To(Equal("%UPSTREAM_HOST%"))
+			})
+
+			It("errors when the collector upstream does not exist", func() {
+				params.Snapshot.Upstreams = nil
+
+				_, err := ProcessAccessLogPlugins(params, alsSettings, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("no upstream found"))
+			})
+
+		})
+
+		Context("filters", func() {
+
+			BeforeEach(func() {
+				alsSettings = &als.AccessLoggingService{
+					AccessLog: []*als.AccessLog{
+						{
+							OutputDestination: &als.AccessLog_FileSink{
+								FileSink: &als.FileSink{
+									Path: "/dev/stdout",
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("logs only errors or slow requests", func() {
+				alsSettings.GetAccessLog()[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_OrFilter{
+						OrFilter: &als.OrFilter{
+							Filters: []*als.SimpleAccessLogFilter{
+								{
+									FilterSpecifier: &als.SimpleAccessLogFilter_StatusCodeFilter{
+										StatusCodeFilter: &als.StatusCodeFilter{
+											Comparison: &als.ComparisonFilter{Op: als.ComparisonFilter_GE, Value: 500},
+										},
+									},
+								},
+								{
+									FilterSpecifier: &als.SimpleAccessLogFilter_DurationFilter{
+										DurationFilter: &als.DurationFilter{
+											Comparison: &als.ComparisonFilter{Op: als.ComparisonFilter_GE, Value: 1000, RuntimeKey: "slow_requests"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accessLogConfigs).To(HaveLen(1))
+				Expect(accessLogConfigs[0].GetFilter()).To(matchers.MatchProto(&envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+						OrFilter: &envoyal.OrFilter{
+							Filters: []*envoyal.AccessLogFilter{
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+										StatusCodeFilter: &envoyal.StatusCodeFilter{
+											Comparison: &envoyal.ComparisonFilter{
+												Op:    envoyal.ComparisonFilter_GE,
+												Value: &envoy_config_core_v3.RuntimeUInt32{DefaultValue: 500, RuntimeKey: "access_log.status_code_filter"},
+											},
+										},
+									},
+								},
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_DurationFilter{
+										DurationFilter: &envoyal.DurationFilter{
+											Comparison: &envoyal.ComparisonFilter{
+												Op:    envoyal.ComparisonFilter_GE,
+												Value: &envoy_config_core_v3.RuntimeUInt32{DefaultValue: 1000, RuntimeKey: "slow_requests"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}))
+			})
+
+			It("translates runtime and header filters", func() {
+				alsSettings.GetAccessLog()[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_AndFilter{
+						AndFilter: &als.AndFilter{
+							Filters: []*als.SimpleAccessLogFilter{
+								{
+									FilterSpecifier: &als.SimpleAccessLogFilter_RuntimeFilter{
+										RuntimeFilter: &als.RuntimeFilter{
+											PercentSampled: &envoytype_gloo.FractionalPercent{Numerator: 10},
+										},
+									},
+								},
+								{
+									FilterSpecifier: &als.SimpleAccessLogFilter_HeaderFilter{
+										HeaderFilter: &als.HeaderFilter{
+											Header: &gloomatchers.HeaderMatcher{Name: "x-debug"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+				filters := accessLogConfigs[0].GetFilter().GetAndFilter().GetFilters()
+				Expect(filters).To(HaveLen(2))
+				Expect(filters[0].GetRuntimeFilter().GetRuntimeKey()).To(Equal("access_log.runtime_filter"))
+				Expect(filters[0].GetRuntimeFilter().GetPercentSampled().GetNumerator()).To(Equal(uint32(10)))
+				Expect(filters[1].GetHeaderFilter().GetHeader().GetName()).To(Equal("x-debug"))
+				Expect(filters[1].GetHeaderFilter().GetHeader().GetPresentMatch()).To(BeTrue())
+			})
+
+			It("errors on invalid filters", func() {
+				alsSettings.GetAccessLog()[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_AndFilter{
+						AndFilter: &als.AndFilter{},
+					},
+				}
+
+				_, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).To(HaveOccurred())
+			})
+
+		})
+
 	})
 
 	Context("ProcessHcmNetworkFilter", func() {
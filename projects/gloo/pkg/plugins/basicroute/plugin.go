@@ -2,11 +2,9 @@ package basicroute
 
 import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
-	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -44,7 +42,7 @@ func (p *plugin) ProcessVirtualHost(
 	if in.GetOptions() == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params, in, out)
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -66,7 +64,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyTimeout(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyRetries(params, in, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(in, out); err != nil {
@@ -132,7 +130,7 @@ func applyTimeout(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
+func applyRetries(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	policy := in.GetOptions().GetRetries()
 	if policy == nil {
 		return nil
@@ -146,7 +144,11 @@ func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
 			"had nil route", in.GetAction())
 	}
 
-	routeAction.Route.RetryPolicy = convertPolicy(policy)
+	retryPolicy, err := convertPolicy(params.Ctx, policy)
+	if err != nil {
+		return err
+	}
+	routeAction.Route.RetryPolicy = retryPolicy
 	return nil
 }
 
@@ -212,24 +214,11 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.GetUpgradeConfigs())
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
-	out.RetryPolicy = convertPolicy(in.GetOptions().GetRetries())
-	return nil
-}
-
-func convertPolicy(policy *retries.RetryPolicy) *envoy_config_route_v3.RetryPolicy {
-	if policy == nil {
-		return nil
-	}
-
-	numRetries := policy.GetNumRetries()
-	if numRetries == 0 {
-		numRetries = 1
-	}
-
-	return &envoy_config_route_v3.RetryPolicy{
-		RetryOn:       policy.GetRetryOn(),
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: policy.GetPerTryTimeout(),
+func applyRetriesVhost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
+	retryPolicy, err := convertPolicy(params.Ctx, in.GetOptions().GetRetries())
+	if err != nil {
+		return err
 	}
+	out.RetryPolicy = retryPolicy
+	return nil
 }
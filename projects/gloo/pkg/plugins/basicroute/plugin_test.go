@@ -10,14 +10,20 @@ import (
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_omit_canary_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/omit_canary_hosts/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 )
 
@@ -315,6 +321,163 @@ var _ = Describe("retries", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
+
+	It("translates back off, retriable responses and host selection", func() {
+		retryPolicy.RetryOn = "5xx,retriable-status-codes,retriable-headers"
+		retryPolicy.RetryBackOff = &retries.RetryBackOff{
+			BaseInterval: prototime.DurationToProto(100 * time.Millisecond),
+			MaxInterval:  prototime.DurationToProto(time.Second),
+		}
+		retryPolicy.RateLimitedRetryBackOff = &retries.RateLimitedRetryBackOff{
+			ResetHeaders: []*retries.ResetHeader{
+				{Name: "Retry-After"},
+				{Name: "X-RateLimit-Reset", Format: retries.ResetHeader_UNIX_TIMESTAMP},
+			},
+			MaxInterval: prototime.DurationToProto(time.Minute),
+		}
+		retryPolicy.RetriableStatusCodes = []uint32{409, 429}
+		retryPolicy.RetriableHeaders = []*matchers.HeaderMatcher{{Name: "x-retry-me"}}
+		retryPolicy.RetriableRequestHeaders = []*matchers.HeaderMatcher{{Name: ":method", Value: "GET"}}
+		retryPolicy.RetryHostPredicates = []*retries.RetryHostPredicate{
+			{HostPredicate: &retries.RetryHostPredicate_PreviousHosts_{PreviousHosts: &retries.RetryHostPredicate_PreviousHosts{}}},
+			{HostPredicate: &retries.RetryHostPredicate_OmitCanaryHosts_{OmitCanaryHosts: &retries.RetryHostPredicate_OmitCanaryHosts{}}},
+		}
+		retryPolicy.HostSelectionRetryMaxAttempts = 3
+		retryPolicy.PreviousPriorities = &retries.PreviousPriorities{UpdateFrequency: 2}
+
+		previousHosts, err := utils.MessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{})
+		Expect(err).NotTo(HaveOccurred())
+		omitCanaryHosts, err := utils.MessageToAny(&envoy_omit_canary_hosts_v3.OmitCanaryHostsPredicate{})
+		Expect(err).NotTo(HaveOccurred())
+		previousPriorities, err := utils.MessageToAny(&envoy_previous_priorities_v3.PreviousPrioritiesConfig{UpdateFrequency: 2})
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedRetryPolicy.RetryOn = "5xx,retriable-status-codes,retriable-headers"
+		expectedRetryPolicy.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: prototime.DurationToProto(100 * time.Millisecond),
+			MaxInterval:  prototime.DurationToProto(time.Second),
+		}
+		expectedRetryPolicy.RateLimitedRetryBackOff = &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+			ResetHeaders: []*envoy_config_route_v3.RetryPolicy_ResetHeader{
+				{Name: "Retry-After", Format: envoy_config_route_v3.RetryPolicy_SECONDS},
+				{Name: "X-RateLimit-Reset", Format: envoy_config_route_v3.RetryPolicy_UNIX_TIMESTAMP},
+			},
+			MaxInterval: prototime.DurationToProto(time.Minute),
+		}
+		expectedRetryPolicy.RetriableStatusCodes = []uint32{409, 429}
+		expectedRetryPolicy.RetriableHeaders = []*envoy_config_route_v3.HeaderMatcher{{
+			Name:                 "x-retry-me",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+		}}
+		expectedRetryPolicy.RetriableRequestHeaders = []*envoy_config_route_v3.HeaderMatcher{{
+			Name:                 ":method",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "GET"},
+		}}
+		expectedRetryPolicy.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{
+			{
+				Name:       PreviousHostsPredicateName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{TypedConfig: previousHosts},
+			},
+			{
+				Name:       OmitCanaryHostsPredicateName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{TypedConfig: omitCanaryHosts},
+			},
+		}
+		expectedRetryPolicy.HostSelectionRetryMaxAttempts = 3
+		expectedRetryPolicy.RetryPriority = &envoy_config_route_v3.RetryPolicy_RetryPriority{
+			Name:       PreviousPrioritiesName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryPriority_TypedConfig{TypedConfig: previousPriorities},
+		}
+
+		plugin := NewPlugin()
+		routeAction := &envoy_config_route_v3.RouteAction{}
+		out := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err = plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Retries: retryPolicy,
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.RetryPolicy).To(Equal(expectedRetryPolicy))
+		Expect(routeAction.RetryPolicy.Validate()).To(Succeed())
+	})
+
+	DescribeTable("rejects invalid combinations",
+		func(modify func(policy *retries.RetryPolicy), expectedErr error) {
+			modify(retryPolicy)
+
+			plugin := NewPlugin()
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			}
+			err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Retries: retryPolicy,
+				},
+				Action: &v1.Route_RouteAction{},
+			}, out)
+			Expect(err).To(MatchError(expectedErr.Error()))
+
+			err = plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					Retries: retryPolicy,
+				},
+			}, &envoy_config_route_v3.VirtualHost{})
+			Expect(err).To(MatchError(expectedErr.Error()))
+		},
+		Entry("back off without a base interval", func(policy *retries.RetryPolicy) {
+			policy.RetryBackOff = &retries.RetryBackOff{MaxInterval: prototime.DurationToProto(time.Second)}
+		}, MissingBaseIntervalErr),
+		Entry("back off max interval less than the base interval", func(policy *retries.RetryPolicy) {
+			policy.RetryBackOff = &retries.RetryBackOff{
+				BaseInterval: prototime.DurationToProto(time.Second),
+				MaxInterval:  prototime.DurationToProto(time.Millisecond),
+			}
+		}, MaxIntervalLessThanBaseIntervalErr),
+		Entry("rate limited back off without reset headers", func(policy *retries.RetryPolicy) {
+			policy.RateLimitedRetryBackOff = &retries.RateLimitedRetryBackOff{}
+		}, MissingResetHeadersErr),
+		Entry("rate limited back off reset header without a name", func(policy *retries.RetryPolicy) {
+			policy.RateLimitedRetryBackOff = &retries.RateLimitedRetryBackOff{ResetHeaders: []*retries.ResetHeader{{}}}
+		}, MissingResetHeaderNameErr),
+		Entry("rate limited back off with a zero max interval", func(policy *retries.RetryPolicy) {
+			policy.RateLimitedRetryBackOff = &retries.RateLimitedRetryBackOff{
+				ResetHeaders: []*retries.ResetHeader{{Name: "Retry-After"}},
+				MaxInterval:  prototime.DurationToProto(0),
+			}
+		}, InvalidRateLimitedMaxIntervalErr),
+		Entry("retriable status codes without retriable-status-codes", func(policy *retries.RetryPolicy) {
+			policy.RetryOn = "5xx"
+			policy.RetriableStatusCodes = []uint32{429}
+		}, MissingRetryOnErr("retriableStatusCodes", RetriableStatusCodesRetryOn)),
+		Entry("invalid retriable status code", func(policy *retries.RetryPolicy) {
+			policy.RetryOn = "retriable-status-codes"
+			policy.RetriableStatusCodes = []uint32{42}
+		}, InvalidRetriableStatusCodeErr(42)),
+		Entry("retriable headers without retriable-headers", func(policy *retries.RetryPolicy) {
+			policy.RetryOn = "5xx, retriable-status-codes"
+			policy.RetriableHeaders = []*matchers.HeaderMatcher{{Name: "x-retry-me"}}
+		}, MissingRetryOnErr("retriableHeaders", RetriableHeadersRetryOn)),
+		Entry("host selection attempts without host predicates", func(policy *retries.RetryPolicy) {
+			policy.HostSelectionRetryMaxAttempts = 3
+		}, MissingHostPredicatesErr),
+		Entry("negative host selection attempts", func(policy *retries.RetryPolicy) {
+			policy.HostSelectionRetryMaxAttempts = -1
+		}, InvalidHostSelectionRetryMaxAttemptsErr),
+		Entry("empty host predicate", func(policy *retries.RetryPolicy) {
+			policy.RetryHostPredicates = []*retries.RetryHostPredicate{{}}
+		}, MissingHostPredicateErr),
+		Entry("previous priorities without an update frequency", func(policy *retries.RetryPolicy) {
+			policy.PreviousPriorities = &retries.PreviousPriorities{}
+		}, InvalidUpdateFrequencyErr),
+	)
 })
 
 var _ = Describe("host rewrite", func() {
@@ -0,0 +1,210 @@
+package basicroute
+
+import (
+	"context"
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_omit_canary_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/omit_canary_hosts/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	PreviousHostsPredicateName   = "envoy.retry_host_predicates.previous_hosts"
+	OmitCanaryHostsPredicateName = "envoy.retry_host_predicates.omit_canary_hosts"
+	PreviousPrioritiesName       = "envoy.retry_priorities.previous_priorities"
+
+	// the retry_on conditions which enable retriable_status_codes and retriable_headers
+	RetriableStatusCodesRetryOn = "retriable-status-codes"
+	RetriableHeadersRetryOn     = "retriable-headers"
+)
+
+var (
+	MissingBaseIntervalErr = errors.Errorf("retry back off must specify a base interval greater than zero")
+
+	MaxIntervalLessThanBaseIntervalErr = errors.Errorf("retry back off max interval must be greater than or equal to the base interval")
+
+	MissingResetHeadersErr = errors.Errorf("rate limited retry back off must specify at least one reset header")
+
+	MissingResetHeaderNameErr = errors.Errorf("rate limited retry back off reset headers must specify a name")
+
+	InvalidRateLimitedMaxIntervalErr = errors.Errorf("rate limited retry back off max interval must be greater than zero")
+
+	InvalidRetriableStatusCodeErr = func(code uint32) error {
+		return errors.Errorf("retriable status code %v is not an HTTP status code", code)
+	}
+
+	MissingRetryOnErr = func(field, retryOn string) error {
+		return errors.Errorf("%v requires retryOn to include %v", field, retryOn)
+	}
+
+	MissingHostPredicatesErr = errors.Errorf("hostSelectionRetryMaxAttempts requires retryHostPredicates")
+
+	InvalidHostSelectionRetryMaxAttemptsErr = errors.Errorf("hostSelectionRetryMaxAttempts must not be negative")
+
+	MissingHostPredicateErr = errors.Errorf("retry host predicates must specify a predicate")
+
+	InvalidUpdateFrequencyErr = errors.Errorf("previous priorities update frequency must be greater than zero")
+)
+
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if err := validatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	numRetries := policy.GetNumRetries()
+	if numRetries == 0 {
+		numRetries = 1
+	}
+
+	out := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:                       policy.GetRetryOn(),
+		NumRetries:                    &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout:                 policy.GetPerTryTimeout(),
+		RetriableStatusCodes:          policy.GetRetriableStatusCodes(),
+		RetriableHeaders:              utils.EnvoyHeaderMatchers(ctx, policy.GetRetriableHeaders()),
+		RetriableRequestHeaders:       utils.EnvoyHeaderMatchers(ctx, policy.GetRetriableRequestHeaders()),
+		HostSelectionRetryMaxAttempts: policy.GetHostSelectionRetryMaxAttempts(),
+	}
+
+	if backOff := policy.GetRetryBackOff(); backOff != nil {
+		out.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: backOff.GetBaseInterval(),
+			MaxInterval:  backOff.GetMaxInterval(),
+		}
+	}
+
+	if backOff := policy.GetRateLimitedRetryBackOff(); backOff != nil {
+		out.RateLimitedRetryBackOff = &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+			MaxInterval: backOff.GetMaxInterval(),
+		}
+		for _, header := range backOff.GetResetHeaders() {
+			out.GetRateLimitedRetryBackOff().ResetHeaders = append(out.GetRateLimitedRetryBackOff().GetResetHeaders(), &envoy_config_route_v3.RetryPolicy_ResetHeader{
+				Name:   header.GetName(),
+				Format: envoy_config_route_v3.RetryPolicy_ResetHeaderFormat(header.GetFormat()),
+			})
+		}
+	}
+
+	for _, predicate := range policy.GetRetryHostPredicates() {
+		hostPredicate, err := convertHostPredicate(predicate)
+		if err != nil {
+			return nil, err
+		}
+		out.RetryHostPredicate = append(out.GetRetryHostPredicate(), hostPredicate)
+	}
+
+	if priorities := policy.GetPreviousPriorities(); priorities != nil {
+		typedConfig, err := utils.MessageToAny(&envoy_previous_priorities_v3.PreviousPrioritiesConfig{
+			UpdateFrequency: int32(priorities.GetUpdateFrequency()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.RetryPriority = &envoy_config_route_v3.RetryPolicy_RetryPriority{
+			Name:       PreviousPrioritiesName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryPriority_TypedConfig{TypedConfig: typedConfig},
+		}
+	}
+
+	return out, nil
+}
+
+func convertHostPredicate(predicate *retries.RetryHostPredicate) (*envoy_config_route_v3.RetryPolicy_RetryHostPredicate, error) {
+	var out *envoy_config_route_v3.RetryPolicy_RetryHostPredicate
+	switch predicate.GetHostPredicate().(type) {
+	case *retries.RetryHostPredicate_PreviousHosts_:
+		typedConfig, err := utils.MessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{})
+		if err != nil {
+			return nil, err
+		}
+		out = &envoy_config_route_v3.RetryPolicy_RetryHostPredicate{
+			Name:       PreviousHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{TypedConfig: typedConfig},
+		}
+	case *retries.RetryHostPredicate_OmitCanaryHosts_:
+		typedConfig, err := utils.MessageToAny(&envoy_omit_canary_hosts_v3.OmitCanaryHostsPredicate{})
+		if err != nil {
+			return nil, err
+		}
+		out = &envoy_config_route_v3.RetryPolicy_RetryHostPredicate{
+			Name:       OmitCanaryHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{TypedConfig: typedConfig},
+		}
+	default:
+		return nil, MissingHostPredicateErr
+	}
+	return out, nil
+}
+
+// validates the combinations of fields which envoy would reject, or silently ignore
+func validatePolicy(policy *retries.RetryPolicy) error {
+	if backOff := policy.GetRetryBackOff(); backOff != nil {
+		baseInterval := backOff.GetBaseInterval().AsDuration()
+		if backOff.GetBaseInterval() == nil || baseInterval <= 0 {
+			return MissingBaseIntervalErr
+		}
+		if backOff.GetMaxInterval() != nil && backOff.GetMaxInterval().AsDuration() < baseInterval {
+			return MaxIntervalLessThanBaseIntervalErr
+		}
+	}
+
+	if backOff := policy.GetRateLimitedRetryBackOff(); backOff != nil {
+		if len(backOff.GetResetHeaders()) == 0 {
+			return MissingResetHeadersErr
+		}
+		for _, header := range backOff.GetResetHeaders() {
+			if header.GetName() == "" {
+				return MissingResetHeaderNameErr
+			}
+		}
+		if backOff.GetMaxInterval() != nil && backOff.GetMaxInterval().AsDuration() <= 0 {
+			return InvalidRateLimitedMaxIntervalErr
+		}
+	}
+
+	if len(policy.GetRetriableStatusCodes()) > 0 {
+		for _, code := range policy.GetRetriableStatusCodes() {
+			if code < 100 || code > 599 {
+				return InvalidRetriableStatusCodeErr(code)
+			}
+		}
+		if !retryOnIncludes(policy.GetRetryOn(), RetriableStatusCodesRetryOn) {
+			return MissingRetryOnErr("retriableStatusCodes", RetriableStatusCodesRetryOn)
+		}
+	}
+
+	if len(policy.GetRetriableHeaders()) > 0 && !retryOnIncludes(policy.GetRetryOn(), RetriableHeadersRetryOn) {
+		return MissingRetryOnErr("retriableHeaders", RetriableHeadersRetryOn)
+	}
+
+	if policy.GetHostSelectionRetryMaxAttempts() < 0 {
+		return InvalidHostSelectionRetryMaxAttemptsErr
+	}
+	if policy.GetHostSelectionRetryMaxAttempts() > 0 && len(policy.GetRetryHostPredicates()) == 0 {
+		return MissingHostPredicatesErr
+	}
+
+	if priorities := policy.GetPreviousPriorities(); priorities != nil && priorities.GetUpdateFrequency() == 0 {
+		return InvalidUpdateFrequencyErr
+	}
+	return nil
+}
+
+// retry_on is a comma-separated list of conditions
+func retryOnIncludes(retryOn, condition string) bool {
+	for _, c := range strings.Split(retryOn, ",") {
+		if strings.TrimSpace(c) == condition {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,225 @@
+package dns_srv
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// an in-process DNS server, which serves SRV and A/AAAA records over UDP and TCP on the same port
+type testDnsServer struct {
+	udp net.PacketConn
+	tcp net.Listener
+
+	lock sync.Mutex
+	// SRV records by name
+	srv map[string][]dnsmessage.SRVResource
+	// addresses by name
+	addresses map[string][]net.IP
+	ttl       uint32
+	// whether to include the addresses of the targets of SRV records in the additional section
+	additionals bool
+	// whether to respond to queries over UDP with truncated responses, so that they are retried over TCP
+	truncateUdp bool
+	queries     []string
+}
+
+func newTestDnsServer() *testDnsServer {
+	s := &testDnsServer{
+		srv:         map[string][]dnsmessage.SRVResource{},
+		addresses:   map[string][]net.IP{},
+		ttl:         60,
+		additionals: true,
+	}
+	// listen on the same port for UDP and TCP, retrying if the port is taken for TCP
+	var err error
+	for i := 0; i < 10; i++ {
+		s.udp, err = net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		s.tcp, err = net.Listen("tcp", s.udp.LocalAddr().String())
+		if err == nil {
+			break
+		}
+		s.udp.Close()
+	}
+	Expect(err).NotTo(HaveOccurred())
+
+	go s.serveUdp()
+	go s.serveTcp()
+	return s
+}
+
+func (s *testDnsServer) Address() string {
+	return s.udp.LocalAddr().String()
+}
+
+func (s *testDnsServer) Close() {
+	s.udp.Close()
+	s.tcp.Close()
+}
+
+func (s *testDnsServer) SetSrv(name string, records ...dnsmessage.SRVResource) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.srv[name] = records
+}
+
+func (s *testDnsServer) SetAddresses(name string, addresses ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.addresses[name] = nil
+	for _, address := range addresses {
+		s.addresses[name] = append(s.addresses[name], net.ParseIP(address))
+	}
+}
+
+func (s *testDnsServer) Configure(configure func(s *testDnsServer)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	configure(s)
+}
+
+func (s *testDnsServer) Queries() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.queries...)
+}
+
+func srvRecord(target string, port, priority, weight uint16) dnsmessage.SRVResource {
+	return dnsmessage.SRVResource{
+		Target:   dnsmessage.MustNewName(target),
+		Port:     port,
+		Priority: priority,
+		Weight:   weight,
+	}
+}
+
+func (s *testDnsServer) serveUdp() {
+	defer GinkgoRecover()
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		s.udp.WriteTo(s.respond(buf[:n], "udp"), addr)
+	}
+}
+
+func (s *testDnsServer) serveTcp() {
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer GinkgoRecover()
+			defer conn.Close()
+			var length uint16
+			if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+				return
+			}
+			query := make([]byte, length)
+			if _, err := io.ReadFull(conn, query); err != nil {
+				return
+			}
+			response := s.respond(query, "tcp")
+			conn.Write(append([]byte{byte(len(response) >> 8), byte(len(response))}, response...))
+		}()
+	}
+}
+
+func (s *testDnsServer) respond(packed []byte, network string) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var query dnsmessage.Message
+	if err := query.Unpack(packed); err != nil || len(query.Questions) != 1 {
+		return nil
+	}
+	question := query.Questions[0]
+	name := question.Name.String()
+	s.queries = append(s.queries, network+" "+question.Type.String()+" "+name)
+
+	header := dnsmessage.Header{ID: query.ID, Response: true, Authoritative: true}
+	if network == "udp" && s.truncateUdp {
+		header.Truncated = true
+		return s.build(header, question, nil)
+	}
+
+	switch question.Type {
+	case dnsmessage.TypeSRV:
+		records, ok := s.srv[name]
+		if !ok {
+			header.RCode = dnsmessage.RCodeNameError
+			return s.build(header, question, nil)
+		}
+		return s.build(header, question, func(b *dnsmessage.Builder) {
+			for _, record := range records {
+				Expect(b.SRVResource(s.header(name, dnsmessage.TypeSRV), record)).To(Succeed())
+			}
+			if !s.additionals {
+				return
+			}
+			Expect(b.StartAdditionals()).To(Succeed())
+			for _, record := range records {
+				s.addAddresses(b, record.Target.String(), dnsmessage.TypeA)
+				s.addAddresses(b, record.Target.String(), dnsmessage.TypeAAAA)
+			}
+		})
+	case dnsmessage.TypeA, dnsmessage.TypeAAAA:
+		if _, ok := s.addresses[name]; !ok {
+			header.RCode = dnsmessage.RCodeNameError
+			return s.build(header, question, nil)
+		}
+		return s.build(header, question, func(b *dnsmessage.Builder) {
+			s.addAddresses(b, name, question.Type)
+		})
+	}
+	header.RCode = dnsmessage.RCodeNotImplemented
+	return s.build(header, question, nil)
+}
+
+func (s *testDnsServer) header(name string, qtype dnsmessage.Type) dnsmessage.ResourceHeader {
+	return dnsmessage.ResourceHeader{
+		Name:  dnsmessage.MustNewName(name),
+		Type:  qtype,
+		Class: dnsmessage.ClassINET,
+		TTL:   s.ttl,
+	}
+}
+
+func (s *testDnsServer) addAddresses(b *dnsmessage.Builder, name string, qtype dnsmessage.Type) {
+	for _, address := range s.addresses[name] {
+		ipv4 := address.To4()
+		switch {
+		case qtype == dnsmessage.TypeA && ipv4 != nil:
+			var a [4]byte
+			copy(a[:], ipv4)
+			Expect(b.AResource(s.header(name, qtype), dnsmessage.AResource{A: a})).To(Succeed())
+		case qtype == dnsmessage.TypeAAAA && ipv4 == nil && strings.Contains(address.String(), ":"):
+			var aaaa [16]byte
+			copy(aaaa[:], address.To16())
+			Expect(b.AAAAResource(s.header(name, qtype), dnsmessage.AAAAResource{AAAA: aaaa})).To(Succeed())
+		}
+	}
+}
+
+func (s *testDnsServer) build(header dnsmessage.Header, question dnsmessage.Question, answers func(b *dnsmessage.Builder)) []byte {
+	b := dnsmessage.NewBuilder(nil, header)
+	Expect(b.StartQuestions()).To(Succeed())
+	Expect(b.Question(question)).To(Succeed())
+	Expect(b.StartAnswers()).To(Succeed())
+	if answers != nil {
+		answers(&b)
+	}
+	response, err := b.Finish()
+	Expect(err).NotTo(HaveOccurred())
+	return response
+}
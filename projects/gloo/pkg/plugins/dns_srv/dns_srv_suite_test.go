@@ -0,0 +1,15 @@
+package dns_srv
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/reporters"
+	"github.com/solo-io/go-utils/testutils"
+)
+
+func TestDnsSrv(t *testing.T) {
+	testutils.RegisterCommonFailHandlers()
+	junitReporter := reporters.NewJUnitReporter("junit.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "DNS SRV Suite", []Reporter{junitReporter})
+}
@@ -0,0 +1,252 @@
+package dns_srv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	DefaultPollInterval = 30 * time.Second
+	DefaultMinTtl       = 5 * time.Second
+)
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on DNS SRV")
+	var dnsSrvUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_DnsSrv); ok {
+			dnsSrvUpstreams = append(dnsSrvUpstreams, upstream)
+		}
+	}
+	return newEndpointsWatcher(opts.Ctx, writeNamespace, dnsSrvUpstreams, p.resolver).watch()
+}
+
+type edsWatcher struct {
+	upstreams      v1.UpstreamList
+	watchContext   context.Context
+	writeNamespace string
+	resolver       Resolver
+}
+
+func newEndpointsWatcher(watchCtx context.Context, writeNamespace string, upstreams v1.UpstreamList, resolver Resolver) *edsWatcher {
+	return &edsWatcher{
+		upstreams:      upstreams,
+		watchContext:   watchCtx,
+		writeNamespace: writeNamespace,
+		resolver:       resolver,
+	}
+}
+
+// the endpoints of an upstream from one resolution of its record
+type resolution struct {
+	upstream  string
+	endpoints v1.EndpointList
+	err       error
+}
+
+// each upstream is resolved on its own schedule. the endpoints of all upstreams are sent once each has been resolved,
+// and again whenever the endpoints of one of them change.
+func (c *edsWatcher) watch() (<-chan v1.EndpointList, <-chan error, error) {
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+	resolutions := make(chan *resolution)
+
+	for _, upstream := range c.upstreams {
+		go c.pollUpstream(upstream, resolutions)
+	}
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+
+		endpointsByUpstream := map[string]v1.EndpointList{}
+		resolved := map[string]bool{}
+		sent := false
+		send := func() {
+			var allEndpoints v1.EndpointList
+			for _, upstream := range c.upstreams {
+				allEndpoints = append(allEndpoints, endpointsByUpstream[upstream.GetMetadata().Ref().Key()]...)
+			}
+			select {
+			case <-c.watchContext.Done():
+			case endpointsChan <- allEndpoints:
+				sent = true
+			}
+		}
+
+		// without upstreams to resolve there are no endpoints, which is sent right away so that EDS becomes ready
+		if len(c.upstreams) == 0 {
+			send()
+		}
+
+		for {
+			select {
+			case <-c.watchContext.Done():
+				return
+			case res := <-resolutions:
+				resolved[res.upstream] = true
+				changed := false
+				if res.err != nil {
+					// keep the endpoints from the last successful resolution
+					select {
+					case <-c.watchContext.Done():
+						return
+					case errs <- res.err:
+					}
+				} else if !endpointsEqual(endpointsByUpstream[res.upstream], res.endpoints) {
+					endpointsByUpstream[res.upstream] = res.endpoints
+					changed = true
+				}
+				if len(resolved) == len(c.upstreams) && (changed || !sent) {
+					send()
+				}
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+func (c *edsWatcher) pollUpstream(upstream *v1.Upstream, resolutions chan<- *resolution) {
+	spec := upstream.GetDnsSrv()
+	for {
+		res := &resolution{upstream: upstream.GetMetadata().Ref().Key()}
+		next := pollInterval(spec)
+
+		srvResolution, err := c.resolver.ResolveSrv(c.watchContext, spec.GetResolverAddress(), spec.GetRecordName())
+		if err != nil {
+			res.err = err
+		} else {
+			res.endpoints = buildEndpoints(c.writeNamespace, upstream, srvResolution)
+			if spec.GetRespectTtl().GetValue() && srvResolution.Ttl < next {
+				next = srvResolution.Ttl
+				if minTtl := minTtl(spec); next < minTtl {
+					next = minTtl
+				}
+			}
+		}
+
+		select {
+		case <-c.watchContext.Done():
+			return
+		case resolutions <- res:
+		}
+
+		timer := time.NewTimer(next)
+		select {
+		case <-c.watchContext.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func pollInterval(spec *dns_srv.UpstreamSpec) time.Duration {
+	if interval := spec.GetPollInterval(); interval != nil && interval.AsDuration() > 0 {
+		return interval.AsDuration()
+	}
+	return DefaultPollInterval
+}
+
+func minTtl(spec *dns_srv.UpstreamSpec) time.Duration {
+	if ttl := spec.GetMinTtl(); ttl != nil && ttl.AsDuration() > 0 {
+		return ttl.AsDuration()
+	}
+	return DefaultMinTtl
+}
+
+// creates an endpoint for each address of each target of the record. SRV priorities are mapped onto consecutive
+// Envoy priorities, starting with the lowest SRV priority at 0, and SRV weights onto load balancing weights.
+func buildEndpoints(writeNamespace string, upstream *v1.Upstream, srvResolution *SrvResolution) v1.EndpointList {
+	targets := append([]*SrvTarget{}, srvResolution.Targets...)
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].Priority != targets[j].Priority {
+			return targets[i].Priority < targets[j].Priority
+		}
+		if targets[i].Target != targets[j].Target {
+			return targets[i].Target < targets[j].Target
+		}
+		return targets[i].Port < targets[j].Port
+	})
+
+	upstreamRef := upstream.GetMetadata().Ref()
+	var endpoints v1.EndpointList
+	names := map[string]bool{}
+	priority := uint32(0)
+	for i, target := range targets {
+		if i > 0 && target.Priority != targets[i-1].Priority {
+			priority++
+		}
+		// envoy weights must be at least 1
+		weight := uint32(target.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		addresses := append(target.Addresses[:0:0], target.Addresses...)
+		sort.Slice(addresses, func(i, j int) bool {
+			return addresses[i].String() < addresses[j].String()
+		})
+		for _, address := range addresses {
+			name := generateName(upstreamRef, address.String(), target.Port)
+			// the same address may be the target of several records
+			if names[name] {
+				continue
+			}
+			names[name] = true
+			endpoints = append(endpoints, &v1.Endpoint{
+				Metadata: &core.Metadata{
+					Name:      name,
+					Namespace: writeNamespace,
+				},
+				Upstreams:           []*core.ResourceRef{upstreamRef},
+				Address:             address.String(),
+				Port:                uint32(target.Port),
+				Hostname:            strings.TrimSuffix(target.Target, "."),
+				LoadBalancingWeight: &wrappers.UInt32Value{Value: weight},
+				Priority:            priority,
+			})
+		}
+	}
+	return endpoints
+}
+
+func endpointsEqual(a, b v1.EndpointList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+// ... also include a function to ensure that the endpoint name conforms to the spec (is unique, begins with expected prefix)
+const dnsSrvEndpointNamePrefix = "dns-srv"
+
+func generateName(upstreamRef *core.ResourceRef, address string, port uint16) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf(
+		"%v-name-%s-namespace-%s-%v-%v",
+		dnsSrvEndpointNamePrefix,
+		upstreamRef.GetName(),
+		upstreamRef.GetNamespace(),
+		address,
+		port,
+	))
+}
@@ -0,0 +1,153 @@
+package dns_srv
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("EDS", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		server   *testDnsServer
+		p        *plugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		server = newTestDnsServer()
+		p = NewPlugin()
+
+		server.SetSrv("_http._tcp.example.com.",
+			srvRecord("a.example.com.", 8080, 10, 60),
+			srvRecord("b.example.com.", 8080, 10, 0),
+			srvRecord("c.example.com.", 9090, 30, 5),
+		)
+		server.SetAddresses("a.example.com.", "10.0.0.1")
+		server.SetAddresses("b.example.com.", "10.0.0.2")
+		server.SetAddresses("c.example.com.", "10.0.0.3")
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "srv", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{
+					RecordName:      "_http._tcp.example.com",
+					ResolverAddress: server.Address(),
+					PollInterval:    &duration.Duration{Nanos: int32(50 * time.Millisecond)},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	watch := func(upstreams ...*v1.Upstream) <-chan v1.EndpointList {
+		endpoints, errs, err := p.WatchEndpoints("gloo-system", upstreams, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		go func() {
+			defer GinkgoRecover()
+			for range errs {
+			}
+		}()
+		return endpoints
+	}
+
+	endpoint := func(name, address string, port, weight, priority uint32, hostname string) *v1.Endpoint {
+		return &v1.Endpoint{
+			Metadata:            &core.Metadata{Name: name, Namespace: "gloo-system"},
+			Upstreams:           []*core.ResourceRef{upstream.GetMetadata().Ref()},
+			Address:             address,
+			Port:                port,
+			Hostname:            hostname,
+			LoadBalancingWeight: &wrappers.UInt32Value{Value: weight},
+			Priority:            priority,
+		}
+	}
+
+	It("creates endpoints for the targets of the record, with their weights and priorities", func() {
+		endpoints := watch(upstream)
+
+		var list v1.EndpointList
+		Eventually(endpoints, time.Second).Should(Receive(&list))
+		Expect(list).To(Equal(v1.EndpointList{
+			endpoint("dns-srv-name-srv-namespace-gloo-system-10-0-0-1-8080", "10.0.0.1", 8080, 60, 0, "a.example.com"),
+			endpoint("dns-srv-name-srv-namespace-gloo-system-10-0-0-2-8080", "10.0.0.2", 8080, 1, 0, "b.example.com"),
+			endpoint("dns-srv-name-srv-namespace-gloo-system-10-0-0-3-9090", "10.0.0.3", 9090, 5, 1, "c.example.com"),
+		}))
+	})
+
+	It("sends the endpoints again when the record changes", func() {
+		endpoints := watch(upstream)
+		Eventually(endpoints, time.Second).Should(Receive(HaveLen(3)))
+
+		server.SetSrv("_http._tcp.example.com.", srvRecord("c.example.com.", 9090, 30, 5))
+		var list v1.EndpointList
+		Eventually(endpoints, time.Second).Should(Receive(&list))
+		Expect(list).To(Equal(v1.EndpointList{
+			endpoint("dns-srv-name-srv-namespace-gloo-system-10-0-0-3-9090", "10.0.0.3", 9090, 5, 0, "c.example.com"),
+		}))
+
+		// the endpoints are only sent when they change
+		Consistently(endpoints, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("re-resolves the record when its TTL expires if respect_ttl is set", func() {
+		upstream.GetDnsSrv().PollInterval = &duration.Duration{Seconds: 3600}
+		upstream.GetDnsSrv().RespectTtl = &wrappers.BoolValue{Value: true}
+		upstream.GetDnsSrv().MinTtl = &duration.Duration{Nanos: int32(100 * time.Millisecond)}
+		server.Configure(func(s *testDnsServer) {
+			s.ttl = 1
+		})
+
+		endpoints := watch(upstream)
+		Eventually(endpoints, time.Second).Should(Receive(HaveLen(3)))
+		server.SetSrv("_http._tcp.example.com.", srvRecord("c.example.com.", 9090, 30, 5))
+		Eventually(endpoints, 3*time.Second).Should(Receive(HaveLen(1)))
+	})
+
+	It("ignores the TTL by default", func() {
+		upstream.GetDnsSrv().PollInterval = &duration.Duration{Seconds: 3600}
+		server.Configure(func(s *testDnsServer) {
+			s.ttl = 1
+		})
+
+		endpoints := watch(upstream)
+		Eventually(endpoints, time.Second).Should(Receive(HaveLen(3)))
+		Consistently(server.Queries, 1500*time.Millisecond).Should(HaveLen(1))
+	})
+
+	It("keeps the endpoints of the last successful resolution when resolving fails", func() {
+		endpoints, errs, err := p.WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints, time.Second).Should(Receive(HaveLen(3)))
+
+		server.Configure(func(s *testDnsServer) {
+			delete(s.srv, "_http._tcp.example.com.")
+		})
+		Eventually(errs, time.Second).Should(Receive(MatchError(ContainSubstring("RCodeNameError"))))
+		Consistently(endpoints, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("sends no endpoints when there are no DNS SRV upstreams", func() {
+		endpoints := watch(&v1.Upstream{
+			Metadata:     &core.Metadata{Name: "static", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		})
+		Eventually(endpoints, time.Second).Should(Receive(BeEmpty()))
+	})
+})
@@ -0,0 +1,90 @@
+package dns_srv
+
+import (
+	"reflect"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+	_ discovery.DiscoveryPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "dns_srv"
+)
+
+/*
+Steps:
+- User creates a DNS SRV upstream
+  - names the SRV record which advertises the instances of the service
+- EDS periodically resolves the record, and the addresses of its targets
+- Gloo plugin creates an endpoint for each address of each target
+*/
+
+type plugin struct {
+	settings *v1.Settings
+	resolver Resolver
+}
+
+func NewPlugin() *plugin {
+	return &plugin{resolver: NewResolver()}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	return nil
+}
+
+// we do not need to update any fields, just check that the input is valid
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.GetUpstreamType().(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+	if !originalSpec.DnsSrv.Equal(desiredSpec.DnsSrv) {
+		return false, UpstreamDeltaError()
+	}
+	return false, nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	dnsSrvSpec, ok := in.GetUpstreamType().(*v1.Upstream_DnsSrv)
+	if !ok {
+		return nil
+	}
+	if dnsSrvSpec.DnsSrv.GetRecordName() == "" {
+		return MissingRecordNameError
+	}
+
+	// the endpoints of the cluster are the targets of the record, which EDS resolves
+	xds.SetEdsOnCluster(out, p.settings)
+	return nil
+}
+
+var (
+	MissingRecordNameError = eris.New("DNS SRV upstreams must specify a record name")
+
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_DnsSrv, got %v", reflect.TypeOf(upstream.GetUpstreamType()).Name())
+	}
+
+	UpstreamDeltaError = func() error {
+		return eris.New("expected no difference between *v1.Upstream_DnsSrv upstreams")
+	}
+)
@@ -0,0 +1,55 @@
+package dns_srv
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p   *plugin
+		out *envoy_config_cluster_v3.Cluster
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		Expect(p.Init(plugins.InitParams{Settings: &v1.Settings{}})).To(Succeed())
+		out = &envoy_config_cluster_v3.Cluster{}
+	})
+
+	It("configures the cluster to use EDS", func() {
+		upstream := &v1.Upstream{
+			Metadata: &core.Metadata{Name: "srv", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{RecordName: "_http._tcp.example.com"},
+			},
+		}
+		Expect(p.ProcessUpstream(plugins.Params{}, upstream, out)).To(Succeed())
+		Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+		Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+	})
+
+	It("requires a record name", func() {
+		upstream := &v1.Upstream{
+			Metadata:     &core.Metadata{Name: "srv", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_DnsSrv{DnsSrv: &dns_srv.UpstreamSpec{}},
+		}
+		Expect(p.ProcessUpstream(plugins.Params{}, upstream, out)).To(MatchError(MissingRecordNameError))
+	})
+
+	It("ignores other upstreams", func() {
+		upstream := &v1.Upstream{
+			Metadata:     &core.Metadata{Name: "static", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		}
+		Expect(p.ProcessUpstream(plugins.Params{}, upstream, out)).To(Succeed())
+		Expect(out.GetEdsClusterConfig()).To(BeNil())
+	})
+})
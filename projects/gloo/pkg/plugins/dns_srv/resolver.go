@@ -0,0 +1,276 @@
+package dns_srv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	defaultResolverAddress = "127.0.0.1:53"
+	resolvConfPath         = "/etc/resolv.conf"
+	queryTimeout           = 5 * time.Second
+	// the largest UDP response we accept, responses which do not fit are retried over TCP
+	maxUdpResponseSize = 4096
+)
+
+// SrvTarget is a target of an SRV record, with the addresses its name resolved to.
+type SrvTarget struct {
+	Target    string
+	Port      uint16
+	Priority  uint16
+	Weight    uint16
+	Addresses []net.IP
+}
+
+// SrvResolution is the result of resolving an SRV record.
+type SrvResolution struct {
+	Targets []*SrvTarget
+	// the shortest TTL of the records the resolution used
+	Ttl time.Duration
+}
+
+// Resolver resolves SRV records, and the addresses of their targets, with a DNS server.
+type Resolver interface {
+	ResolveSrv(ctx context.Context, resolverAddress, recordName string) (*SrvResolution, error)
+}
+
+var (
+	ResolveErr = func(err error, recordName, resolverAddress string) error {
+		return eris.Wrapf(err, "resolving %v with %v", recordName, resolverAddress)
+	}
+
+	ResponseCodeErr = func(rcode dnsmessage.RCode) error {
+		return eris.Errorf("DNS server responded with %v", rcode)
+	}
+
+	ResponseIdErr = eris.New("DNS server responded with the wrong message id")
+)
+
+func NewResolver() Resolver {
+	return &dnsResolver{}
+}
+
+type dnsResolver struct{}
+
+func (r *dnsResolver) ResolveSrv(ctx context.Context, resolverAddress, recordName string) (*SrvResolution, error) {
+	resolverAddress = ResolverAddress(resolverAddress)
+	resolution, err := r.resolveSrv(ctx, resolverAddress, recordName)
+	if err != nil {
+		return nil, ResolveErr(err, recordName, resolverAddress)
+	}
+	return resolution, nil
+}
+
+func (r *dnsResolver) resolveSrv(ctx context.Context, resolverAddress, recordName string) (*SrvResolution, error) {
+	response, err := exchange(ctx, resolverAddress, recordName, dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := &SrvResolution{}
+	updateTtl := func(header dnsmessage.ResourceHeader) {
+		ttl := time.Duration(header.TTL) * time.Second
+		if resolution.Ttl == 0 || ttl < resolution.Ttl {
+			resolution.Ttl = ttl
+		}
+	}
+
+	for _, answer := range response.Answers {
+		srv, ok := answer.Body.(*dnsmessage.SRVResource)
+		// a target of "." means that the service is not available at the domain
+		if !ok || srv.Target.String() == "." {
+			continue
+		}
+		updateTtl(answer.Header)
+		resolution.Targets = append(resolution.Targets, &SrvTarget{
+			Target:   srv.Target.String(),
+			Port:     srv.Port,
+			Priority: srv.Priority,
+			Weight:   srv.Weight,
+		})
+	}
+
+	// servers usually include the addresses of the targets, so that they do not need to be resolved separately
+	additional := map[string][]net.IP{}
+	for _, resource := range response.Additionals {
+		switch body := resource.Body.(type) {
+		case *dnsmessage.AResource:
+			additional[resource.Header.Name.String()] = append(additional[resource.Header.Name.String()], body.A[:])
+		case *dnsmessage.AAAAResource:
+			additional[resource.Header.Name.String()] = append(additional[resource.Header.Name.String()], body.AAAA[:])
+		default:
+			continue
+		}
+		updateTtl(resource.Header)
+	}
+
+	for _, target := range resolution.Targets {
+		if addresses, ok := additional[target.Target]; ok {
+			target.Addresses = addresses
+			continue
+		}
+		for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+			response, err := exchange(ctx, resolverAddress, target.Target, qtype)
+			if err != nil {
+				return nil, err
+			}
+			for _, answer := range response.Answers {
+				switch body := answer.Body.(type) {
+				case *dnsmessage.AResource:
+					target.Addresses = append(target.Addresses, body.A[:])
+				case *dnsmessage.AAAAResource:
+					target.Addresses = append(target.Addresses, body.AAAA[:])
+				default:
+					continue
+				}
+				updateTtl(answer.Header)
+			}
+		}
+	}
+	return resolution, nil
+}
+
+// sends a query to the DNS server over UDP, and retries it over TCP if the response is truncated
+func exchange(ctx context.Context, resolverAddress, name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	queryName, err := dnsmessage.NewName(name)
+	if err != nil {
+		return nil, err
+	}
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: uint16(rand.Uint32()), RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  queryName,
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := exchangeUdp(ctx, resolverAddress, packed)
+	if err != nil {
+		return nil, err
+	}
+	if response.Truncated {
+		response, err = exchangeTcp(ctx, resolverAddress, packed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if response.ID != query.ID {
+		return nil, ResponseIdErr
+	}
+	// a name without addresses of the queried type is not an error
+	if response.RCode != dnsmessage.RCodeSuccess && !(response.RCode == dnsmessage.RCodeNameError && qtype != dnsmessage.TypeSRV) {
+		return nil, ResponseCodeErr(response.RCode)
+	}
+	return response, nil
+}
+
+func exchangeUdp(ctx context.Context, resolverAddress string, query []byte) (*dnsmessage.Message, error) {
+	conn, err := dial(ctx, "udp", resolverAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, maxUdpResponseSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return parse(buf[:n])
+}
+
+func exchangeTcp(ctx context.Context, resolverAddress string, query []byte) (*dnsmessage.Message, error) {
+	conn, err := dial(ctx, "tcp", resolverAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// messages sent over TCP are prefixed with their length
+	if _, err := conn.Write(append([]byte{byte(len(query) >> 8), byte(len(query))}, query...)); err != nil {
+		return nil, err
+	}
+	var length uint16
+	if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	return parse(buf)
+}
+
+func dial(ctx context.Context, network, address string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func parse(buf []byte) (*dnsmessage.Message, error) {
+	var message dnsmessage.Message
+	if err := message.Unpack(buf); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// ResolverAddress returns the address of the DNS server to query for the resolver address of an upstream, which
+// defaults to the first nameserver in /etc/resolv.conf, and port 53.
+func ResolverAddress(address string) string {
+	if address == "" {
+		address = defaultNameserver()
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return net.JoinHostPort(strings.Trim(address, "[]"), "53")
+	}
+	return address
+}
+
+func defaultNameserver() string {
+	file, err := os.Open(resolvConfPath)
+	if err != nil {
+		return defaultResolverAddress
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return fields[1]
+		}
+	}
+	return defaultResolverAddress
+}
@@ -0,0 +1,115 @@
+package dns_srv
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resolver", func() {
+
+	var (
+		ctx      context.Context
+		server   *testDnsServer
+		resolver Resolver
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newTestDnsServer()
+		resolver = NewResolver()
+
+		server.SetSrv("_http._tcp.example.com.",
+			srvRecord("a.example.com.", 8080, 10, 60),
+			srvRecord("b.example.com.", 8081, 20, 0),
+		)
+		server.SetAddresses("a.example.com.", "10.0.0.1", "10.0.0.2")
+		server.SetAddresses("b.example.com.", "10.0.0.3", "fd00::3")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	expectTargets := func(resolution *SrvResolution) {
+		Expect(resolution.Targets).To(ConsistOf(
+			&SrvTarget{
+				Target:    "a.example.com.",
+				Port:      8080,
+				Priority:  10,
+				Weight:    60,
+				Addresses: []net.IP{net.ParseIP("10.0.0.1").To4(), net.ParseIP("10.0.0.2").To4()},
+			},
+			&SrvTarget{
+				Target:    "b.example.com.",
+				Port:      8081,
+				Priority:  20,
+				Weight:    0,
+				Addresses: []net.IP{net.ParseIP("10.0.0.3").To4(), net.ParseIP("fd00::3")},
+			},
+		))
+	}
+
+	It("resolves the targets of the record with the addresses the server includes", func() {
+		resolution, err := resolver.ResolveSrv(ctx, server.Address(), "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		expectTargets(resolution)
+		Expect(resolution.Ttl).To(Equal(time.Minute))
+		Expect(server.Queries()).To(Equal([]string{"udp TypeSRV _http._tcp.example.com."}))
+	})
+
+	It("resolves the addresses of the targets when the server does not include them", func() {
+		server.Configure(func(s *testDnsServer) {
+			s.additionals = false
+		})
+		resolution, err := resolver.ResolveSrv(ctx, server.Address(), "_http._tcp.example.com.")
+		Expect(err).NotTo(HaveOccurred())
+		expectTargets(resolution)
+		Expect(server.Queries()).To(ConsistOf(
+			"udp TypeSRV _http._tcp.example.com.",
+			"udp TypeA a.example.com.",
+			"udp TypeAAAA a.example.com.",
+			"udp TypeA b.example.com.",
+			"udp TypeAAAA b.example.com.",
+		))
+	})
+
+	It("retries truncated responses over TCP", func() {
+		server.Configure(func(s *testDnsServer) {
+			s.truncateUdp = true
+		})
+		resolution, err := resolver.ResolveSrv(ctx, server.Address(), "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		expectTargets(resolution)
+		Expect(server.Queries()).To(Equal([]string{
+			"udp TypeSRV _http._tcp.example.com.",
+			"tcp TypeSRV _http._tcp.example.com.",
+		}))
+	})
+
+	It("returns the shortest TTL", func() {
+		server.Configure(func(s *testDnsServer) {
+			s.ttl = 7
+		})
+		resolution, err := resolver.ResolveSrv(ctx, server.Address(), "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Ttl).To(Equal(7 * time.Second))
+	})
+
+	It("errors when the record does not exist", func() {
+		_, err := resolver.ResolveSrv(ctx, server.Address(), "_missing._tcp.example.com")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("resolving _missing._tcp.example.com with " + server.Address()))
+		Expect(err.Error()).To(ContainSubstring("RCodeNameError"))
+	})
+
+	It("defaults the port of the resolver address", func() {
+		Expect(ResolverAddress("10.0.0.10")).To(Equal("10.0.0.10:53"))
+		Expect(ResolverAddress("fd00::10")).To(Equal("[fd00::10]:53"))
+		Expect(ResolverAddress("[fd00::10]:5353")).To(Equal("[fd00::10]:5353"))
+		Expect(ResolverAddress("dns.example.com:5353")).To(Equal("dns.example.com:5353"))
+	})
+})
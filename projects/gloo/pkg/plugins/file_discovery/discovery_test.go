@@ -0,0 +1,154 @@
+package file_discovery
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("Discovery", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		dir    string
+		p      *plugin
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		dir, err = ioutil.TempDir("", "file-discovery")
+		Expect(err).NotTo(HaveOccurred())
+		p = NewPlugin(&v1.Settings_DiscoveryOptions_FileOptions{
+			Directory: dir,
+			Debounce:  ptypes.DurationProto(50 * time.Millisecond),
+		})
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+	}
+
+	Context("DiscoverUpstreams", func() {
+
+		It("creates an upstream for each endpoint file", func() {
+			writeFile("petstore.yaml", "endpoints: [{address: 10.0.0.1, port: 8080}]")
+
+			upstreams, _, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var list v1.UpstreamList
+			Eventually(upstreams, time.Second).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetMetadata().GetName()).To(Equal("petstore"))
+			Expect(list[0].GetMetadata().GetNamespace()).To(Equal("gloo-system"))
+			Expect(list[0].GetFileDiscovery().GetServiceName()).To(Equal("petstore"))
+			Expect(list[0].GetMetadata().GetAnnotations()).To(BeEmpty())
+
+			By("adding an invalid file")
+			writeFile("reviews.yaml", "endpoints: [{address: reviews, port: 8080}]")
+			Eventually(upstreams, time.Second).Should(Receive(&list))
+			Expect(list).To(HaveLen(2))
+			Expect(list[1].GetMetadata().GetName()).To(Equal("reviews"))
+			Expect(list[1].GetMetadata().GetAnnotations()[ValidationErrorAnnotation]).To(ContainSubstring("is not an IP address"))
+
+			By("removing a file")
+			Expect(os.Remove(filepath.Join(dir, "petstore.yaml"))).To(Succeed())
+			Eventually(upstreams, time.Second).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetMetadata().GetName()).To(Equal("reviews"))
+		})
+
+		It("debounces changes", func() {
+			upstreams, _, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(upstreams, time.Second).Should(Receive(BeEmpty()))
+
+			for _, name := range []string{"a.yaml", "b.yaml", "c.yaml"} {
+				writeFile(name, "endpoints: []")
+			}
+			Eventually(upstreams, time.Second).Should(Receive(HaveLen(3)))
+			Consistently(upstreams, 200*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("creates the directory if it does not exist", func() {
+			p.directory = filepath.Join(dir, "missing")
+			upstreams, _, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(upstreams, time.Second).Should(Receive(BeEmpty()))
+			Expect(p.directory).To(BeADirectory())
+		})
+	})
+
+	Context("WatchEndpoints", func() {
+
+		var upstreams v1.UpstreamList
+
+		BeforeEach(func() {
+			upstreams = v1.UpstreamList{
+				upstreamForService("gloo-system", &service{name: "petstore"}),
+			}
+		})
+
+		It("creates endpoints from the file of each upstream", func() {
+			writeFile("petstore.yaml", `
+endpoints:
+- address: 10.0.0.1
+  port: 8080
+  hostname: petstore-1
+  labels: {version: v1}
+  weight: 3
+`)
+			endpoints, _, err := p.WatchEndpoints("gloo-system", upstreams, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var list v1.EndpointList
+			Eventually(endpoints, time.Second).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetMetadata().GetName()).To(Equal("file-name-petstore-namespace-gloo-system-10-0-0-1-8080"))
+			Expect(list[0].GetMetadata().GetLabels()).To(Equal(map[string]string{"version": "v1"}))
+			Expect(list[0].GetAddress()).To(Equal("10.0.0.1"))
+			Expect(list[0].GetPort()).To(BeEquivalentTo(8080))
+			Expect(list[0].GetHostname()).To(Equal("petstore-1"))
+			Expect(list[0].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(3))
+			Expect(list[0].GetUpstreams()).To(ConsistOf(upstreams[0].GetMetadata().Ref()))
+
+			By("updating the file")
+			writeFile("petstore.yaml", "endpoints: [{address: 10.0.0.2, port: 8080}, {address: 10.0.0.3, port: 8080}]")
+			Eventually(endpoints, time.Second).Should(Receive(&list))
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].GetAddress()).To(Equal("10.0.0.2"))
+			Expect(list[1].GetAddress()).To(Equal("10.0.0.3"))
+
+			By("making the file invalid, which keeps the last valid endpoints")
+			writeFile("petstore.yaml", "endpoints: [{address: 10.0.0.4}]")
+			Consistently(endpoints, 200*time.Millisecond).ShouldNot(Receive())
+
+			By("removing the file")
+			Expect(os.Remove(filepath.Join(dir, "petstore.yaml"))).To(Succeed())
+			Eventually(endpoints, time.Second).Should(Receive(BeEmpty()))
+		})
+
+		It("sends an empty list when there are no upstreams", func() {
+			writeFile("petstore.yaml", "endpoints: [{address: 10.0.0.1, port: 8080}]")
+			endpoints, _, err := p.WatchEndpoints("gloo-system", nil, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(endpoints, time.Second).Should(Receive(BeEmpty()))
+		})
+	})
+})
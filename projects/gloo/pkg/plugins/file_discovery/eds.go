@@ -0,0 +1,128 @@
+package file_discovery
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on file discovery")
+	var fileUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_FileDiscovery); ok {
+			fileUpstreams = append(fileUpstreams, upstream)
+		}
+	}
+
+	services, errs, err := p.watchServices(opts.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpointsChan := make(chan v1.EndpointList)
+	go func() {
+		defer close(endpointsChan)
+
+		// the endpoints of the last valid version of the file of each service
+		lastValid := map[string]v1.EndpointList{}
+		var previous v1.EndpointList
+		sent := false
+		for {
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case services, ok := <-services:
+				if !ok {
+					return
+				}
+				byName := map[string]*service{}
+				for _, svc := range services {
+					byName[svc.name] = svc
+				}
+
+				var allEndpoints v1.EndpointList
+				for _, upstream := range fileUpstreams {
+					name := upstream.GetFileDiscovery().GetServiceName()
+					svc, ok := byName[name]
+					switch {
+					case !ok:
+						delete(lastValid, name)
+					case svc.err == nil:
+						lastValid[name] = buildEndpoints(writeNamespace, upstream, svc)
+					}
+					allEndpoints = append(allEndpoints, lastValid[name]...)
+				}
+
+				if sent && endpointsEqual(previous, allEndpoints) {
+					continue
+				}
+				select {
+				case <-opts.Ctx.Done():
+					return
+				case endpointsChan <- allEndpoints:
+					previous, sent = allEndpoints, true
+				}
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+func buildEndpoints(writeNamespace string, upstream *v1.Upstream, svc *service) v1.EndpointList {
+	upstreamRef := upstream.GetMetadata().Ref()
+	var endpoints v1.EndpointList
+	for _, fileEndpoint := range svc.endpoints {
+		endpoint := &v1.Endpoint{
+			Metadata: &core.Metadata{
+				Name:      generateName(upstreamRef, fileEndpoint.Address, fileEndpoint.Port),
+				Namespace: writeNamespace,
+				Labels:    fileEndpoint.Labels,
+			},
+			Upstreams: []*core.ResourceRef{upstreamRef},
+			Address:   fileEndpoint.Address,
+			Port:      fileEndpoint.Port,
+			Hostname:  fileEndpoint.Hostname,
+		}
+		if fileEndpoint.Weight > 0 {
+			endpoint.LoadBalancingWeight = &wrappers.UInt32Value{Value: fileEndpoint.Weight}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+func endpointsEqual(a, b v1.EndpointList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+// ... also include a function to ensure that the endpoint name conforms to the spec (is unique, begins with expected prefix)
+const fileEndpointNamePrefix = "file"
+
+func generateName(upstreamRef *core.ResourceRef, address string, port uint32) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf(
+		"%v-name-%s-namespace-%s-%v-%v",
+		fileEndpointNamePrefix,
+		upstreamRef.GetName(),
+		upstreamRef.GetNamespace(),
+		address,
+		port,
+	))
+}
@@ -0,0 +1,15 @@
+package file_discovery
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/reporters"
+	"github.com/solo-io/go-utils/testutils"
+)
+
+func TestFileDiscovery(t *testing.T) {
+	testutils.RegisterCommonFailHandlers()
+	junitReporter := reporters.NewJUnitReporter("junit.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "File Discovery Suite", []Reporter{junitReporter})
+}
@@ -0,0 +1,119 @@
+package file_discovery
+
+import (
+	"reflect"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+	_ discovery.DiscoveryPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "file_discovery"
+
+	// set on discovered upstreams whose endpoint file is invalid, and reported on their status by gloo
+	ValidationErrorAnnotation = "gloo.solo.io/file_discovery_error"
+
+	DefaultDebounce = time.Second
+)
+
+/*
+Steps:
+- Deploy tooling writes an endpoint file for each service to the directory in the settings
+- Discovery creates an upstream for each file, named after the file
+- EDS creates an endpoint for each address in the file of an upstream
+- Both watch the directory, and update the upstreams and endpoints when the files change
+*/
+
+type plugin struct {
+	settings *v1.Settings
+
+	directory string
+	debounce  time.Duration
+}
+
+func NewPlugin(options *v1.Settings_DiscoveryOptions_FileOptions) *plugin {
+	debounce := DefaultDebounce
+	if d := options.GetDebounce(); d != nil && d.AsDuration() > 0 {
+		debounce = d.AsDuration()
+	}
+	return &plugin{
+		directory: options.GetDirectory(),
+		debounce:  debounce,
+	}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	return nil
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	if _, ok := original.GetUpstreamType().(*v1.Upstream_FileDiscovery); !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	if _, ok := desired.GetUpstreamType().(*v1.Upstream_FileDiscovery); !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+
+	utils.UpdateUpstream(original, desired)
+
+	// the validation error is on the metadata, which is otherwise not compared
+	if validationError(original) != validationError(desired) {
+		return true, nil
+	}
+	return !upstreamsEqual(original, desired), nil
+}
+
+// we want to know if the upstreams are equal apart from their Status and Metadata
+func upstreamsEqual(original, desired *v1.Upstream) bool {
+	copyOriginal := original.Clone().(*v1.Upstream)
+	copyOriginal.Metadata = desired.GetMetadata()
+	copyOriginal.SetNamespacedStatuses(desired.GetNamespacedStatuses())
+	return copyOriginal.Equal(desired)
+}
+
+func validationError(upstream *v1.Upstream) string {
+	return upstream.GetMetadata().GetAnnotations()[ValidationErrorAnnotation]
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	if _, ok := in.GetUpstreamType().(*v1.Upstream_FileDiscovery); !ok {
+		return nil
+	}
+
+	// configure the cluster to use EDS:ADS
+	xds.SetEdsOnCluster(out, p.settings)
+
+	// the cluster is kept with the endpoints of the last valid version of the file, so this is only a warning
+	if err := validationError(in); err != "" {
+		return InvalidEndpointFileWarning(err)
+	}
+	return nil
+}
+
+var (
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_FileDiscovery, got %v", reflect.TypeOf(upstream.GetUpstreamType()).Name())
+	}
+
+	InvalidEndpointFileWarning = func(err string) error {
+		return pluginutils.NewConfigurationWarning("%v; the endpoints of the last valid version of the file are used", err)
+	}
+)
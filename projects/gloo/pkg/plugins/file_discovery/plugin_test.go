@@ -0,0 +1,100 @@
+package file_discovery
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/file_discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p   *plugin
+		out *envoy_config_cluster_v3.Cluster
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin(&v1.Settings_DiscoveryOptions_FileOptions{Directory: "/endpoints"})
+		Expect(p.Init(plugins.InitParams{Settings: &v1.Settings{}})).To(Succeed())
+		out = &envoy_config_cluster_v3.Cluster{}
+	})
+
+	fileUpstream := func() *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_FileDiscovery{
+				FileDiscovery: &file_discovery.UpstreamSpec{ServiceName: "petstore"},
+			},
+		}
+	}
+
+	It("defaults the debounce", func() {
+		Expect(p.debounce).To(Equal(DefaultDebounce))
+	})
+
+	Context("ProcessUpstream", func() {
+
+		It("configures the cluster to use EDS", func() {
+			Expect(p.ProcessUpstream(plugins.Params{}, fileUpstream(), out)).To(Succeed())
+			Expect(out.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+		})
+
+		It("warns about the validation error of the endpoint file", func() {
+			upstream := fileUpstream()
+			upstream.GetMetadata().Annotations = map[string]string{ValidationErrorAnnotation: "invalid endpoint file petstore.yaml"}
+			err := p.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring("invalid endpoint file petstore.yaml")))
+			Expect(pluginutils.IsConfigurationWarning(err)).To(BeTrue())
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+		})
+
+		It("ignores other upstreams", func() {
+			upstream := &v1.Upstream{
+				Metadata:     &core.Metadata{Name: "static", Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+			}
+			Expect(p.ProcessUpstream(plugins.Params{}, upstream, out)).To(Succeed())
+			Expect(out.GetEdsClusterConfig()).To(BeNil())
+		})
+	})
+
+	Context("UpdateUpstream", func() {
+
+		It("keeps config set by the user", func() {
+			original := fileUpstream()
+			original.UseHttp2 = &wrappers.BoolValue{Value: true}
+			desired := fileUpstream()
+			updated, err := p.UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+			Expect(desired.GetUseHttp2().GetValue()).To(BeTrue())
+		})
+
+		It("updates the upstream when the validation error changes", func() {
+			original := fileUpstream()
+			desired := fileUpstream()
+			desired.GetMetadata().Annotations = map[string]string{ValidationErrorAnnotation: "invalid"}
+			updated, err := p.UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			updated, err = p.UpdateUpstream(desired, fileUpstream())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+		})
+
+		It("errors for other upstreams", func() {
+			other := &v1.Upstream{UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}}}
+			_, err := p.UpdateUpstream(other, fileUpstream())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package file_discovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"sigs.k8s.io/yaml"
+)
+
+// EndpointFile is the format of the endpoint file of a service, in YAML or JSON.
+type EndpointFile struct {
+	Endpoints []*FileEndpoint `json:"endpoints"`
+}
+
+// FileEndpoint is an address at which the service listens.
+type FileEndpoint struct {
+	// an IP address
+	Address string `json:"address"`
+	Port    uint32 `json:"port"`
+	// the hostname of the endpoint, such as for auto host rewrite
+	Hostname string `json:"hostname,omitempty"`
+	// labels for selecting the endpoint with subsets
+	Labels map[string]string `json:"labels,omitempty"`
+	// the load balancing weight of the endpoint, defaults to 1
+	Weight uint32 `json:"weight,omitempty"`
+}
+
+var fileExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// a service defined by an endpoint file
+type service struct {
+	name string
+	file string
+	// the endpoints in the file, nil if the file is invalid
+	endpoints []*FileEndpoint
+	// the reason the file is invalid
+	err error
+}
+
+var (
+	ReadDirectoryError = func(err error, dir string) error {
+		return eris.Wrapf(err, "reading endpoint files in %v", dir)
+	}
+
+	InvalidFileError = func(err error, file string) error {
+		return eris.Wrapf(err, "invalid endpoint file %v", file)
+	}
+
+	DuplicateServiceError = func(file, other string) error {
+		return eris.Errorf("the service of endpoint file %v is also defined by %v", file, other)
+	}
+
+	InvalidAddressError = func(i int, address string) error {
+		return eris.Errorf("endpoint %v: address %q is not an IP address", i, address)
+	}
+
+	InvalidPortError = func(i int, port uint32) error {
+		return eris.Errorf("endpoint %v: port %v is not between 1 and 65535", i, port)
+	}
+
+	DuplicateEndpointError = func(i int, address string, port uint32) error {
+		return eris.Errorf("endpoint %v: %v:%v is listed more than once", i, address, port)
+	}
+)
+
+// reads the services defined by the endpoint files in the directory, sorted by name. hidden files, such as those
+// kubernetes uses to update mounted ConfigMaps, and files without a YAML or JSON extension are ignored.
+func readServices(dir string) ([]*service, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, ReadDirectoryError(err, dir)
+	}
+
+	byName := map[string]*service{}
+	var services []*service
+	for _, file := range files {
+		ext := filepath.Ext(file.Name())
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || !fileExtensions[ext] {
+			continue
+		}
+		svc := readService(filepath.Join(dir, file.Name()))
+		svc.name = strings.TrimSuffix(file.Name(), ext)
+		if other, ok := byName[svc.name]; ok {
+			svc.endpoints, svc.err = nil, DuplicateServiceError(svc.file, other.file)
+			other.endpoints, other.err = nil, DuplicateServiceError(other.file, svc.file)
+			continue
+		}
+		byName[svc.name] = svc
+		services = append(services, svc)
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].name < services[j].name
+	})
+	return services, nil
+}
+
+func readService(file string) *service {
+	svc := &service{file: file}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		svc.err = InvalidFileError(err, file)
+		return svc
+	}
+	var endpointFile EndpointFile
+	if err := yaml.UnmarshalStrict(data, &endpointFile); err != nil {
+		svc.err = InvalidFileError(err, file)
+		return svc
+	}
+	if err := validateEndpoints(endpointFile.Endpoints); err != nil {
+		svc.err = InvalidFileError(err, file)
+		return svc
+	}
+	svc.endpoints = endpointFile.Endpoints
+	return svc
+}
+
+func validateEndpoints(endpoints []*FileEndpoint) error {
+	seen := map[string]bool{}
+	for i, endpoint := range endpoints {
+		if net.ParseIP(endpoint.Address) == nil {
+			return InvalidAddressError(i, endpoint.Address)
+		}
+		if endpoint.Port == 0 || endpoint.Port > 65535 {
+			return InvalidPortError(i, endpoint.Port)
+		}
+		key := fmt.Sprintf("%v:%v", endpoint.Address, endpoint.Port)
+		if seen[key] {
+			return DuplicateEndpointError(i, endpoint.Address, endpoint.Port)
+		}
+		seen[key] = true
+	}
+	return nil
+}
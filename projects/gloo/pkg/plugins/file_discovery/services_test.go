@@ -0,0 +1,100 @@
+package file_discovery
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Services", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-discovery")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+	}
+
+	It("reads YAML and JSON endpoint files", func() {
+		writeFile("petstore.yaml", `
+endpoints:
+- address: 10.0.0.1
+  port: 8080
+  hostname: petstore-1.example.com
+  labels:
+    version: v1
+  weight: 2
+- address: 10.0.0.2
+  port: 8080
+`)
+		writeFile("reviews.json", `{"endpoints": [{"address": "fd00::1", "port": 9080}]}`)
+		writeFile("README.md", "not an endpoint file")
+		writeFile(".hidden.yaml", "endpoints: [")
+		Expect(os.Mkdir(filepath.Join(dir, "nested.yaml"), 0755)).To(Succeed())
+
+		services, err := readServices(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(HaveLen(2))
+
+		Expect(services[0].name).To(Equal("petstore"))
+		Expect(services[0].err).NotTo(HaveOccurred())
+		Expect(services[0].endpoints).To(Equal([]*FileEndpoint{
+			{
+				Address:  "10.0.0.1",
+				Port:     8080,
+				Hostname: "petstore-1.example.com",
+				Labels:   map[string]string{"version": "v1"},
+				Weight:   2,
+			},
+			{Address: "10.0.0.2", Port: 8080},
+		}))
+
+		Expect(services[1].name).To(Equal("reviews"))
+		Expect(services[1].err).NotTo(HaveOccurred())
+		Expect(services[1].endpoints).To(Equal([]*FileEndpoint{{Address: "fd00::1", Port: 9080}}))
+	})
+
+	DescribeTable("invalid endpoint files",
+		func(content string, expectedError string) {
+			writeFile("petstore.yaml", content)
+			services, err := readServices(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(HaveLen(1))
+			Expect(services[0].endpoints).To(BeNil())
+			Expect(services[0].err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("malformed", "endpoints: [", "invalid endpoint file"),
+		Entry("unknown field", "endpoints: [{address: 10.0.0.1, port: 80, zone: a}]", `unknown field "zone"`),
+		Entry("hostname address", "endpoints: [{address: petstore.example.com, port: 80}]", `address "petstore.example.com" is not an IP address`),
+		Entry("missing port", "endpoints: [{address: 10.0.0.1}]", "port 0 is not between 1 and 65535"),
+		Entry("port out of range", "endpoints: [{address: 10.0.0.1, port: 70000}]", "port 70000 is not between 1 and 65535"),
+		Entry("duplicate endpoint", "endpoints: [{address: 10.0.0.1, port: 80}, {address: 10.0.0.1, port: 80}]", "endpoint 1: 10.0.0.1:80 is listed more than once"),
+	)
+
+	It("rejects services defined by more than one file", func() {
+		writeFile("petstore.yaml", "endpoints: [{address: 10.0.0.1, port: 80}]")
+		writeFile("petstore.json", `{"endpoints": [{"address": "10.0.0.2", "port": 80}]}`)
+		services, err := readServices(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(HaveLen(1))
+		Expect(services[0].err).To(MatchError(ContainSubstring("is also defined by")))
+	})
+
+	It("errors when the directory cannot be read", func() {
+		_, err := readServices(filepath.Join(dir, "missing"))
+		Expect(err).To(MatchError(ContainSubstring("reading endpoint files")))
+	})
+})
@@ -0,0 +1,71 @@
+package file_discovery
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/file_discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// DiscoverUpstreams creates an upstream for each endpoint file in the directory
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	services, errs, err := p.watchServices(opts.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	// need to do this because interface requires a bidirectional channel
+	ourErrs := make(chan error)
+	go errutils.AggregateErrs(opts.Ctx, ourErrs, errs, "file-uds")
+
+	go func() {
+		defer close(upstreamsChan)
+		for {
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case services, ok := <-services:
+				if !ok {
+					return
+				}
+				var upstreams v1.UpstreamList
+				for _, svc := range services {
+					upstreams = append(upstreams, upstreamForService(writeNamespace, svc))
+				}
+				select {
+				case <-opts.Ctx.Done():
+					return
+				case upstreamsChan <- upstreams:
+				}
+			}
+		}
+	}()
+	return upstreamsChan, ourErrs, nil
+}
+
+func upstreamForService(writeNamespace string, svc *service) *v1.Upstream {
+	upstream := &v1.Upstream{
+		Metadata: &core.Metadata{
+			Name:      UpstreamName(svc.name),
+			Namespace: writeNamespace,
+		},
+		UpstreamType: &v1.Upstream_FileDiscovery{
+			FileDiscovery: &file_discovery.UpstreamSpec{
+				ServiceName: svc.name,
+			},
+		},
+	}
+	if svc.err != nil {
+		upstream.GetMetadata().Annotations = map[string]string{ValidationErrorAnnotation: svc.err.Error()}
+	}
+	return upstream
+}
+
+// UpstreamName returns the name of the upstream for the service of an endpoint file.
+func UpstreamName(serviceName string) string {
+	return kubeutils.SanitizeNameV2(serviceName)
+}
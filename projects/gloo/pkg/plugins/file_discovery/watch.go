@@ -0,0 +1,75 @@
+package file_discovery
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// watchServices sends the services in the directory, and again each time the files in the directory change and have
+// then not changed for the debounce duration. the directory is created if it does not exist, like those of the
+// directory config source.
+func (p *plugin) watchServices(ctx context.Context) (<-chan []*service, <-chan error, error) {
+	if err := os.MkdirAll(p.directory, 0755); err != nil {
+		return nil, nil, ReadDirectoryError(err, p.directory)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := watcher.Add(p.directory); err != nil {
+		watcher.Close()
+		return nil, nil, ReadDirectoryError(err, p.directory)
+	}
+
+	servicesChan := make(chan []*service)
+	errs := make(chan error)
+	go func() {
+		defer watcher.Close()
+		defer close(servicesChan)
+		defer close(errs)
+
+		read := func() {
+			services, err := readServices(p.directory)
+			if err != nil {
+				select {
+				case <-ctx.Done():
+				case errs <- err:
+				}
+				return
+			}
+			select {
+			case <-ctx.Done():
+			case servicesChan <- services:
+			}
+		}
+
+		read()
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				contextutils.LoggerFrom(ctx).Debugw("endpoint file changed", zap.Any("event", event))
+				debounce = time.After(p.debounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				contextutils.LoggerFrom(ctx).Warnw("error watching endpoint files", zap.Error(err))
+			case <-debounce:
+				debounce = nil
+				read()
+			}
+		}
+	}()
+	return servicesChan, errs, nil
+}
@@ -6,11 +6,14 @@ import (
 	"time"
 
 	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +25,9 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister
+	// NodesLister() will return a non-null lister only if gloo is allowed to list nodes
+	NodesLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,32 +35,48 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister      map[string]kubelisters.EndpointsLister
+	endpointSlicesLister map[string]discoverylisters.EndpointSliceLister
+	nodesLister          kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+// getInformerFactory starts informers for either Endpoints or, if useEndpointSlices is set, EndpointSlices.
+// Nodes are cluster-scoped, so they are watched whichever namespaces are watched, as long as gloo may list them.
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, useEndpointSlices bool) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, useEndpointSlices)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, useEndpointSlices bool) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:      map[string]kubelisters.EndpointsLister{},
+		endpointSlicesLister: map[string]discoverylisters.EndpointSliceLister{},
+	}
+	if canListNodes(ctx, client) {
+		nodeInformer := kubeinformers.NewSharedInformerFactory(client, resyncDuration).Core().V1().Nodes()
+		informers = append(informers, nodeInformer.Informer())
+		k.nodesLister = nodeInformer.Lister()
 	}
 	for _, nsToWatch := range watchNamespaces {
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
+		if useEndpointSlices {
+			endpointSliceInformer := kubeInformerFactory.Discovery().V1().EndpointSlices()
+			informers = append(informers, endpointSliceInformer.Informer())
+			k.endpointSlicesLister[nsToWatch] = endpointSliceInformer.Lister()
+			continue
+		}
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
@@ -85,10 +107,28 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 	return k
 }
 
+// canListNodes returns whether gloo may list nodes, which namespaced installations usually may not.
+// Without nodes, the locality of endpoints is only known from EndpointSlices.
+func canListNodes(ctx context.Context, client kubernetes.Interface) bool {
+	if _, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("cannot list nodes, endpoint localities will not be read from node labels", zap.Error(err))
+		return false
+	}
+	return true
+}
+
 func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsLister {
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister {
+	return k.endpointSlicesLister[ns]
+}
+
+func (k *KubePluginListers) NodesLister() kubelisters.NodeLister {
+	return k.nodesLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -19,14 +19,15 @@ import (
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
-	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+	kubeFactory := func(namespaces []string, useEndpointSlices bool) KubePluginSharedFactory {
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, useEndpointSlices)
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -35,7 +36,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return watcher.watch(writeNamespace, opts)
 }
 
-func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePluginSharedFactory, kubeCoreCache corecache.KubeCoreCache, writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (*edsWatcher, error) {
+func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string, useEndpointSlices bool) KubePluginSharedFactory, kubeCoreCache corecache.KubeCoreCache, writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (*edsWatcher, error) {
 	var namespaces []string
 
 	settings := settingsutil.FromContext(opts.Ctx)
@@ -56,14 +57,18 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 		}
 	}
 
-	kubeFactory := kubeFactoryFactory(namespaces)
+	useEndpointSlices := settings.GetKubernetes().GetEndpointsSource() == v1.Settings_KubernetesConfiguration_ENDPOINT_SLICES
+
+	kubeFactory := kubeFactoryFactory(namespaces, useEndpointSlices)
 	// this can take a bit of time some make sure we are still in business
 	if opts.Ctx.Err() != nil {
 		return nil, opts.Ctx.Err()
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	watcher := newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack)
+	watcher.useEndpointSlices = useEndpointSlices
+	return watcher, nil
 }
 
 type edsWatcher struct {
@@ -72,6 +77,8 @@ type edsWatcher struct {
 	kubeCoreCache     corecache.KubeCoreCache
 	namespaces        []string
 	lastEndpointsHash uint64
+	// build endpoints from EndpointSlices rather than Endpoints
+	useEndpointSlices bool
 }
 
 func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
@@ -94,6 +101,7 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*discoveryv1.EndpointSlice
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -116,6 +124,14 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if c.useEndpointSlices {
+			endpointSlices, err := c.kubeShareFactory.EndpointSlicesLister(ns).List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, endpointSlices...)
+			continue
+		}
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
@@ -123,7 +139,23 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		endpointList = append(endpointList, endpoints...)
 	}
 
-	eps, warns, errsToLog := filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	// the locality of endpoints is only known if we can watch the nodes they run on
+	var localities map[string]*v1.Locality
+	if nodesLister := c.kubeShareFactory.NodesLister(); nodesLister != nil {
+		nodes, err := nodesLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		localities = nodeLocalities(nodes)
+	}
+
+	var eps v1.EndpointList
+	var warns, errsToLog []string
+	if c.useEndpointSlices {
+		eps, warns, errsToLog = filterEndpointSlices(ctx, writeNamespace, endpointSliceList, serviceList, podList, localities, c.upstreams)
+	} else {
+		eps, warns, errsToLog = filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, localities, c.upstreams)
+	}
 
 	warnsToLog = append(warnsToLog, warns...)
 
@@ -244,12 +276,14 @@ func filterEndpoints(
 	kubeEndpoints []*kubev1.Endpoints,
 	services []*kubev1.Service,
 	pods []*kubev1.Pod,
+	localities map[string]*v1.Locality,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
 ) (v1.EndpointList, []string, []string) {
 	var endpoints v1.EndpointList
 
 	var warnsToLog, errorsToLog []string
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsStatus := make(map[Epkey]*endpointStatus)
 
 	istioIntegrationEnabled := isIstioIntegrationEnabled()
 
@@ -278,52 +312,66 @@ func filterEndpoints(
 					copyRef := *usRef
 					endpointsMap[key] = append(endpointsMap[key], &copyRef)
 				} else {
-					warnings := processSubsetAddresses(subset, spec, pods, usRef, port, endpointsMap)
+					warnings := processSubsetAddresses(subset, spec, pods, localities, usRef, port, endpointsMap, endpointsStatus)
 					warnsToLog = append(warnsToLog, warnings...)
 				}
 			}
 		}
 	}
 
-	endpoints = generateFilteredEndpointList(endpointsMap, services, pods, writeNamespace, endpoints, istioIntegrationEnabled)
+	endpoints = generateFilteredEndpointList(endpointsMap, endpointsStatus, services, pods, writeNamespace, endpoints, istioIntegrationEnabled)
 
 	return endpoints, warnsToLog, errorsToLog
 }
 
-func processSubsetAddresses(subset kubev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, pods []*kubev1.Pod, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef) []string {
+func processSubsetAddresses(subset kubev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, pods []*kubev1.Pod, localities map[string]*v1.Locality, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef, endpointsStatus map[Epkey]*endpointStatus) []string {
 	var warnings []string
 	for _, addr := range subset.Addresses {
-		var podName, podNamespace string
-		targetRef := addr.TargetRef
-		if targetRef != nil {
-			if targetRef.Kind == "Pod" {
-				podName = targetRef.Name
-				podNamespace = targetRef.Namespace
-			}
+		key, warning := endpointKeyForAddress(addr.IP, addr.TargetRef, spec, pods, usRef, port)
+		if warning != "" {
+			warnings = append(warnings, warning)
+			continue
 		}
-		if len(spec.GetSelector()) != 0 {
-			// determine whether labels for the owner of this ip (pod) matches the spec
-			podLabels, err := getPodLabelsForIp(addr.IP, podName, podNamespace, pods)
-			if err != nil {
-				// pod not found for IP? what's that about?
-				warnings = append(warnings, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err))
-				continue
-			}
-			if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
-				continue
-			}
-			// pod hasn't been assigned address yet
-			if addr.IP == "" {
-				continue
-			}
+		if key == nil {
+			continue
 		}
-		key := Epkey{addr.IP, port, podName, podNamespace, usRef}
 		copyRef := *usRef
-		endpointsMap[key] = append(endpointsMap[key], &copyRef)
+		endpointsMap[*key] = append(endpointsMap[*key], &copyRef)
+		if locality := localityForNode(addr.NodeName, localities); locality != nil {
+			endpointsStatus[*key] = &endpointStatus{locality: locality}
+		}
 	}
 	return warnings
 }
 
+// endpointKeyForAddress returns the key for an endpoint address of the upstream, or nil if the pod at that
+// address is not selected by the upstream. If the pod cannot be determined, it returns a warning instead.
+func endpointKeyForAddress(ip string, targetRef *kubev1.ObjectReference, spec *kubeplugin.UpstreamSpec, pods []*kubev1.Pod, usRef *core.ResourceRef, port uint32) (*Epkey, string) {
+	var podName, podNamespace string
+	if targetRef != nil {
+		if targetRef.Kind == "Pod" {
+			podName = targetRef.Name
+			podNamespace = targetRef.Namespace
+		}
+	}
+	if len(spec.GetSelector()) != 0 {
+		// determine whether labels for the owner of this ip (pod) matches the spec
+		podLabels, err := getPodLabelsForIp(ip, podName, podNamespace, pods)
+		if err != nil {
+			// pod not found for IP? what's that about?
+			return nil, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err)
+		}
+		if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
+			return nil, ""
+		}
+		// pod hasn't been assigned address yet
+		if ip == "" {
+			return nil, ""
+		}
+	}
+	return &Epkey{ip, port, podName, podNamespace, usRef}, ""
+}
+
 func findFirstPortInEndpointSubsets(subset kubev1.EndpointSubset, singlePortService bool, kubeServicePort *kubev1.ServicePort) uint32 {
 	var port uint32
 	for _, p := range subset.Ports {
@@ -342,6 +390,7 @@ func findFirstPortInEndpointSubsets(subset kubev1.EndpointSubset, singlePortServ
 
 func generateFilteredEndpointList(
 	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsStatus map[Epkey]*endpointStatus,
 	services []*kubev1.Service,
 	pods []*kubev1.Pod,
 	writeNamespace string,
@@ -376,6 +425,10 @@ func generateFilteredEndpointList(
 				ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod.GetObjectMeta().GetLabels())
 			}
 		}
+		if status := endpointsStatus[addr]; status != nil {
+			ep.Locality = status.locality
+			ep.HealthStatus = status.healthStatus
+		}
 		endpoints = append(endpoints, ep)
 	}
 
@@ -396,8 +449,6 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
-
 	}
 	return ep
 }
@@ -13,6 +13,10 @@ import (
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Eds", func() {
@@ -46,8 +50,9 @@ var _ = Describe("Eds", func() {
 		upstreamsToTrack := v1.UpstreamList{up}
 
 		mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
+		mockSharedFactory.EXPECT().NodesLister().Return(nil)
 
-		watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+		watcher, err := newEndpointWatcherForUpstreams(func([]string, bool) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 		Expect(err).NotTo(HaveOccurred())
 		watcher.List("foo", clients.ListOpts{Ctx: ctx})
 		Expect(func() {}).NotTo(Panic())
@@ -72,4 +77,224 @@ var _ = Describe("Eds", func() {
 		})
 	})
 
+	Context("EndpointSlices", func() {
+
+		var (
+			upstreamRef *core.ResourceRef
+			upstreams   map[*core.ResourceRef]*kubev1.UpstreamSpec
+			services    []*corev1.Service
+		)
+
+		boolPtr := func(b bool) *bool { return &b }
+		stringPtr := func(s string) *string { return &s }
+		int32Ptr := func(i int32) *int32 { return &i }
+
+		sliceEndpoint := func(ip, zone string, ready, serving, terminating bool) discoveryv1.Endpoint {
+			return discoveryv1.Endpoint{
+				Addresses: []string{ip},
+				Conditions: discoveryv1.EndpointConditions{
+					Ready:       boolPtr(ready),
+					Serving:     boolPtr(serving),
+					Terminating: boolPtr(terminating),
+				},
+				Zone: stringPtr(zone),
+			}
+		}
+
+		endpointSlice := func(name string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo",
+					Name:      name,
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints:   endpoints,
+				Ports: []discoveryv1.EndpointPort{{
+					Name: stringPtr("http"),
+					Port: int32Ptr(8080),
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			upstreamRef = &core.ResourceRef{Name: "us", Namespace: "foo"}
+			upstreams = map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				upstreamRef: {
+					ServiceName:      "svc",
+					ServiceNamespace: "foo",
+					ServicePort:      80,
+				},
+			}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+				},
+			}}
+		})
+
+		It("uses the conditions and zone of each endpoint", func() {
+			slices := []*discoveryv1.EndpointSlice{
+				endpointSlice("svc-a",
+					sliceEndpoint("10.0.0.1", "zone-a", true, true, false),
+					// terminating but still serving
+					sliceEndpoint("10.0.0.2", "zone-a", false, true, true),
+				),
+				endpointSlice("svc-b",
+					sliceEndpoint("10.0.0.3", "zone-b", true, true, false),
+					// terminating and no longer serving
+					sliceEndpoint("10.0.0.4", "zone-b", false, false, true),
+					// not ready
+					sliceEndpoint("10.0.0.5", "zone-b", false, false, false),
+				),
+			}
+
+			endpoints, warnings, errs := filterEndpointSlices(ctx, "foo", slices, services, nil, nil, upstreams)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+			Expect(endpoints).To(HaveLen(3))
+
+			byAddress := map[string]*v1.Endpoint{}
+			for _, ep := range endpoints {
+				Expect(ep.GetPort()).To(Equal(uint32(8080)))
+				Expect(ep.GetUpstreams()).To(HaveLen(1))
+				Expect(ep.GetUpstreams()[0].Key()).To(Equal(upstreamRef.Key()))
+				byAddress[ep.GetAddress()] = ep
+			}
+			Expect(byAddress).To(HaveKey("10.0.0.1"))
+			Expect(byAddress["10.0.0.1"].GetHealthStatus()).To(Equal(v1.Endpoint_UNKNOWN))
+			Expect(byAddress["10.0.0.1"].GetLocality().GetZone()).To(Equal("zone-a"))
+			Expect(byAddress).To(HaveKey("10.0.0.2"))
+			Expect(byAddress["10.0.0.2"].GetHealthStatus()).To(Equal(v1.Endpoint_DRAINING))
+			Expect(byAddress).To(HaveKey("10.0.0.3"))
+			Expect(byAddress["10.0.0.3"].GetLocality().GetZone()).To(Equal("zone-b"))
+		})
+
+		It("treats unknown conditions as ready", func() {
+			endpoint := discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}
+			endpoints, _, _ := filterEndpointSlices(ctx, "foo", []*discoveryv1.EndpointSlice{endpointSlice("svc-a", endpoint)}, services, nil, nil, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetHealthStatus()).To(Equal(v1.Endpoint_UNKNOWN))
+			Expect(endpoints[0].GetLocality()).To(BeNil())
+		})
+
+		It("ignores slices of other services", func() {
+			slice := endpointSlice("other", sliceEndpoint("10.0.0.1", "zone-a", true, true, false))
+			slice.Labels[discoveryv1.LabelServiceName] = "other"
+			endpoints, _, _ := filterEndpointSlices(ctx, "foo", []*discoveryv1.EndpointSlice{slice}, services, nil, nil, upstreams)
+			Expect(endpoints).To(BeEmpty())
+		})
+
+		It("does not duplicate endpoints which appear in more than one slice", func() {
+			slices := []*discoveryv1.EndpointSlice{
+				endpointSlice("svc-a", sliceEndpoint("10.0.0.1", "zone-a", false, true, true)),
+				endpointSlice("svc-b", sliceEndpoint("10.0.0.1", "zone-a", true, true, false)),
+			}
+			endpoints, _, _ := filterEndpointSlices(ctx, "foo", slices, services, nil, nil, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetUpstreams()).To(HaveLen(1))
+			Expect(endpoints[0].GetHealthStatus()).To(Equal(v1.Endpoint_UNKNOWN))
+		})
+
+		It("does not duplicate pods of dual-stack services", func() {
+			podEndpoint := func(ip string, ready, terminating bool) discoveryv1.Endpoint {
+				endpoint := sliceEndpoint(ip, "zone-a", ready, true, terminating)
+				endpoint.TargetRef = &corev1.ObjectReference{Kind: "Pod", Namespace: "foo", Name: "pod"}
+				return endpoint
+			}
+			ipv6Slice := endpointSlice("svc-ipv6", podEndpoint("fd00::1", true, false))
+			ipv6Slice.AddressType = discoveryv1.AddressTypeIPv6
+			slices := []*discoveryv1.EndpointSlice{
+				ipv6Slice,
+				endpointSlice("svc-ipv4", podEndpoint("10.0.0.1", true, false)),
+			}
+			endpoints, _, _ := filterEndpointSlices(ctx, "foo", slices, services, nil, nil, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetAddress()).To(Equal("10.0.0.1"))
+
+			// the healthier address is preferred
+			slices[1] = endpointSlice("svc-ipv4", podEndpoint("10.0.0.1", false, true))
+			endpoints, _, _ = filterEndpointSlices(ctx, "foo", slices, services, nil, nil, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetAddress()).To(Equal("fd00::1"))
+			Expect(endpoints[0].GetHealthStatus()).To(Equal(v1.Endpoint_UNKNOWN))
+		})
+
+		It("prefers the locality of the node of an endpoint to its zone", func() {
+			endpoint := sliceEndpoint("10.0.0.1", "zone-a", true, true, false)
+			endpoint.NodeName = stringPtr("node-1")
+			localities := map[string]*v1.Locality{"node-1": {Region: "region-1", Zone: "zone-b"}}
+			endpoints, _, _ := filterEndpointSlices(ctx, "foo", []*discoveryv1.EndpointSlice{endpointSlice("svc-a", endpoint)}, services, nil, localities, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetLocality().GetRegion()).To(Equal("region-1"))
+			Expect(endpoints[0].GetLocality().GetZone()).To(Equal("zone-b"))
+		})
+	})
+
+	Context("Locality", func() {
+
+		stringPtr := func(s string) *string { return &s }
+
+		It("reads the locality of nodes from their topology labels", func() {
+			localities := nodeLocalities([]*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{
+					corev1.LabelTopologyRegion: "region-1",
+					corev1.LabelTopologyZone:   "zone-a",
+				}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{
+					corev1.LabelTopologyZone: "zone-b",
+				}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-3"}},
+			})
+			Expect(localities).To(HaveLen(2))
+			Expect(localities["node-1"].GetRegion()).To(Equal("region-1"))
+			Expect(localities["node-1"].GetZone()).To(Equal("zone-a"))
+			Expect(localities["node-2"].GetRegion()).To(BeEmpty())
+			Expect(localities["node-2"].GetZone()).To(Equal("zone-b"))
+		})
+
+		It("sets the locality of endpoints from the node they run on", func() {
+			upstreamRef := &core.ResourceRef{Name: "us", Namespace: "foo"}
+			upstreams := map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				upstreamRef: {
+					ServiceName:      "svc",
+					ServiceNamespace: "foo",
+					ServicePort:      80,
+				},
+			}
+			services := []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+				},
+			}}
+			kubeEndpoints := []*corev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+				Subsets: []corev1.EndpointSubset{{
+					Addresses: []corev1.EndpointAddress{
+						{IP: "10.0.0.1", NodeName: stringPtr("node-1")},
+						{IP: "10.0.0.2", NodeName: stringPtr("node-unknown")},
+						{IP: "10.0.0.3"},
+					},
+					Ports: []corev1.EndpointPort{{Name: "http", Port: 8080}},
+				}},
+			}}
+			localities := map[string]*v1.Locality{"node-1": {Region: "region-1", Zone: "zone-a"}}
+
+			endpoints, warnings, errs := filterEndpoints(ctx, "foo", kubeEndpoints, services, nil, localities, upstreams)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+			Expect(endpoints).To(HaveLen(3))
+
+			byAddress := map[string]*v1.Endpoint{}
+			for _, ep := range endpoints {
+				byAddress[ep.GetAddress()] = ep
+			}
+			Expect(byAddress["10.0.0.1"].GetLocality().GetRegion()).To(Equal("region-1"))
+			Expect(byAddress["10.0.0.1"].GetLocality().GetZone()).To(Equal("zone-a"))
+			Expect(byAddress["10.0.0.2"].GetLocality()).To(BeNil())
+			Expect(byAddress["10.0.0.3"].GetLocality()).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,182 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// podPortKey identifies the port of a pod targeted by an upstream, whatever the address it is reached at
+type podPortKey struct {
+	upstream  string
+	name      string
+	namespace string
+	port      uint32
+}
+
+// endpointStatus holds the state of an endpoint beyond its address, such as its locality and, when discovering
+// from EndpointSlices, its health
+type endpointStatus struct {
+	locality     *v1.Locality
+	healthStatus v1.Endpoint_HealthStatus
+}
+
+func filterEndpointSlices(
+	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
+	writeNamespace string,
+	endpointSlices []*discoveryv1.EndpointSlice,
+	services []*kubev1.Service,
+	pods []*kubev1.Pod,
+	localities map[string]*v1.Locality,
+	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+) (v1.EndpointList, []string, []string) {
+	var endpoints v1.EndpointList
+
+	var warnsToLog, errorsToLog []string
+	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsStatus := make(map[Epkey]*endpointStatus)
+	// the endpoint of each pod and port; dual-stack services have a slice per address family with the same pods
+	podEndpoints := make(map[podPortKey]Epkey)
+
+	// sort the slices so that the endpoints chosen among duplicates do not depend on the order of the slices,
+	// and IPv4 addresses are preferred to IPv6 addresses for pods which have both
+	endpointSlices = append([]*discoveryv1.EndpointSlice{}, endpointSlices...)
+	sort.SliceStable(endpointSlices, func(i, j int) bool {
+		if endpointSlices[i].AddressType != endpointSlices[j].AddressType {
+			return endpointSlices[i].AddressType < endpointSlices[j].AddressType
+		}
+		return endpointSlices[i].Name < endpointSlices[j].Name
+	})
+
+	istioIntegrationEnabled := isIstioIntegrationEnabled()
+
+	// for each upstream
+	for usRef, spec := range upstreams {
+		kubeServicePort, singlePortService := findPortForService(services, spec)
+		if kubeServicePort == nil {
+			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName()))
+			continue
+		}
+		// find each matching endpoint slice; a service may be backed by many slices
+		for _, slice := range endpointSlices {
+			if slice.Namespace != spec.GetServiceNamespace() || slice.Labels[discoveryv1.LabelServiceName] != spec.GetServiceName() {
+				continue
+			}
+			if slice.AddressType != discoveryv1.AddressTypeIPv4 && slice.AddressType != discoveryv1.AddressTypeIPv6 {
+				continue
+			}
+			port := findFirstPortInEndpointSlice(slice, singlePortService, kubeServicePort)
+			if port == 0 {
+				warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), slice.Name))
+				continue
+			}
+
+			if istioIntegrationEnabled {
+				hostname := fmt.Sprintf("%v.%v", spec.GetServiceName(), spec.GetServiceNamespace())
+				key := Epkey{hostname, port, spec.GetServiceName(), spec.GetServiceNamespace(), usRef}
+				if _, ok := endpointsMap[key]; !ok {
+					copyRef := *usRef
+					endpointsMap[key] = append(endpointsMap[key], &copyRef)
+				}
+				continue
+			}
+
+			for _, sliceEndpoint := range slice.Endpoints {
+				status, ok := statusForSliceEndpoint(sliceEndpoint)
+				if !ok || len(sliceEndpoint.Addresses) == 0 {
+					continue
+				}
+				// the labels of the node are authoritative, and also give the region of the endpoint
+				if locality := localityForNode(sliceEndpoint.NodeName, localities); locality != nil {
+					status.locality = locality
+				}
+				// all addresses of an endpoint are fungible, so only the first is used
+				key, warning := endpointKeyForAddress(sliceEndpoint.Addresses[0], sliceEndpoint.TargetRef, spec, pods, usRef, port)
+				if warning != "" {
+					warnsToLog = append(warnsToLog, warning)
+					continue
+				}
+				if key == nil {
+					continue
+				}
+				// the same endpoint may briefly appear in more than one slice; prefer the healthier one
+				if existing, ok := endpointsStatus[*key]; ok {
+					if existing.healthStatus == v1.Endpoint_DRAINING {
+						endpointsStatus[*key] = status
+					}
+					continue
+				}
+				// a pod may also appear with an address of each family; keep one endpoint per pod and port
+				if key.Name != "" {
+					podKey := podPortKey{upstream: usRef.Key(), name: key.Name, namespace: key.Namespace, port: port}
+					if existingKey, ok := podEndpoints[podKey]; ok {
+						if endpointsStatus[existingKey].healthStatus != v1.Endpoint_DRAINING || status.healthStatus == v1.Endpoint_DRAINING {
+							continue
+						}
+						delete(endpointsMap, existingKey)
+						delete(endpointsStatus, existingKey)
+					}
+					podEndpoints[podKey] = *key
+				}
+				copyRef := *usRef
+				endpointsMap[*key] = append(endpointsMap[*key], &copyRef)
+				endpointsStatus[*key] = status
+			}
+		}
+	}
+
+	endpoints = generateFilteredEndpointList(endpointsMap, endpointsStatus, services, pods, writeNamespace, endpoints, istioIntegrationEnabled)
+
+	return endpoints, warnsToLog, errorsToLog
+}
+
+// statusForSliceEndpoint returns the status of an endpoint in an EndpointSlice, and false if it should not receive traffic.
+// Endpoints which are terminating but still serving are kept as DRAINING, so that Envoy stops sending them new requests
+// without failing those already in flight.
+func statusForSliceEndpoint(endpoint discoveryv1.Endpoint) (*endpointStatus, bool) {
+	conditions := endpoint.Conditions
+	// a nil condition is unknown, and should be interpreted as ready
+	ready := conditions.Ready == nil || *conditions.Ready
+	serving := ready
+	if conditions.Serving != nil {
+		serving = *conditions.Serving
+	}
+	terminating := conditions.Terminating != nil && *conditions.Terminating
+
+	status := &endpointStatus{}
+	switch {
+	case ready && !terminating:
+	case serving && terminating:
+		status.healthStatus = v1.Endpoint_DRAINING
+	default:
+		return nil, false
+	}
+	if endpoint.Zone != nil && *endpoint.Zone != "" {
+		status.locality = &v1.Locality{Zone: *endpoint.Zone}
+	}
+	return status, true
+}
+
+func findFirstPortInEndpointSlice(slice *discoveryv1.EndpointSlice, singlePortService bool, kubeServicePort *kubev1.ServicePort) uint32 {
+	for _, p := range slice.Ports {
+		// a nil port indicates all ports, which we can't route to
+		if p.Port == nil {
+			continue
+		}
+		// if the endpoint port is not named, it implies that
+		// the kube service only has a single unnamed port as well.
+		if singlePortService {
+			return uint32(*p.Port)
+		}
+		if p.Name != nil && *p.Name == kubeServicePort.Name {
+			return uint32(*p.Port)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,39 @@
+package kubernetes
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+// nodeLocalities returns the locality of each node which carries the well-known topology labels, keyed by node name
+func nodeLocalities(nodes []*kubev1.Node) map[string]*v1.Locality {
+	localities := make(map[string]*v1.Locality)
+	for _, node := range nodes {
+		region := node.Labels[kubev1.LabelTopologyRegion]
+		zone := node.Labels[kubev1.LabelTopologyZone]
+		if region == "" && zone == "" {
+			continue
+		}
+		localities[node.Name] = &v1.Locality{
+			Region: region,
+			Zone:   zone,
+		}
+	}
+	return localities
+}
+
+// localityForNode returns the locality of the node an endpoint runs on, or nil if it is not known
+func localityForNode(nodeName *string, localities map[string]*v1.Locality) *v1.Locality {
+	if nodeName == nil {
+		return nil
+	}
+	locality, ok := localities[*nodeName]
+	if !ok {
+		return nil
+	}
+	// endpoints should not share their locality
+	return &v1.Locality{
+		Region: locality.GetRegion(),
+		Zone:   locality.GetZone(),
+	}
+}
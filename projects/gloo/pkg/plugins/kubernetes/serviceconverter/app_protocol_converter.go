@@ -0,0 +1,168 @@
+package serviceconverter
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+/*
+The AppProtocolConverter configures upstreams from the appProtocol of the service port:
+
+grpc              - HTTP/2, and a gRPC health check if the gloo.solo.io/app_protocol_health_check annotation is "true"
+http2             - HTTP/2
+kubernetes.io/h2c - HTTP/2 without TLS
+kubernetes.io/ws  - HTTP/1.1, which WebSocket upgrades require
+https             - TLS, with the SNI of the service's cluster DNS name
+
+It is applied before the annotation converters, so the gloo.solo.io/h2_service, gloo.solo.io/sslService.* and
+gloo.solo.io/upstream_config annotations take precedence over the appProtocol, which in turn takes precedence over
+the port name.
+*/
+
+// set on discovered upstreams to record the appProtocol they were configured from, so that the config can be removed
+// when the appProtocol of the service port changes
+const GlooAppProtocolAnnotation = "gloo.solo.io/discovered_app_protocol"
+
+// set to "true" on a service to add health checks to upstreams for the appProtocol of its ports
+const GlooAppProtocolHealthCheckAnnotation = "gloo.solo.io/app_protocol_health_check"
+
+const (
+	AppProtocolGrpc      = "grpc"
+	AppProtocolHttp2     = "http2"
+	AppProtocolH2c       = "kubernetes.io/h2c"
+	AppProtocolWebSocket = "kubernetes.io/ws"
+	AppProtocolHttps     = "https"
+)
+
+// configures the upstream from the appProtocol of the service port
+type AppProtocolConverter struct{}
+
+func (a *AppProtocolConverter) ConvertService(svc *kubev1.Service, port kubev1.ServicePort, us *v1.Upstream) error {
+	if port.AppProtocol == nil {
+		return nil
+	}
+	appProtocol := *port.AppProtocol
+	if !applyAppProtocol(appProtocol, svc, us) || us.GetMetadata() == nil {
+		return nil
+	}
+
+	if us.GetMetadata().GetAnnotations() == nil {
+		us.GetMetadata().Annotations = map[string]string{}
+	}
+	us.GetMetadata().GetAnnotations()[GlooAppProtocolAnnotation] = appProtocol
+	return nil
+}
+
+// sets the config for the appProtocol on the upstream, returns false if the appProtocol is not one gloo configures
+func applyAppProtocol(appProtocol string, svc *kubev1.Service, us *v1.Upstream) bool {
+	switch appProtocol {
+	case AppProtocolGrpc:
+		us.UseHttp2 = &wrappers.BoolValue{Value: true}
+		if svc.Annotations[GlooAppProtocolHealthCheckAnnotation] == "true" {
+			us.HealthChecks = []*envoycore.HealthCheck{{
+				Timeout:            &duration.Duration{Seconds: 5},
+				Interval:           &duration.Duration{Seconds: 10},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: 3},
+				HealthyThreshold:   &wrappers.UInt32Value{Value: 1},
+				HealthChecker: &envoycore.HealthCheck_GrpcHealthCheck_{
+					GrpcHealthCheck: &envoycore.HealthCheck_GrpcHealthCheck{},
+				},
+			}}
+		}
+	case AppProtocolHttp2, AppProtocolH2c:
+		us.UseHttp2 = &wrappers.BoolValue{Value: true}
+	case AppProtocolWebSocket:
+		us.UseHttp2 = &wrappers.BoolValue{Value: false}
+	case AppProtocolHttps:
+		us.SslConfig = &v1.UpstreamSslConfig{
+			Sni: fmt.Sprintf("%v.%v.svc", svc.Name, svc.Namespace),
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// WithoutAppProtocolConfig returns a copy of the discovered upstream without the config which was set from the
+// appProtocol recorded on it. Discovery keeps config which it does not set itself, so the config from the previous
+// appProtocol is removed before an upstream is updated for a service port whose appProtocol changed. Config which the
+// user has changed since is kept.
+func WithoutAppProtocolConfig(us *v1.Upstream) *v1.Upstream {
+	clone := us.Clone().(*v1.Upstream)
+	appProtocol, ok := us.GetMetadata().GetAnnotations()[GlooAppProtocolAnnotation]
+	if !ok {
+		return clone
+	}
+
+	fromAppProtocol := appProtocolConfig(appProtocol, us)
+	if fromAppProtocol.GetUseHttp2() != nil && proto.Equal(clone.GetUseHttp2(), fromAppProtocol.GetUseHttp2()) {
+		clone.UseHttp2 = nil
+	}
+	if fromAppProtocol.GetSslConfig() != nil && proto.Equal(clone.GetSslConfig(), fromAppProtocol.GetSslConfig()) {
+		clone.SslConfig = nil
+	}
+	if len(fromAppProtocol.GetHealthChecks()) > 0 && healthChecksEqual(clone.GetHealthChecks(), fromAppProtocol.GetHealthChecks()) {
+		clone.HealthChecks = nil
+	}
+	delete(clone.GetMetadata().GetAnnotations(), GlooAppProtocolAnnotation)
+	return clone
+}
+
+// KeepUserConfig sets the config which the appProtocol of the desired upstream set back to that of the previous
+// upstream, where the previous upstream sets it, so that the appProtocol only fills in config the user has not set.
+// Config which the annotations of the service set over that of the appProtocol is left as discovered.
+func KeepUserConfig(previous, desired *v1.Upstream) {
+	appProtocol := AppProtocol(desired)
+	if appProtocol == "" {
+		return
+	}
+
+	fromAppProtocol := appProtocolConfig(appProtocol, desired)
+	if previous.GetUseHttp2() != nil && fromAppProtocol.GetUseHttp2() != nil && proto.Equal(desired.GetUseHttp2(), fromAppProtocol.GetUseHttp2()) {
+		desired.UseHttp2 = previous.GetUseHttp2()
+	}
+	if previous.GetSslConfig() != nil && fromAppProtocol.GetSslConfig() != nil && proto.Equal(desired.GetSslConfig(), fromAppProtocol.GetSslConfig()) {
+		desired.SslConfig = previous.GetSslConfig()
+	}
+	if len(previous.GetHealthChecks()) > 0 && len(fromAppProtocol.GetHealthChecks()) > 0 && healthChecksEqual(desired.GetHealthChecks(), fromAppProtocol.GetHealthChecks()) {
+		desired.HealthChecks = previous.GetHealthChecks()
+	}
+}
+
+// the config the appProtocol sets on the discovered upstream, including the health checks which may be enabled
+func appProtocolConfig(appProtocol string, us *v1.Upstream) *v1.Upstream {
+	svc := &kubev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        us.GetKube().GetServiceName(),
+			Namespace:   us.GetKube().GetServiceNamespace(),
+			Annotations: map[string]string{GlooAppProtocolHealthCheckAnnotation: "true"},
+		},
+	}
+	fromAppProtocol := &v1.Upstream{}
+	applyAppProtocol(appProtocol, svc, fromAppProtocol)
+	return fromAppProtocol
+}
+
+func healthChecksEqual(a, b []*envoycore.HealthCheck) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// the appProtocol the discovered upstream was configured from, empty if none
+func AppProtocol(us *v1.Upstream) string {
+	return us.GetMetadata().GetAnnotations()[GlooAppProtocolAnnotation]
+}
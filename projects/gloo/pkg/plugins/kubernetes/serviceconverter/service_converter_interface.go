@@ -7,6 +7,8 @@ import (
 
 func init() {
 	DefaultServiceConverters = []ServiceConverter{
+		// The App Protocol Converter is applied first, so that the annotation converters take precedence over it
+		&AppProtocolConverter{},
 		&UseHttp2Converter{},
 		&UseSslConverter{},
 		// The General Service Converter is applied last, and is capable of overriding settings applied by prior converters
@@ -16,15 +16,18 @@ var http2PortNames = []string{
 	"http2",
 }
 
-// sets UseHttp2 on the upstream if the service has the relevant port name
+// sets UseHttp2 on the upstream if the service has the relevant annotation or port name.
+// the annotation overrides UseHttp2 set by prior converters, while the port name only applies if they did not set it.
 type UseHttp2Converter struct{}
 
 func (u *UseHttp2Converter) ConvertService(svc *kubev1.Service, port kubev1.ServicePort, us *v1.Upstream) error {
-	us.UseHttp2 = useHttp2(svc, port)
+	if h2 := useHttp2(svc, port, us.GetUseHttp2() == nil); h2 != nil {
+		us.UseHttp2 = h2
+	}
 	return nil
 }
 
-func useHttp2(svc *kubev1.Service, port kubev1.ServicePort) *wrappers.BoolValue {
+func useHttp2(svc *kubev1.Service, port kubev1.ServicePort, checkPortName bool) *wrappers.BoolValue {
 	if svc.Annotations != nil {
 		if svc.Annotations[GlooH2Annotation] == "true" {
 			return &wrappers.BoolValue{Value: true}
@@ -33,6 +36,9 @@ func useHttp2(svc *kubev1.Service, port kubev1.ServicePort) *wrappers.BoolValue
 		}
 	}
 
+	if !checkPortName {
+		return nil
+	}
 	for _, http2Name := range http2PortNames {
 		if strings.HasPrefix(port.Name, http2Name) {
 			return &wrappers.BoolValue{Value: true}
@@ -122,7 +122,14 @@ func UpdateUpstream(original, desired *v1.Upstream) (didChange bool, err error)
 	// copy labels; user may have written them over. cannot be auto-discovered
 	desiredSpec.Kube.Selector = originalSpec.Kube.GetSelector()
 
-	utils.UpdateUpstream(original, desired)
+	// config set from the appProtocol of the service port is owned by discovery, so is not kept once it changes
+	previous := original
+	if serviceconverter.AppProtocol(original) != serviceconverter.AppProtocol(desired) {
+		previous = serviceconverter.WithoutAppProtocolConfig(original)
+	}
+	// while config the user set is kept over that of the appProtocol
+	serviceconverter.KeepUserConfig(previous, desired)
+	utils.UpdateUpstream(previous, desired)
 
 	return !upstreamsEqual(original, desired), nil
 }
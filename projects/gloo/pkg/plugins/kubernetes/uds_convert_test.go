@@ -583,6 +583,90 @@ var _ = Describe("UdsConvert", func() {
 			)
 		})
 	})
+
+	Context("app protocol", func() {
+
+		createUpstream := func(appProtocol, portName string, annotations map[string]string) *v1.Upstream {
+			svc := &kubev1.Service{
+				Spec: kubev1.ServiceSpec{},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "test-ns",
+					Annotations: annotations,
+				},
+			}
+			port := kubev1.ServicePort{
+				Port:        123,
+				Name:        portName,
+				AppProtocol: &appProtocol,
+			}
+			return uc.CreateUpstream(context.TODO(), svc, port)
+		}
+
+		DescribeTable("should configure the upstream for the app protocol", func(appProtocol string, useHttp2 *wrappers.BoolValue, sslConfig *v1.UpstreamSslConfig, grpcHealthCheck bool) {
+			up := createUpstream(appProtocol, "", map[string]string{
+				serviceconverter.GlooAppProtocolHealthCheckAnnotation: "true",
+			})
+			Expect(up.GetUseHttp2()).To(Equal(useHttp2))
+			Expect(up.GetSslConfig()).To(Equal(sslConfig))
+			if grpcHealthCheck {
+				Expect(up.GetHealthChecks()).To(HaveLen(1))
+				Expect(up.GetHealthChecks()[0].GetGrpcHealthCheck()).NotTo(BeNil())
+			} else {
+				Expect(up.GetHealthChecks()).To(BeEmpty())
+			}
+			Expect(up.GetMetadata().GetAnnotations()).To(HaveKeyWithValue(serviceconverter.GlooAppProtocolAnnotation, appProtocol))
+		},
+			Entry("grpc", "grpc", &wrappers.BoolValue{Value: true}, nil, true),
+			Entry("http2", "http2", &wrappers.BoolValue{Value: true}, nil, false),
+			Entry("h2c", "kubernetes.io/h2c", &wrappers.BoolValue{Value: true}, nil, false),
+			Entry("websocket", "kubernetes.io/ws", &wrappers.BoolValue{Value: false}, nil, false),
+			Entry("https", "https", nil, &v1.UpstreamSslConfig{Sni: "test.test-ns.svc"}, false),
+		)
+
+		It("should ignore app protocols it does not configure", func() {
+			up := createUpstream("http", "", nil)
+			Expect(up.GetUseHttp2()).To(BeNil())
+			Expect(up.GetSslConfig()).To(BeNil())
+			Expect(up.GetMetadata().GetAnnotations()).NotTo(HaveKey(serviceconverter.GlooAppProtocolAnnotation))
+		})
+
+		It("should not add health checks unless enabled by annotation", func() {
+			up := createUpstream("grpc", "", nil)
+			Expect(up.GetUseHttp2().GetValue()).To(BeTrue())
+			Expect(up.GetHealthChecks()).To(BeEmpty())
+		})
+
+		It("should take precedence over the port name", func() {
+			up := createUpstream("kubernetes.io/ws", "http2-ws", nil)
+			Expect(up.GetUseHttp2()).To(Equal(&wrappers.BoolValue{Value: false}))
+		})
+
+		It("should be overridden by the h2 annotation", func() {
+			up := createUpstream("grpc", "", map[string]string{
+				serviceconverter.GlooH2Annotation: "false",
+			})
+			Expect(up.GetUseHttp2()).To(Equal(&wrappers.BoolValue{Value: false}))
+		})
+
+		It("should be overridden by the ssl annotations", func() {
+			up := createUpstream("https", "", map[string]string{
+				serviceconverter.GlooSslSecretAnnotation: "mysecret",
+			})
+			Expect(up.GetSslConfig()).To(Equal(&v1.UpstreamSslConfig{
+				SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Name: "mysecret", Namespace: "test-ns"},
+				},
+			}))
+		})
+
+		It("should be overridden by the upstream config annotation", func() {
+			up := createUpstream("grpc", "", map[string]string{
+				serviceconverter.GlooAnnotationPrefix: `{"use_http2": false}`,
+			})
+			Expect(up.GetUseHttp2().GetValue()).To(BeFalse())
+		})
+	})
 })
 
 func testSetUseHttp2Converter() {
@@ -1,9 +1,15 @@
 package kubernetes_test
 
 import (
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	gloov1kube "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -59,4 +65,115 @@ var _ = Describe("Uds", func() {
 		Expect(desired.SslConfig).To(BeIdenticalTo(desiredSslConfig))
 	})
 
+	Context("app protocol", func() {
+
+		upstream := func(appProtocol string) *gloov1.Upstream {
+			us := &gloov1.Upstream{
+				Metadata: &core.Metadata{Name: "test", Namespace: "gloo-system"},
+				UpstreamType: &gloov1.Upstream_Kube{
+					Kube: &gloov1kube.UpstreamSpec{ServiceName: "test", ServiceNamespace: "default"},
+				},
+			}
+			if appProtocol != "" {
+				us.Metadata.Annotations = map[string]string{serviceconverter.GlooAppProtocolAnnotation: appProtocol}
+			}
+			return us
+		}
+
+		// the upstream the app protocol converter discovers for the service port
+		createUpstream := func(appProtocol string, annotations map[string]string) *gloov1.Upstream {
+			us := upstream("")
+			svc := &kubev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: annotations}}
+			port := kubev1.ServicePort{AppProtocol: &appProtocol}
+			Expect((&serviceconverter.AppProtocolConverter{}).ConvertService(svc, port, us)).NotTo(HaveOccurred())
+			return us
+		}
+
+		It("should remove the config of the previous app protocol when it changes", func() {
+			original := upstream("grpc")
+			original.UseHttp2 = &wrappers.BoolValue{Value: true}
+			original.SslConfig = &gloov1.UpstreamSslConfig{Sni: "user-sni"}
+			desired := upstream("kubernetes.io/ws")
+			desired.UseHttp2 = &wrappers.BoolValue{Value: false}
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.GetUseHttp2().GetValue()).To(BeFalse())
+			// config which the previous app protocol did not set is kept
+			Expect(desired.GetSslConfig()).To(Equal(original.GetSslConfig()))
+		})
+
+		It("should remove the config of the app protocol when it is removed", func() {
+			original := upstream("https")
+			original.SslConfig = &gloov1.UpstreamSslConfig{Sni: "test.default.svc"}
+			desired := upstream("")
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.GetSslConfig()).To(BeNil())
+			Expect(original.GetSslConfig()).NotTo(BeNil())
+		})
+
+		It("should keep config when the app protocol does not change", func() {
+			original := upstream("http2")
+			original.UseHttp2 = &wrappers.BoolValue{Value: true}
+			original.SslConfig = &gloov1.UpstreamSslConfig{Sni: "user-sni"}
+			desired := upstream("http2")
+			desired.UseHttp2 = &wrappers.BoolValue{Value: true}
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+			Expect(desired.GetSslConfig()).To(BeIdenticalTo(original.GetSslConfig()))
+		})
+
+		It("should keep the config the user set over that of the app protocol", func() {
+			userHealthChecks := []*envoycore.HealthCheck{{
+				Timeout:  &duration.Duration{Seconds: 1},
+				Interval: &duration.Duration{Seconds: 2},
+				HealthChecker: &envoycore.HealthCheck_HttpHealthCheck_{
+					HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{Path: "/healthz"},
+				},
+			}}
+			original := upstream("grpc")
+			original.UseHttp2 = &wrappers.BoolValue{Value: false}
+			original.HealthChecks = userHealthChecks
+			desired := upstream("grpc")
+			converted := createUpstream("grpc", map[string]string{serviceconverter.GlooAppProtocolHealthCheckAnnotation: "true"})
+			desired.UseHttp2 = converted.GetUseHttp2()
+			desired.HealthChecks = converted.GetHealthChecks()
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+			Expect(desired.GetUseHttp2().GetValue()).To(BeFalse())
+			Expect(desired.GetHealthChecks()).To(Equal(userHealthChecks))
+		})
+
+		It("should keep the config the user set when the app protocol changes", func() {
+			original := upstream("https")
+			original.SslConfig = &gloov1.UpstreamSslConfig{Sni: "user-sni"}
+			desired := upstream("kubernetes.io/h2c")
+			desired.UseHttp2 = &wrappers.BoolValue{Value: true}
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.GetSslConfig().GetSni()).To(Equal("user-sni"))
+			Expect(desired.GetUseHttp2().GetValue()).To(BeTrue())
+		})
+
+		It("should fill in the config of the app protocol the user has not set", func() {
+			original := upstream("https")
+			desired := upstream("https")
+			desired.SslConfig = createUpstream("https", nil).GetSslConfig()
+
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.GetSslConfig()).To(Equal(&gloov1.UpstreamSslConfig{Sni: "test.default.svc"}))
+		})
+	})
 })
@@ -0,0 +1,27 @@
+package pluginutils
+
+import (
+	"fmt"
+)
+
+// ConfigurationWarning is returned by plugins for configuration which is translated, but which may not take effect
+// as intended. The translator reports it as a warning on the resource instead of an error.
+type ConfigurationWarning struct {
+	Message string
+}
+
+func NewConfigurationWarning(format string, args ...interface{}) *ConfigurationWarning {
+	return &ConfigurationWarning{Message: fmt.Sprintf(format, args...)}
+}
+
+func (w *ConfigurationWarning) Error() string {
+	return w.Message
+}
+
+func IsConfigurationWarning(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(*ConfigurationWarning)
+	return ok
+}
@@ -4,6 +4,7 @@ package registry
 import (
 	"context"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dns_srv"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamic_forward_proxy"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
@@ -20,6 +21,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/file_discovery"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcweb"
@@ -96,6 +98,7 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		metadata.NewPlugin(),
 		tunneling.NewPlugin(),
 		dynamic_forward_proxy.NewPlugin(),
+		dns_srv.NewPlugin(),
 	)
 
 	if opts.KubeClient != nil {
@@ -104,6 +107,9 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 	if opts.Consul.ConsulWatcher != nil {
 		glooPlugins = append(glooPlugins, consul.NewPlugin(opts.Consul.ConsulWatcher, consul.NewConsulDnsResolver(opts.Consul.DnsServer), opts.Consul.DnsPollingInterval))
 	}
+	if fileOptions := opts.Settings.GetDiscovery().GetFileOptions(); fileOptions.GetDirectory() != "" {
+		glooPlugins = append(glooPlugins, file_discovery.NewPlugin(fileOptions))
+	}
 
 	return glooPlugins
 }
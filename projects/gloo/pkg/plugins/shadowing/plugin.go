@@ -9,6 +9,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var (
@@ -21,9 +23,12 @@ const (
 )
 
 var (
-	InvalidRouteActionError  = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
-	UnspecifiedUpstreamError = eris.New("invalid plugin spec: must specify an upstream ref")
-	InvalidNumeratorError    = func(num float32) error {
+	InvalidRouteActionError         = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
+	UnspecifiedUpstreamError        = eris.New("invalid plugin spec: must specify an upstream ref")
+	UnspecifiedDestinationError     = eris.New("invalid mirror policy: must specify an upstream, kube or consul destination")
+	UnspecifiedConsulServiceError   = eris.New("invalid mirror policy: must specify the name of the consul service")
+	ShadowingAndMirrorPoliciesError = eris.New("cannot use both shadowing and request mirror policies on a route")
+	InvalidNumeratorError           = func(num float32) error {
 		return eris.Errorf("shadow percentage must be between 0 and 100, received %v", num)
 	}
 )
@@ -43,15 +48,23 @@ func (p *plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
-	if in.GetOptions() == nil || in.GetOptions().GetShadowing() == nil {
+	shadowSpec := in.GetOptions().GetShadowing()
+	mirrorPolicies := translator.RequestMirrorPolicies(params.VirtualHost, in)
+	if shadowSpec == nil && len(mirrorPolicies) == 0 {
 		return nil
 	}
+	if shadowSpec != nil && len(mirrorPolicies) > 0 {
+		return ShadowingAndMirrorPoliciesError
+	}
 	// the shadow plugin should only be used on routes that are of type envoyroute.Route_Route
 	// (this is because shadowing is not defined on redirect or direct response route actions)
 	if out.GetAction() != nil && out.GetRoute() == nil {
+		// the mirror policies of the virtual host only apply to its routes with route actions
+		if shadowSpec == nil && len(in.GetOptions().GetRequestMirrorPolicies()) == 0 {
+			return nil
+		}
 		return InvalidRouteActionError
 	}
-	shadowSpec := in.GetOptions().GetShadowing()
 	// we have already ensured that the output route action is either nil or of the proper type
 	// if it is nil, we initialize it prior to transforming it
 	outRa := out.GetRoute()
@@ -61,7 +74,10 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		}
 		outRa = out.GetRoute()
 	}
-	return applyShadowSpec(outRa, shadowSpec)
+	if shadowSpec != nil {
+		return applyShadowSpec(outRa, shadowSpec)
+	}
+	return applyMirrorPolicies(outRa, mirrorPolicies)
 }
 
 func applyShadowSpec(out *envoy_config_route_v3.RouteAction, spec *shadowing.RouteShadowing) error {
@@ -85,3 +101,69 @@ func getFractionalPercent(numerator float32) *envoy_config_core_v3.RuntimeFracti
 		DefaultValue: common.ToEnvoyPercentage(numerator),
 	}
 }
+
+func applyMirrorPolicies(out *envoy_config_route_v3.RouteAction, policies []*shadowing.RequestMirrorPolicy) error {
+	var mirrorPolicies []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy
+	for _, policy := range policies {
+		// policies with headers are converted too, so that their errors are reported on the route
+		mirrorPolicy, err := convertMirrorPolicy(policy)
+		if err != nil {
+			return err
+		}
+		// the translator generates routes which match the headers of the policy, and mirror requests to it
+		if len(policy.GetHeaders()) > 0 {
+			continue
+		}
+		mirrorPolicies = append(mirrorPolicies, mirrorPolicy)
+	}
+	out.RequestMirrorPolicies = mirrorPolicies
+	return nil
+}
+
+func convertMirrorPolicy(policy *shadowing.RequestMirrorPolicy) (*envoy_config_route_v3.RouteAction_RequestMirrorPolicy, error) {
+	upstreamRef, err := mirrorPolicyUpstreamRef(policy)
+	if err != nil {
+		return nil, err
+	}
+	out := &envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
+		Cluster:      translator.UpstreamToClusterName(upstreamRef),
+		TraceSampled: policy.GetTraceSampled(),
+	}
+
+	// without a runtime fraction, all requests are mirrored
+	if policy.GetPercentage() != nil || policy.GetRuntimeKey() != "" {
+		percentage := float32(100)
+		if policy.GetPercentage() != nil {
+			percentage = policy.GetPercentage().GetValue()
+		}
+		if percentage < 0 || percentage > 100 {
+			return nil, InvalidNumeratorError(percentage)
+		}
+		out.RuntimeFraction = getFractionalPercent(percentage)
+		out.GetRuntimeFraction().RuntimeKey = policy.GetRuntimeKey()
+	}
+	return out, nil
+}
+
+func mirrorPolicyUpstreamRef(policy *shadowing.RequestMirrorPolicy) (*core.ResourceRef, error) {
+	dest := &v1.Destination{}
+	switch destination := policy.GetDestinationType().(type) {
+	case *shadowing.RequestMirrorPolicy_Upstream:
+		dest.DestinationType = &v1.Destination_Upstream{Upstream: destination.Upstream}
+	case *shadowing.RequestMirrorPolicy_Kube:
+		dest.DestinationType = &v1.Destination_Kube{Kube: &v1.KubernetesServiceDestination{
+			Ref:  destination.Kube.GetRef(),
+			Port: destination.Kube.GetPort(),
+		}}
+	case *shadowing.RequestMirrorPolicy_Consul:
+		if destination.Consul.GetServiceName() == "" {
+			return nil, UnspecifiedConsulServiceError
+		}
+		dest.DestinationType = &v1.Destination_Consul{Consul: &v1.ConsulServiceDestination{
+			ServiceName: destination.Consul.GetServiceName(),
+		}}
+	default:
+		return nil, UnspecifiedDestinationError
+	}
+	return upstreams.DestinationToUpstreamRef(dest)
+}
@@ -3,9 +3,11 @@ package shadowing
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/go-utils/testutils"
@@ -142,6 +144,201 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
 	})
 
+	Context("request mirror policies", func() {
+
+		var (
+			p          *plugin
+			upRef      *core.ResourceRef
+			otherUpRef *core.ResourceRef
+		)
+
+		BeforeEach(func() {
+			p = NewPlugin()
+			upRef = &core.ResourceRef{
+				Name:      "some-upstream",
+				Namespace: "default",
+			}
+			otherUpRef = &core.ResourceRef{
+				Name:      "other-upstream",
+				Namespace: "default",
+			}
+		})
+
+		It("should mirror requests to each policy", func() {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef},
+							Percentage:      &wrappers.FloatValue{Value: 25},
+						},
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: otherUpRef},
+							Percentage:      &wrappers.FloatValue{Value: 10},
+							RuntimeKey:      "mirror.other",
+							TraceSampled:    &wrappers.BoolValue{Value: false},
+						},
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Kube{Kube: &shadowing.RequestMirrorPolicy_KubernetesServiceDestination{
+								Ref:  &core.ResourceRef{Name: "svc", Namespace: "ns"},
+								Port: 8080,
+							}},
+						},
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Consul{Consul: &shadowing.RequestMirrorPolicy_ConsulServiceDestination{
+								ServiceName: "consul-svc",
+							}},
+						},
+					},
+				},
+			}
+			out := &envoy_config_route_v3.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			policies := out.GetRoute().GetRequestMirrorPolicies()
+			Expect(policies).To(HaveLen(4))
+			Expect(policies[0].GetCluster()).To(Equal("some-upstream_default"))
+			checkFraction(policies[0].GetRuntimeFraction(), 25)
+			Expect(policies[0].GetTraceSampled()).To(BeNil())
+			Expect(policies[1].GetCluster()).To(Equal("other-upstream_default"))
+			checkFraction(policies[1].GetRuntimeFraction(), 10)
+			Expect(policies[1].GetRuntimeFraction().GetRuntimeKey()).To(Equal("mirror.other"))
+			Expect(policies[1].GetTraceSampled().GetValue()).To(BeFalse())
+			Expect(policies[2].GetCluster()).To(Equal("kube-svc:ns-svc-8080_ns"))
+			Expect(policies[2].GetRuntimeFraction()).To(BeNil())
+			Expect(policies[3].GetCluster()).To(Equal("consul-svc:consul-svc_gloo-system"))
+		})
+
+		It("should use the policies of the virtual host, unless the route specifies its own", func() {
+			params := plugins.RouteParams{
+				VirtualHost: &v1.VirtualHost{
+					Options: &v1.VirtualHostOptions{
+						RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+							{DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef}},
+						},
+					},
+				},
+			}
+
+			out := &envoy_config_route_v3.Route{}
+			err := p.ProcessRoute(params, &v1.Route{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(out.GetRoute().GetRequestMirrorPolicies()[0].GetCluster()).To(Equal("some-upstream_default"))
+
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: otherUpRef}},
+					},
+				},
+			}
+			out = &envoy_config_route_v3.Route{}
+			err = p.ProcessRoute(params, in, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(out.GetRoute().GetRequestMirrorPolicies()[0].GetCluster()).To(Equal("other-upstream_default"))
+
+			// the policies of the virtual host are not applied to routes without route actions
+			out = &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Redirect{
+					Redirect: &envoy_config_route_v3.RedirectAction{},
+				},
+			}
+			err = p.ProcessRoute(params, &v1.Route{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRedirect()).NotTo(BeNil())
+		})
+
+		It("should skip policies with headers", func() {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef}},
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: otherUpRef},
+							Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}},
+						},
+					},
+				},
+			}
+			out := &envoy_config_route_v3.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(out.GetRoute().GetRequestMirrorPolicies()[0].GetCluster()).To(Equal("some-upstream_default"))
+		})
+
+		It("should error when given invalid policies", func() {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Upstream:   upRef,
+						Percentage: 100,
+					},
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: otherUpRef}},
+					},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveInErrorChain(ShadowingAndMirrorPoliciesError))
+
+			in = &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{Percentage: &wrappers.FloatValue{Value: 50}},
+					},
+				},
+			}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveInErrorChain(UnspecifiedDestinationError))
+
+			in = &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef},
+							Percentage:      &wrappers.FloatValue{Value: 150},
+						},
+					},
+				},
+			}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveInErrorChain(InvalidNumeratorError(150)))
+
+			// policies with headers are validated, though the route does not mirror requests to them
+			in = &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{
+							DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef},
+							Percentage:      &wrappers.FloatValue{Value: 150},
+							Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}},
+						},
+					},
+				},
+			}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveInErrorChain(InvalidNumeratorError(150)))
+
+			in = &v1.Route{
+				Options: &v1.RouteOptions{
+					RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+						{DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upRef}},
+					},
+				},
+			}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_DirectResponse{
+					DirectResponse: &envoy_config_route_v3.DirectResponseAction{},
+				},
+			})
+			Expect(err).To(HaveInErrorChain(InvalidRouteActionError))
+		})
+	})
+
 })
 
 func checkFraction(frac *envoy_config_core_v3.RuntimeFractionalPercent, percentage float32) {
@@ -1,6 +1,8 @@
 package tracing
 
 import (
+	"sort"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_config_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -127,6 +129,24 @@ func customTags(tracingSettings *tracing.ListenerTracingSettings) []*envoytracin
 		}
 		customTags = append(customTags, tag)
 	}
+	// envoy cannot set the attributes of the OpenTelemetry resource, so they are added to each span
+	resourceAttributes := tracingSettings.GetOpenTelemetryConfig().GetResourceAttributes()
+	var resourceAttributeKeys []string
+	for key := range resourceAttributes {
+		resourceAttributeKeys = append(resourceAttributeKeys, key)
+	}
+	sort.Strings(resourceAttributeKeys)
+	for _, key := range resourceAttributeKeys {
+		tag := &envoytracing.CustomTag{
+			Tag: key,
+			Type: &envoytracing.CustomTag_Literal_{
+				Literal: &envoytracing.CustomTag_Literal{
+					Value: resourceAttributes[key],
+				},
+			},
+		}
+		customTags = append(customTags, tag)
+	}
 
 	return customTags
 }
@@ -146,6 +166,9 @@ func processEnvoyTracingProvider(
 	case *tracing.ListenerTracingSettings_DatadogConfig:
 		return processEnvoyDatadogTracing(snapshot, typed)
 
+	case *tracing.ListenerTracingSettings_OpenTelemetryConfig:
+		return processEnvoyOpenTelemetryTracing(snapshot, typed)
+
 	default:
 		return nil, errors.Errorf("Unsupported Tracing.ProviderConfiguration: %v", typed)
 	}
@@ -225,6 +248,46 @@ func processEnvoyDatadogTracing(
 	}, nil
 }
 
+func processEnvoyOpenTelemetryTracing(
+	snapshot *v1snap.ApiSnapshot,
+	openTelemetryTracingSettings *tracing.ListenerTracingSettings_OpenTelemetryConfig,
+) (*envoy_config_trace_v3.Tracing_Http, error) {
+	var collectorClusterName string
+
+	switch collectorCluster := openTelemetryTracingSettings.OpenTelemetryConfig.GetCollectorCluster().(type) {
+	case *v3.OpenTelemetryConfig_CollectorUpstreamRef:
+		// Support upstreams as the collector cluster
+		var err error
+		collectorClusterName, err = getEnvoyTracingCollectorClusterName(snapshot, collectorCluster.CollectorUpstreamRef)
+		if err != nil {
+			return nil, err
+		}
+	case *v3.OpenTelemetryConfig_ClusterName:
+		// Support static clusters as the collector cluster
+		collectorClusterName = collectorCluster.ClusterName
+	default:
+		// unlike the http tracers, there is no default collector cluster for the grpc service
+		return nil, errors.Errorf("Invalid OpenTelemetryConfig (no collector cluster provided)")
+	}
+
+	envoyConfig, err := api_conversion.ToEnvoyOpenTelemetryConfiguration(openTelemetryTracingSettings.OpenTelemetryConfig, collectorClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalledEnvoyConfig, err := ptypes.MarshalAny(envoyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_config_trace_v3.Tracing_Http{
+		Name: "envoy.tracers.opentelemetry",
+		ConfigType: &envoy_config_trace_v3.Tracing_Http_TypedConfig{
+			TypedConfig: marshalledEnvoyConfig,
+		},
+	}, nil
+}
+
 func getEnvoyTracingCollectorClusterName(snapshot *v1snap.ApiSnapshot, collectorUpstreamRef *core.ResourceRef) (string, error) {
 	if snapshot == nil {
 		return "", errors.Errorf("Invalid Snapshot (nil provided)")
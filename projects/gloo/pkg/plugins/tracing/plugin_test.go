@@ -1,6 +1,7 @@
 package tracing
 
 import (
+	udpa_type_v1 "github.com/cncf/xds/go/udpa/type/v1"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -394,6 +395,170 @@ var _ = Describe("Plugin", func() {
 			})
 		})
 
+		Describe("when opentelemetry provider config", func() {
+
+			getEnvoyConfig := func(cfg *envoyhttp.HttpConnectionManager) *udpa_type_v1.TypedStruct {
+				Expect(cfg.Tracing.Provider.GetName()).To(Equal("envoy.tracers.opentelemetry"))
+				envoyConfig := &udpa_type_v1.TypedStruct{}
+				err := ptypes.UnmarshalAny(cfg.Tracing.Provider.GetTypedConfig(), envoyConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(envoyConfig.GetTypeUrl()).To(Equal("type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig"))
+				return envoyConfig
+			}
+
+			getClusterName := func(envoyConfig *udpa_type_v1.TypedStruct) string {
+				return envoyConfig.GetValue().GetFields()["grpc_service"].GetStructValue().GetFields()["envoy_grpc"].GetStructValue().GetFields()["cluster_name"].GetStringValue()
+			}
+
+			It("references invalid upstream", func() {
+				pluginParams = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{
+						Upstreams: v1.UpstreamList{
+							// No valid upstreams
+						},
+					},
+				}
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_CollectorUpstreamRef{
+									CollectorUpstreamRef: &core.ResourceRef{
+										Name:      "invalid-name",
+										Namespace: "invalid-namespace",
+									},
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).NotTo(BeNil())
+			})
+
+			It("references no collector cluster", func() {
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								ServiceName: "gloo",
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).NotTo(BeNil())
+			})
+
+			It("references valid upstream", func() {
+				us := v1.NewUpstream("default", "valid")
+				pluginParams = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{
+						Upstreams: v1.UpstreamList{us},
+					},
+				}
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_CollectorUpstreamRef{
+									CollectorUpstreamRef: &core.ResourceRef{
+										Name:      "valid",
+										Namespace: "default",
+									},
+								},
+								ServiceName: "opentelemetry-gloo",
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				envoyConfig := getEnvoyConfig(cfg)
+				Expect(getClusterName(envoyConfig)).To(Equal("valid_default"))
+				Expect(envoyConfig.GetValue().GetFields()["service_name"].GetStringValue()).To(Equal("opentelemetry-gloo"))
+			})
+
+			It("references cluster name", func() {
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_ClusterName{
+									ClusterName: "opentelemetry-cluster-name",
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				envoyConfig := getEnvoyConfig(cfg)
+				Expect(getClusterName(envoyConfig)).To(Equal("opentelemetry-cluster-name"))
+				Expect(envoyConfig.GetValue().GetFields()).NotTo(HaveKey("service_name"))
+			})
+
+			It("adds resource attributes as tags", func() {
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						LiteralsForTags: []*tracing.TracingTagLiteral{
+							{
+								Tag:   "literal",
+								Value: "value",
+							},
+						},
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_ClusterName{
+									ClusterName: "opentelemetry-cluster-name",
+								},
+								ResourceAttributes: map[string]string{
+									"service.namespace":      "gloo-system",
+									"deployment.environment": "production",
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				Expect(cfg.Tracing.CustomTags).To(Equal([]*envoytracing.CustomTag{
+					{
+						Tag: "literal",
+						Type: &envoytracing.CustomTag_Literal_{
+							Literal: &envoytracing.CustomTag_Literal{
+								Value: "value",
+							},
+						},
+					},
+					{
+						Tag: "deployment.environment",
+						Type: &envoytracing.CustomTag_Literal_{
+							Literal: &envoytracing.CustomTag_Literal{
+								Value: "production",
+							},
+						},
+					},
+					{
+						Tag: "service.namespace",
+						Type: &envoytracing.CustomTag_Literal_{
+							Literal: &envoytracing.CustomTag_Literal{
+								Value: "gloo-system",
+							},
+						},
+					},
+				}))
+			})
+		})
+
 	})
 
 	It("should update routes properly", func() {
@@ -0,0 +1,136 @@
+package syncer
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+)
+
+const (
+	secretCertExpiryName = "gloo.solo.io/tls/secret_cert_expiry_seconds"
+	sniCertExpiryName    = "gloo.solo.io/tls/sni_cert_expiry_seconds"
+)
+
+var (
+	certExpiry = newCertExpiryMetrics(time.Now)
+
+	secretCertExpiryDescriptor = metricdata.Descriptor{
+		Name:        secretCertExpiryName,
+		Description: "The seconds until the first of the certs in each TLS secret expires. Negative once it has expired.",
+		Unit:        metricdata.Unit("s"),
+		Type:        metricdata.TypeGaugeFloat64,
+		LabelKeys:   []metricdata.LabelKey{{Key: "secret_namespace"}, {Key: "secret_name"}},
+	}
+	sniCertExpiryDescriptor = metricdata.Descriptor{
+		Name:        sniCertExpiryName,
+		Description: "The seconds until the first of the certs served for each SNI domain of each proxy expires. Negative once it has expired.",
+		Unit:        metricdata.Unit("s"),
+		Type:        metricdata.TypeGaugeFloat64,
+		LabelKeys:   []metricdata.LabelKey{{Key: "proxy_name"}, {Key: "sni_domain"}},
+	}
+)
+
+func init() {
+	metricproducer.GlobalManager().AddProducer(certExpiry)
+}
+
+// certExpiryMetrics exports when the certs of each TLS secret, and of the secrets served for each SNI domain, expire.
+// The seconds until expiry are computed whenever the metrics are read, so they stay accurate between syncs.
+type certExpiryMetrics struct {
+	now func() time.Time
+
+	lock       sync.Mutex
+	secrets    map[[2]string]time.Time
+	sniDomains map[[2]string]time.Time
+}
+
+func newCertExpiryMetrics(now func() time.Time) *certExpiryMetrics {
+	return &certExpiryMetrics{
+		now:        now,
+		secrets:    map[[2]string]time.Time{},
+		sniDomains: map[[2]string]time.Time{},
+	}
+}
+
+// Update replaces the expiries with those of the secrets and proxies in the snapshot
+func (m *certExpiryMetrics) Update(snap *v1snap.ApiSnapshot) {
+	secrets := map[[2]string]time.Time{}
+	for _, secret := range snap.Secrets {
+		if notAfter, ok := utils.GetTlsSecretExpiry(secret); ok {
+			secrets[[2]string{secret.GetMetadata().GetNamespace(), secret.GetMetadata().GetName()}] = notAfter
+		}
+	}
+
+	sniDomains := map[[2]string]time.Time{}
+	for _, proxy := range snap.Proxies {
+		for _, listener := range proxy.GetListeners() {
+			for _, sslConfig := range utils.GetListenerSslConfigs(listener) {
+				notAfter, ok := secretRefExpiry(secrets, sslConfig)
+				if !ok {
+					continue
+				}
+				for _, sniDomain := range sslConfig.GetSniDomains() {
+					key := [2]string{proxy.GetMetadata().GetName(), sniDomain}
+					if existing, ok := sniDomains[key]; !ok || notAfter.Before(existing) {
+						sniDomains[key] = notAfter
+					}
+				}
+			}
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.secrets = secrets
+	m.sniDomains = sniDomains
+}
+
+func secretRefExpiry(secrets map[[2]string]time.Time, sslConfig *v1.SslConfig) (time.Time, bool) {
+	ref := sslConfig.GetSecretRef()
+	if ref == nil {
+		return time.Time{}, false
+	}
+	notAfter, ok := secrets[[2]string{ref.GetNamespace(), ref.GetName()}]
+	return notAfter, ok
+}
+
+// Read implements metricproducer.Producer
+func (m *certExpiryMetrics) Read() []*metricdata.Metric {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := m.now()
+	return []*metricdata.Metric{
+		expiryMetric(secretCertExpiryDescriptor, m.secrets, now),
+		expiryMetric(sniCertExpiryDescriptor, m.sniDomains, now),
+	}
+}
+
+func expiryMetric(descriptor metricdata.Descriptor, expiries map[[2]string]time.Time, now time.Time) *metricdata.Metric {
+	keys := make([][2]string, 0, len(expiries))
+	for key := range expiries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	metric := &metricdata.Metric{Descriptor: descriptor}
+	for _, key := range keys {
+		metric.TimeSeries = append(metric.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue(key[0]), metricdata.NewLabelValue(key[1])},
+			Points:      []metricdata.Point{metricdata.NewFloat64Point(now, expiries[key].Sub(now).Seconds())},
+			StartTime:   now,
+		})
+	}
+	return metric
+}
@@ -0,0 +1,98 @@
+package syncer
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.opencensus.io/metric/metricdata"
+)
+
+var _ = Describe("certExpiryMetrics", func() {
+
+	var (
+		now     time.Time
+		metrics *certExpiryMetrics
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		metrics = newCertExpiryMetrics(func() time.Time { return now })
+	})
+
+	tlsSecret := func(name string, validFor time.Duration) *v1.Secret {
+		cert, key := gloohelpers.GetCerts(gloohelpers.Params{
+			Hosts:     "gateway-proxy",
+			ValidFrom: &now,
+			ValidFor:  &validFor,
+		})
+		return &v1.Secret{
+			Metadata: &core.Metadata{Name: name, Namespace: "gloo-system"},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{CertChain: cert, PrivateKey: key},
+			},
+		}
+	}
+
+	sslConfig := func(secretName string, sniDomains ...string) *v1.SslConfig {
+		return &v1.SslConfig{
+			SslSecrets: &v1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: secretName, Namespace: "gloo-system"}},
+			SniDomains: sniDomains,
+		}
+	}
+
+	seconds := func(metric *metricdata.Metric) map[[2]string]float64 {
+		values := map[[2]string]float64{}
+		for _, ts := range metric.TimeSeries {
+			values[[2]string{ts.LabelValues[0].Value, ts.LabelValues[1].Value}] = ts.Points[0].Value.(float64)
+		}
+		return values
+	}
+
+	It("exports the seconds until the certs of each secret and sni domain expire", func() {
+		metrics.Update(&v1snap.ApiSnapshot{
+			Secrets: v1.SecretList{tlsSecret("short", time.Hour), tlsSecret("long", 2*time.Hour)},
+			Proxies: v1.ProxyList{{
+				Metadata: &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+				Listeners: []*v1.Listener{{
+					SslConfigurations: []*v1.SslConfig{
+						sslConfig("long", "a.com", "b.com"),
+						sslConfig("short", "b.com"),
+						sslConfig("missing", "c.com"),
+					},
+				}},
+			}},
+		})
+
+		read := metrics.Read()
+		Expect(read).To(HaveLen(2))
+		Expect(read[0].Descriptor.Name).To(Equal(secretCertExpiryName))
+		secretSeconds := seconds(read[0])
+		Expect(secretSeconds).To(HaveLen(2))
+		Expect(secretSeconds[[2]string{"gloo-system", "short"}]).To(BeNumerically("~", time.Hour.Seconds(), 1))
+		Expect(secretSeconds[[2]string{"gloo-system", "long"}]).To(BeNumerically("~", 2*time.Hour.Seconds(), 1))
+
+		Expect(read[1].Descriptor.Name).To(Equal(sniCertExpiryName))
+		sniSeconds := seconds(read[1])
+		Expect(sniSeconds).To(HaveLen(2))
+		Expect(sniSeconds[[2]string{"gateway-proxy", "a.com"}]).To(BeNumerically("~", 2*time.Hour.Seconds(), 1))
+		Expect(sniSeconds[[2]string{"gateway-proxy", "b.com"}]).To(BeNumerically("~", time.Hour.Seconds(), 1))
+
+		By("computing the seconds when read")
+		now = now.Add(3 * time.Hour)
+		secretSeconds = seconds(metrics.Read()[0])
+		Expect(secretSeconds[[2]string{"gloo-system", "short"}]).To(BeNumerically("~", -2*time.Hour.Seconds(), 1))
+	})
+
+	It("drops the expiries of secrets which are removed", func() {
+		metrics.Update(&v1snap.ApiSnapshot{Secrets: v1.SecretList{tlsSecret("short", time.Hour)}})
+		Expect(metrics.Read()[0].TimeSeries).To(HaveLen(1))
+
+		metrics.Update(&v1snap.ApiSnapshot{})
+		Expect(metrics.Read()[0].TimeSeries).To(BeEmpty())
+	})
+})
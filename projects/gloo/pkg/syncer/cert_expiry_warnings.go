@@ -0,0 +1,35 @@
+package syncer
+
+import (
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// certExpiryWarnings returns a warning for each TLS secret whose certs have expired, or expire within the threshold,
+// keyed by the secret's ref. The warnings are added to the reports of the resources using the secrets by the syncers
+// rather than by the translators, so that the validation webhook never rejects a resource because of them.
+func certExpiryWarnings(secrets v1.SecretList, now time.Time, threshold time.Duration) map[string]string {
+	warnings := map[string]string{}
+	for _, secret := range secrets {
+		if warning := utils.CertExpiryWarning(secret, now, threshold); warning != "" {
+			warnings[secret.GetMetadata().Ref().Key()] = warning
+		}
+	}
+	return warnings
+}
+
+// addUpstreamCertExpiryWarnings warns on each upstream whose ssl config uses a secret with a cert expiry warning
+func addUpstreamCertExpiryWarnings(upstreams v1.UpstreamList, warnings map[string]string, reports reporter.ResourceReports) {
+	for _, upstream := range upstreams {
+		ref := upstream.GetSslConfig().GetSecretRef()
+		if ref == nil {
+			continue
+		}
+		if warning, ok := warnings[ref.Key()]; ok {
+			reports.AddWarning(upstream, warning)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
 	"github.com/solo-io/go-utils/hashutils"
@@ -12,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
@@ -19,6 +22,7 @@ import (
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -39,10 +43,26 @@ var (
 		Aggregation: view.LastValue(),
 		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, resourceNameKey},
 	}
+
+	proxyTranslationTime    = stats.Float64("api.gloo.solo.io/translator/proxy_translation_time", "The time taken to translate and sanitize a proxy", "ms")
+	translationResultKey, _ = tag.NewKey("result")
+
+	proxyTranslationTimeView = &view.View{
+		Name:        "api.gloo.solo.io/translator/proxy_translation_time",
+		Measure:     proxyTranslationTime,
+		Description: "The time taken to translate and sanitize a proxy, or to find that its previous translation can be reused",
+		Aggregation: view.Distribution(0, 1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, translationResultKey},
+	}
+)
+
+const (
+	translationResultTranslated = "translated"
+	translationResultReused     = "reused"
 )
 
 func init() {
-	_ = view.Register(envoySnapshotOutView)
+	_ = view.Register(envoySnapshotOutView, proxyTranslationTimeView)
 }
 
 // empty resources to give to envoy when a proxy was deleted
@@ -67,6 +87,12 @@ func measureResource(ctx context.Context, resource string, len int) {
 	}
 }
 
+func measureTranslationTime(ctx context.Context, result string, duration time.Duration) {
+	if ctxWithTags, err := tag.New(ctx, tag.Insert(translationResultKey, result)); err == nil {
+		stats.Record(ctxWithTags, proxyTranslationTime.M(float64(duration)/float64(time.Millisecond)))
+	}
+}
+
 // TODO(kdorosh) in follow up PR, update this interface so it can never error
 // It is logically invalid for us to return an error here (translation of resources always needs to
 // result in a xds snapshot, so we are resilient to pod restarts)
@@ -92,6 +118,8 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 	allReports.Accept(snap.UpstreamGroups.AsInputResources()...)
 	allReports.Accept(snap.Proxies.AsInputResources()...)
 
+	certExpiry.Update(snap)
+
 	if !s.settings.GetGloo().GetDisableProxyGarbageCollection().GetValue() {
 		allKeys := map[string]bool{
 			xds.FallbackNodeCacheKey: true,
@@ -116,52 +144,60 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 			}
 		}
 	}
-	for _, proxy := range snap.Proxies {
-		proxyCtx := ctx
-		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, proxy.GetMetadata().Ref().Key())); err == nil {
-			proxyCtx = ctxWithTags
-		}
+	// index the resources each proxy depends on, so that proxies whose inputs did not change
+	// since the last sync can reuse their previous translation
+	depIndex, err := newDependencyIndex(snap)
+	if err != nil {
+		logger.Warnw("failed to index proxy dependencies, translating all proxies", zap.Error(err))
+	}
+	inputResources := indexInputResources(snap)
 
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Snapshot: snap,
-		}
+	// translate proxies concurrently, bounded by the number of translation workers
+	results := make([]*proxySyncResult, len(snap.Proxies))
+	errs := make([]error, len(snap.Proxies))
+	workers := make(chan struct{}, s.translationWorkers)
+	var wg sync.WaitGroup
+	for i, proxy := range snap.Proxies {
+		i, proxy := i, proxy
+		wg.Add(1)
+		workers <- struct{}{}
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			results[i], errs[i] = s.syncProxy(ctx, snap, proxy, depIndex, inputResources)
+		}()
+	}
+	wg.Wait()
 
+	proxyTranslations := make(map[string]*proxyTranslation, len(snap.Proxies))
+	for i, proxy := range snap.Proxies {
 		// TODO(kdorosh) in follow up PR, update this interface so it can never error
 		// It is logically invalid for us to return an error here (translation of resources always needs to
 		// result in a xds snapshot, so we are resilient to pod restarts)
 		//
 		// for now this can only really fail on plugin initialization e.g. https://github.com/solo-io/gloo/blob/4f133dd2be0875463754fecc84c1eced7d4202fd/projects/gloo/pkg/plugins/consul/plugin.go#L134
 		// we are not in a very bad place today but should update this interface ASAP so we don't introduce new regressions
-		xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
-		if err != nil {
-			err := eris.Wrapf(err, "translation loop failed")
+		if errs[i] != nil {
+			err := eris.Wrapf(errs[i], "translation loop failed")
 			logger.DPanicw("", zap.Error(err))
 			return err
 		}
-
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
-		}
-
-		sanitizedSnapshot := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
-		// if the snapshot is not consistent, make it so
-		xdsSnapshot.MakeConsistent()
-
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config after xds sanitization", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
-		}
+		result := results[i]
+		proxyCtx := proxyContext(ctx, proxy)
 
 		// Merge reports after sanitization to capture changes made by the sanitizers
-		allReports.Merge(reports)
+		allReports.Merge(result.reports)
 		key := xds.SnapshotCacheKey(proxy)
-		s.xdsCache.SetSnapshot(key, sanitizedSnapshot)
+		s.xdsCache.SetSnapshot(key, result.snapshot)
+		proxyTranslations[key] = result.translation
 
 		// Record some metrics
-		clustersLen := len(xdsSnapshot.GetResources(resource.ClusterTypeV3).Items)
-		listenersLen := len(xdsSnapshot.GetResources(resource.ListenerTypeV3).Items)
-		routesLen := len(xdsSnapshot.GetResources(resource.RouteTypeV3).Items)
-		endpointsLen := len(xdsSnapshot.GetResources(resource.EndpointTypeV3).Items)
+		clustersLen := len(result.snapshot.GetResources(resource.ClusterTypeV3).Items)
+		listenersLen := len(result.snapshot.GetResources(resource.ListenerTypeV3).Items)
+		routesLen := len(result.snapshot.GetResources(resource.RouteTypeV3).Items)
+		endpointsLen := len(result.snapshot.GetResources(resource.EndpointTypeV3).Items)
 
 		measureResource(proxyCtx, "clusters", clustersLen)
 		measureResource(proxyCtx, "listeners", listenersLen)
@@ -174,14 +210,205 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 			"routes", routesLen,
 			"endpoints", endpointsLen)
 
-		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), xdsSnapshot)
+		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), result.snapshot)
 	}
+	// only remember the translations of proxies that still exist
+	s.proxyTranslations = proxyTranslations
 
 	logger.Debugf("gloo reports to be written: %v", allReports)
 
 	return nil
 }
 
+func proxyContext(ctx context.Context, proxy *v1.Proxy) context.Context {
+	if ctxWithTags, err := tag.New(ctx, tag.Insert(syncerstats.ProxyNameKey, proxy.GetMetadata().Ref().Key())); err == nil {
+		return ctxWithTags
+	}
+	return ctx
+}
+
+// proxySyncResult is the sanitized snapshot and reports of a proxy, and the translation they were sanitized from
+type proxySyncResult struct {
+	translation *proxyTranslation
+	snapshot    envoycache.Snapshot
+	reports     reporter.ResourceReports
+}
+
+// syncProxy translates a proxy, or reuses its previous translation, and sanitizes the result.
+// It is safe to call concurrently.
+func (s *translatorSyncer) syncProxy(
+	ctx context.Context,
+	snap *v1snap.ApiSnapshot,
+	proxy *v1.Proxy,
+	depIndex *dependencyIndex,
+	inputResources map[string]resources.InputResource,
+) (*proxySyncResult, error) {
+	logger := contextutils.LoggerFrom(ctx)
+
+	translation, err := s.translateProxy(ctx, snap, proxy, depIndex, inputResources)
+	if err != nil {
+		return nil, err
+	}
+
+	// the translation is kept unsanitized, as sanitization depends on the reports of all upstreams
+	reports := copyReports(translation.reports)
+	xdsSnapshot := copySnapshot(translation.snapshot)
+
+	if validateErr := reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+	}
+
+	sanitizedSnapshot := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
+	// if the snapshot is not consistent, make it so
+	xdsSnapshot.MakeConsistent()
+
+	if validateErr := reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config after xds sanitization", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+	}
+
+	return &proxySyncResult{
+		translation: translation,
+		snapshot:    sanitizedSnapshot,
+		reports:     reports,
+	}, nil
+}
+
+// translateProxy translates a single proxy, or returns its previous translation if neither the proxy nor the
+// resources it references changed since. If only the upstreams or endpoints of the snapshot changed, the previous
+// translation is returned with its clusters and endpoints replaced. It is safe to call concurrently.
+func (s *translatorSyncer) translateProxy(
+	ctx context.Context,
+	snap *v1snap.ApiSnapshot,
+	proxy *v1.Proxy,
+	depIndex *dependencyIndex,
+	inputResources map[string]resources.InputResource,
+) (*proxyTranslation, error) {
+	logger := contextutils.LoggerFrom(ctx)
+	proxyCtx := proxyContext(ctx, proxy)
+	start := time.Now()
+
+	// without a hash of its inputs, the proxy is translated, and its translation is not reused later
+	hashed := false
+	var inputsHash, clustersHash uint64
+	if depIndex != nil {
+		var err error
+		inputsHash, err = depIndex.InputsHash(proxy)
+		if err != nil {
+			logger.Warnw("failed to hash proxy dependencies", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(err))
+		} else {
+			hashed = true
+			clustersHash = depIndex.clustersHash
+		}
+	}
+
+	// proxyTranslations is only written after all proxies are translated, so it is safe to read here
+	previous, ok := s.proxyTranslations[xds.SnapshotCacheKey(proxy)]
+	if ok && hashed && previous.hashed && previous.inputsHash == inputsHash {
+		if translation, ok := s.reuseTranslation(ctx, snap, proxy, previous, clustersHash, inputResources); ok {
+			logger.Debugw("Reusing previous translation of unchanged proxy", zap.Any("proxy", proxy.GetMetadata().Ref()))
+			measureTranslationTime(proxyCtx, translationResultReused, time.Since(start))
+			return translation, nil
+		}
+	}
+
+	params := plugins.Params{
+		Ctx:      proxyCtx,
+		Snapshot: snap,
+	}
+
+	xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	measureTranslationTime(proxyCtx, translationResultTranslated, time.Since(start))
+
+	upstreamClusters, upstreamEndpoints := upstreamResourceNames(snap, xdsSnapshot)
+	return &proxyTranslation{
+		hashed:            hashed,
+		inputsHash:        inputsHash,
+		clustersHash:      clustersHash,
+		snapshot:          xdsSnapshot,
+		reports:           copyReports(reports),
+		upstreamClusters:  upstreamClusters,
+		upstreamEndpoints: upstreamEndpoints,
+	}, nil
+}
+
+// reuseTranslation returns the previous translation of a proxy whose inputs did not change, with the clusters and
+// endpoints of the current snapshot if they changed since. It returns false if the translation cannot be reused.
+func (s *translatorSyncer) reuseTranslation(
+	ctx context.Context,
+	snap *v1snap.ApiSnapshot,
+	proxy *v1.Proxy,
+	previous *proxyTranslation,
+	clustersHash uint64,
+	inputResources map[string]resources.InputResource,
+) (*proxyTranslation, bool) {
+	reports, ok := remapReports(previous.reports, inputResources)
+	if !ok {
+		return nil, false
+	}
+	if previous.clustersHash == clustersHash {
+		reused := *previous
+		reused.reports = reports
+		return &reused, true
+	}
+
+	clusterResult, err := s.translateClusters(ctx, snap, proxy)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to translate clusters", zap.Error(err))
+		return nil, false
+	}
+
+	// the reports on upstreams are replaced with those of the current clusters
+	for res := range reports {
+		if isClusterReport(res) {
+			delete(reports, res)
+		}
+	}
+	reports.Merge(clusterResult.reports)
+
+	xdsSnapshot := previous.withClusters(clusterResult)
+	upstreamClusters, upstreamEndpoints := upstreamResourceNames(snap, xdsSnapshot)
+	return &proxyTranslation{
+		hashed:            true,
+		inputsHash:        previous.inputsHash,
+		clustersHash:      clustersHash,
+		snapshot:          xdsSnapshot,
+		reports:           reports,
+		upstreamClusters:  upstreamClusters,
+		upstreamEndpoints: upstreamEndpoints,
+	}, true
+}
+
+// translateClusters translates the clusters and endpoints of the upstreams of the snapshot for a proxy, by
+// translating a copy of the proxy without listeners.
+func (s *translatorSyncer) translateClusters(ctx context.Context, snap *v1snap.ApiSnapshot, proxy *v1.Proxy) (*clusterTranslation, error) {
+	clusterProxy := &v1.Proxy{
+		Metadata: proxy.GetMetadata(),
+	}
+	params := plugins.Params{
+		Ctx:      proxyContext(ctx, proxy),
+		Snapshot: snap,
+	}
+	xdsSnapshot, reports, _, err := s.translator.Translate(params, clusterProxy)
+	if err != nil {
+		return nil, err
+	}
+	clusterReports := make(reporter.ResourceReports)
+	for res, report := range reports {
+		if isClusterReport(res) {
+			clusterReports[res] = report
+		}
+	}
+	return &clusterTranslation{
+		clusters:  xdsSnapshot.GetResources(resource.ClusterTypeV3),
+		endpoints: xdsSnapshot.GetResources(resource.EndpointTypeV3),
+		reports:   clusterReports,
+	}, nil
+}
+
 // TODO(ilackarms): move this somewhere else, make it part of dev-mode
 func (s *translatorSyncer) ServeXdsSnapshots() error {
 	r := mux.NewRouter()
@@ -0,0 +1,450 @@
+package syncer
+
+import (
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"sort"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/hashutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	resourceRefDescriptor = (&core.ResourceRef{}).ProtoReflect().Descriptor().FullName()
+	destinationDescriptor = (&v1.Destination{}).ProtoReflect().Descriptor().FullName()
+)
+
+// proxyDependencies are the resources, referenced by a Proxy, that the translation of its listeners depends on.
+type proxyDependencies struct {
+	Upstreams        v1.UpstreamList
+	UpstreamGroups   v1.UpstreamGroupList
+	Secrets          v1.SecretList
+	Artifacts        v1.ArtifactList
+	AuthConfigs      extauthv1.AuthConfigList
+	RateLimitConfigs ratelimit.RateLimitConfigList
+	GraphqlApis      v1beta1.GraphQLApiList
+
+	// seen holds the kind and ref of each dependency, to avoid duplicates
+	seen map[string]struct{}
+}
+
+func (deps *proxyDependencies) add(res resources.Resource) bool {
+	if deps.seen == nil {
+		deps.seen = map[string]struct{}{}
+	}
+	key := resources.Kind(res) + "/" + res.GetMetadata().Ref().Key()
+	if _, ok := deps.seen[key]; ok {
+		return false
+	}
+	deps.seen[key] = struct{}{}
+	return true
+}
+
+// Hash returns a hash of the dependencies, independent of the order in which they were referenced
+func (deps *proxyDependencies) Hash(hasher hash.Hash64) (uint64, error) {
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	upstreams := append(v1.UpstreamList{}, deps.Upstreams...)
+	upstreams.Sort()
+	upstreamGroups := append(v1.UpstreamGroupList{}, deps.UpstreamGroups...)
+	upstreamGroups.Sort()
+	secrets := append(v1.SecretList{}, deps.Secrets...)
+	secrets.Sort()
+	artifacts := append(v1.ArtifactList{}, deps.Artifacts...)
+	artifacts.Sort()
+	authConfigs := append(extauthv1.AuthConfigList{}, deps.AuthConfigs...)
+	authConfigs.Sort()
+	rateLimitConfigs := append(ratelimit.RateLimitConfigList{}, deps.RateLimitConfigs...)
+	rateLimitConfigs.Sort()
+	graphqlApis := append(v1beta1.GraphQLApiList{}, deps.GraphqlApis...)
+	graphqlApis.Sort()
+
+	var values []interface{}
+	values = append(values, upstreams.AsInterfaces()...)
+	values = append(values, upstreamGroups.AsInterfaces()...)
+	values = append(values, secrets.AsInterfaces()...)
+	values = append(values, artifacts.AsInterfaces()...)
+	values = append(values, authConfigs.AsInterfaces()...)
+	values = append(values, rateLimitConfigs.AsInterfaces()...)
+	values = append(values, graphqlApis.AsInterfaces()...)
+	return hashAll(hasher, values...)
+}
+
+// hashAll hashes the values in order. Unlike hashutils.HashAllSafe, it returns the errors of hashing the values,
+// so that two different inputs cannot both hash to 0.
+func hashAll(hasher hash.Hash64, values ...interface{}) (uint64, error) {
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	for _, value := range values {
+		safeHasher, ok := value.(hashutils.SafeHasher)
+		if !ok {
+			return 0, eris.Errorf("cannot hash %T", value)
+		}
+		if _, err := safeHasher.Hash(hasher); err != nil {
+			return 0, err
+		}
+	}
+	return hasher.Sum64(), nil
+}
+
+// dependencyIndex resolves the dependencies of proxies against a single ApiSnapshot.
+//
+// Every proxy is translated with a cluster for every Upstream in the snapshot, and its endpoints. Those are
+// the same for all proxies, and depend on all Upstreams and Endpoints (and on the resources those Upstreams
+// reference), so they are hashed once per snapshot. The listeners and routes of a proxy only depend on the
+// resources it references, which are resolved from the references found in each Proxy.
+type dependencyIndex struct {
+	upstreams        map[string]*v1.Upstream
+	upstreamGroups   map[string]*v1.UpstreamGroup
+	secrets          map[string]*v1.Secret
+	artifacts        map[string]*v1.Artifact
+	authConfigs      map[string]*extauthv1.AuthConfig
+	rateLimitConfigs map[string]*ratelimit.RateLimitConfig
+	graphqlApis      map[string]*v1beta1.GraphQLApi
+
+	// clustersHash is the hash of the inputs of the clusters and endpoints of every proxy
+	clustersHash uint64
+}
+
+func newDependencyIndex(snap *v1snap.ApiSnapshot) (*dependencyIndex, error) {
+	idx := &dependencyIndex{
+		upstreams:        make(map[string]*v1.Upstream, len(snap.Upstreams)),
+		upstreamGroups:   make(map[string]*v1.UpstreamGroup, len(snap.UpstreamGroups)),
+		secrets:          make(map[string]*v1.Secret, len(snap.Secrets)),
+		artifacts:        make(map[string]*v1.Artifact, len(snap.Artifacts)),
+		authConfigs:      make(map[string]*extauthv1.AuthConfig, len(snap.AuthConfigs)),
+		rateLimitConfigs: make(map[string]*ratelimit.RateLimitConfig, len(snap.Ratelimitconfigs)),
+		graphqlApis:      make(map[string]*v1beta1.GraphQLApi, len(snap.GraphqlApis)),
+	}
+	for _, us := range snap.Upstreams {
+		idx.upstreams[us.GetMetadata().Ref().Key()] = us
+	}
+	for _, ug := range snap.UpstreamGroups {
+		idx.upstreamGroups[ug.GetMetadata().Ref().Key()] = ug
+	}
+	for _, secret := range snap.Secrets {
+		idx.secrets[secret.GetMetadata().Ref().Key()] = secret
+	}
+	for _, artifact := range snap.Artifacts {
+		idx.artifacts[artifact.GetMetadata().Ref().Key()] = artifact
+	}
+	for _, authConfig := range snap.AuthConfigs {
+		idx.authConfigs[authConfig.GetMetadata().Ref().Key()] = authConfig
+	}
+	for _, rateLimitConfig := range snap.Ratelimitconfigs {
+		idx.rateLimitConfigs[rateLimitConfig.GetMetadata().Ref().Key()] = rateLimitConfig
+	}
+	for _, graphqlApi := range snap.GraphqlApis {
+		idx.graphqlApis[graphqlApi.GetMetadata().Ref().Key()] = graphqlApi
+	}
+
+	// upstreams may reference secrets (e.g. for TLS) and artifacts
+	upstreamDeps := &proxyDependencies{}
+	for _, us := range snap.Upstreams {
+		idx.resolveRefs(us, upstreamDeps)
+	}
+
+	// upstream groups are verified, and reported on, with the clusters
+	var values []interface{}
+	values = append(values, snap.Upstreams.AsInterfaces()...)
+	values = append(values, snap.Endpoints.AsInterfaces()...)
+	values = append(values, snap.UpstreamGroups.AsInterfaces()...)
+	values = append(values, upstreamDeps)
+	clustersHash, err := hashAll(nil, values...)
+	if err != nil {
+		return nil, err
+	}
+	idx.clustersHash = clustersHash
+
+	return idx, nil
+}
+
+// Dependencies returns the resources in the snapshot which are referenced by the proxy.
+func (idx *dependencyIndex) Dependencies(proxy *v1.Proxy) *proxyDependencies {
+	deps := &proxyDependencies{}
+	idx.resolveRefs(proxy, deps)
+	// upstream groups reference upstreams in turn
+	for _, ug := range deps.UpstreamGroups {
+		idx.resolveRefs(ug, deps)
+	}
+	return deps
+}
+
+// InputsHash returns a hash of the proxy and the resources it references.
+// If it is unchanged between two snapshots, so are the listeners and routes of the proxy.
+func (idx *dependencyIndex) InputsHash(proxy *v1.Proxy) (uint64, error) {
+	return hashAll(nil, proxy, idx.Dependencies(proxy))
+}
+
+// resolveRefs finds every ResourceRef and Destination in the message and adds the snapshot resources they point to.
+// A ResourceRef does not carry the kind of resource it references, so a ref matching resources of
+// several kinds adds all of them; depending on too much only costs an unnecessary translation.
+func (idx *dependencyIndex) resolveRefs(msg proto.Message, deps *proxyDependencies) {
+	for _, ref := range collectResourceRefs(msg) {
+		key := ref.Key()
+		if us, ok := idx.upstreams[key]; ok && deps.add(us) {
+			deps.Upstreams = append(deps.Upstreams, us)
+		}
+		if ug, ok := idx.upstreamGroups[key]; ok && deps.add(ug) {
+			deps.UpstreamGroups = append(deps.UpstreamGroups, ug)
+		}
+		if secret, ok := idx.secrets[key]; ok && deps.add(secret) {
+			deps.Secrets = append(deps.Secrets, secret)
+		}
+		if artifact, ok := idx.artifacts[key]; ok && deps.add(artifact) {
+			deps.Artifacts = append(deps.Artifacts, artifact)
+		}
+		if authConfig, ok := idx.authConfigs[key]; ok && deps.add(authConfig) {
+			deps.AuthConfigs = append(deps.AuthConfigs, authConfig)
+		}
+		if rateLimitConfig, ok := idx.rateLimitConfigs[key]; ok && deps.add(rateLimitConfig) {
+			deps.RateLimitConfigs = append(deps.RateLimitConfigs, rateLimitConfig)
+		}
+		if graphqlApi, ok := idx.graphqlApis[key]; ok && deps.add(graphqlApi) {
+			deps.GraphqlApis = append(deps.GraphqlApis, graphqlApi)
+		}
+	}
+}
+
+// collectResourceRefs returns every core.ResourceRef set anywhere in the message, as well as the refs of the
+// upstreams which kube and consul destinations are translated to
+func collectResourceRefs(msg proto.Message) []*core.ResourceRef {
+	var refs []*core.ResourceRef
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		switch m.Descriptor().FullName() {
+		case resourceRefDescriptor:
+			if ref, ok := m.Interface().(*core.ResourceRef); ok {
+				refs = append(refs, ref)
+			}
+			return
+		case destinationDescriptor:
+			if dest, ok := m.Interface().(*v1.Destination); ok {
+				if ref, err := upstreams.DestinationToUpstreamRef(dest); err == nil {
+					refs = append(refs, ref)
+				}
+			}
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsList() && fd.Message() != nil:
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					walk(list.Get(i).Message())
+				}
+			case fd.IsMap() && fd.MapValue().Message() != nil:
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					walk(mv.Message())
+					return true
+				})
+			case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+				walk(v.Message())
+			}
+			return true
+		})
+	}
+	walk(msg.ProtoReflect())
+	return refs
+}
+
+// proxyTranslation is the result of translating a single proxy, before it is sanitized
+type proxyTranslation struct {
+	// hashed is false if the inputs of the proxy could not be hashed, in which case the translation is not reused
+	hashed     bool
+	inputsHash uint64
+	// clustersHash is the hash of the inputs of the clusters and endpoints the proxy was translated with
+	clustersHash uint64
+
+	snapshot envoycache.Snapshot
+	reports  reporter.ResourceReports
+
+	// the names of the clusters and endpoints in the snapshot which were translated from the upstreams of the
+	// ApiSnapshot, rather than generated for the proxy
+	upstreamClusters  map[string]struct{}
+	upstreamEndpoints map[string]struct{}
+}
+
+// clusterTranslation holds the clusters and endpoints translated from the upstreams of an ApiSnapshot,
+// which are part of the translation of every proxy, and the reports on the upstreams and upstream groups.
+type clusterTranslation struct {
+	clusters  envoycache.Resources
+	endpoints envoycache.Resources
+	reports   reporter.ResourceReports
+}
+
+// upstreamResourceNames returns the names of the clusters and endpoints in the xds snapshot which are translated
+// from the upstreams of the ApiSnapshot
+func upstreamResourceNames(snap *v1snap.ApiSnapshot, xdsSnapshot envoycache.Snapshot) (map[string]struct{}, map[string]struct{}) {
+	clusterNames := map[string]struct{}{}
+	endpointNames := map[string]struct{}{}
+	clusters := xdsSnapshot.GetResources(resource.ClusterTypeV3).Items
+	for _, us := range snap.Upstreams {
+		clusterName := translator.UpstreamToClusterName(us.GetMetadata().Ref())
+		res, ok := clusters[clusterName]
+		if !ok {
+			continue
+		}
+		clusterNames[clusterName] = struct{}{}
+		endpointName := clusterName
+		if cluster, ok := res.ResourceProto().(*envoy_config_cluster_v3.Cluster); ok && cluster.GetEdsClusterConfig().GetServiceName() != "" {
+			endpointName = cluster.GetEdsClusterConfig().GetServiceName()
+		}
+		endpointNames[endpointName] = struct{}{}
+	}
+	return clusterNames, endpointNames
+}
+
+// withClusters returns the snapshot of a previous translation, with the clusters and endpoints that were translated
+// from upstreams replaced by those of the current ApiSnapshot
+func (t *proxyTranslation) withClusters(clusters *clusterTranslation) envoycache.Snapshot {
+	newClusters := replaceItems(t.snapshot.GetResources(resource.ClusterTypeV3).Items, t.upstreamClusters, clusters.clusters.Items)
+	newEndpoints := replaceItems(t.snapshot.GetResources(resource.EndpointTypeV3).Items, t.upstreamEndpoints, clusters.endpoints.Items)
+	clustersVersion := resourcesVersion(newClusters)
+	endpointsVersion := resourcesVersion(newEndpoints)
+
+	// as in translation, a new version of the clusters comes with a new version of the endpoints, so the clusters are warm
+	return xds.NewSnapshotFromResources(
+		envoycache.Resources{Version: fmt.Sprintf("%v-%v", clustersVersion, endpointsVersion), Items: newEndpoints},
+		envoycache.Resources{Version: fmt.Sprintf("%v", clustersVersion), Items: newClusters},
+		t.snapshot.GetResources(resource.RouteTypeV3),
+		t.snapshot.GetResources(resource.ListenerTypeV3),
+	)
+}
+
+// replaceItems returns the items that are not replaced, together with the replacements
+func replaceItems(items map[string]envoycache.Resource, replaced map[string]struct{}, replacements map[string]envoycache.Resource) map[string]envoycache.Resource {
+	out := make(map[string]envoycache.Resource, len(items)+len(replacements))
+	for name, item := range items {
+		if _, ok := replaced[name]; !ok {
+			out[name] = item
+		}
+	}
+	for name, item := range replacements {
+		out[name] = item
+	}
+	return out
+}
+
+func resourcesVersion(items map[string]envoycache.Resource) uint64 {
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]envoycache.Resource, 0, len(items))
+	for _, name := range names {
+		list = append(list, items[name])
+	}
+	return translator.EnvoyCacheResourcesListToFnvHash(list)
+}
+
+// copySnapshot returns a copy of the xds snapshot which sanitizers can modify without changing the original.
+// Sanitizers add and remove resources, but do not modify them, so the resources themselves are not copied.
+func copySnapshot(snap envoycache.Snapshot) envoycache.Snapshot {
+	envoySnap, ok := snap.(*xds.EnvoySnapshot)
+	if !ok || envoySnap == nil {
+		return snap
+	}
+	copyResources := func(res envoycache.Resources) envoycache.Resources {
+		items := make(map[string]envoycache.Resource, len(res.Items))
+		for name, item := range res.Items {
+			items[name] = item
+		}
+		return envoycache.Resources{Version: res.Version, Items: items}
+	}
+	return xds.NewSnapshotFromResources(
+		copyResources(envoySnap.Endpoints),
+		copyResources(envoySnap.Clusters),
+		copyResources(envoySnap.Routes),
+		copyResources(envoySnap.Listeners),
+	)
+}
+
+// copyReports returns a copy of the reports, which can be merged into and modified without changing the original
+func copyReports(reports reporter.ResourceReports) reporter.ResourceReports {
+	out := make(reporter.ResourceReports, len(reports))
+	for res, report := range reports {
+		out[res] = copyReport(report)
+	}
+	return out
+}
+
+func copyReport(report reporter.Report) reporter.Report {
+	out := reporter.Report{
+		Warnings: append([]string(nil), report.Warnings...),
+		Errors:   report.Errors,
+	}
+	if errs, ok := report.Errors.(*multierror.Error); ok && errs != nil {
+		out.Errors = &multierror.Error{
+			Errors:      append([]error(nil), errs.Errors...),
+			ErrorFormat: errs.ErrorFormat,
+		}
+	}
+	return out
+}
+
+// remapReports rekeys reports from a previous translation onto the resources of the current snapshot.
+// Reports are keyed by resource pointer, and a new snapshot carries new pointers for unchanged resources.
+// It returns false if a reported resource cannot be found in the current snapshot.
+func remapReports(previous reporter.ResourceReports, current map[string]resources.InputResource) (reporter.ResourceReports, bool) {
+	remapped := make(reporter.ResourceReports, len(previous))
+	for res, report := range previous {
+		currentRes, ok := current[inputResourceKey(res)]
+		if !ok {
+			return nil, false
+		}
+		remapped[currentRes] = copyReport(report)
+	}
+	return remapped, true
+}
+
+// indexInputResources indexes the resources of the snapshot which translation reports on
+func indexInputResources(snap *v1snap.ApiSnapshot) map[string]resources.InputResource {
+	index := map[string]resources.InputResource{}
+	for _, list := range []resources.InputResourceList{
+		snap.Proxies.AsInputResources(),
+		snap.Upstreams.AsInputResources(),
+		snap.UpstreamGroups.AsInputResources(),
+		snap.AuthConfigs.AsInputResources(),
+		snap.Ratelimitconfigs.AsInputResources(),
+		snap.GraphqlApis.AsInputResources(),
+	} {
+		for _, res := range list {
+			index[inputResourceKey(res)] = res
+		}
+	}
+	return index
+}
+
+func inputResourceKey(res resources.InputResource) string {
+	return resources.Kind(res) + "/" + res.GetMetadata().Ref().Key()
+}
+
+// isClusterReport returns true for the kinds of resources which are reported on when translating clusters
+func isClusterReport(res resources.InputResource) bool {
+	switch res.(type) {
+	case *v1.Upstream, *v1.UpstreamGroup:
+		return true
+	}
+	return false
+}
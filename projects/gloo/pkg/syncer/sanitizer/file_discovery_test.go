@@ -0,0 +1,101 @@
+package sanitizer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/file_discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	filediscovery "github.com/solo-io/gloo/projects/gloo/pkg/plugins/file_discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+)
+
+var _ = Describe("UpstreamRemovingSanitizer with file discovery", func() {
+
+	It("keeps the cluster of an upstream whose endpoint file is invalid", func() {
+		ctx := context.Background()
+		settings := &v1.Settings{
+			Discovery: &v1.Settings_DiscoveryOptions{
+				FileOptions: &v1.Settings_DiscoveryOptions_FileOptions{Directory: "/endpoints"},
+			},
+		}
+		memoryClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		registeredPlugins := registry.Plugins(bootstrap.Opts{
+			Settings:  settings,
+			Secrets:   memoryClientFactory,
+			Upstreams: memoryClientFactory,
+		})
+		tr := translator.NewTranslator(utils.NewSslConfigTranslator(), settings, func(ctx context.Context) plugins.PluginRegistry {
+			return registry.NewPluginRegistry(registeredPlugins)
+		})
+
+		upstream := &v1.Upstream{
+			Metadata: &core.Metadata{
+				Name:        "petstore",
+				Namespace:   "gloo-system",
+				Annotations: map[string]string{filediscovery.ValidationErrorAnnotation: "invalid endpoint file petstore.yaml"},
+			},
+			UpstreamType: &v1.Upstream_FileDiscovery{
+				FileDiscovery: &file_discovery.UpstreamSpec{ServiceName: "petstore"},
+			},
+		}
+		proxy := &v1.Proxy{
+			Metadata: &core.Metadata{Name: "proxy", Namespace: "gloo-system"},
+			Listeners: []*v1.Listener{{
+				Name:        "http-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    80,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: []*v1.VirtualHost{{
+							Name:    "petstore",
+							Domains: []string{"*"},
+							Routes: []*v1.Route{{
+								Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+								Action: &v1.Route_RouteAction{
+									RouteAction: &v1.RouteAction{
+										Destination: &v1.RouteAction_Single{
+											Single: &v1.Destination{
+												DestinationType: &v1.Destination_Upstream{Upstream: upstream.GetMetadata().Ref()},
+											},
+										},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			}},
+		}
+		glooSnapshot := &v1snap.ApiSnapshot{
+			Proxies:   v1.ProxyList{proxy},
+			Upstreams: v1.UpstreamList{upstream},
+		}
+
+		xdsSnapshot, reports, _, err := tr.Translate(plugins.Params{Ctx: ctx, Snapshot: glooSnapshot}, proxy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports[upstream].Errors).NotTo(HaveOccurred())
+		Expect(reports[upstream].Warnings).To(ConsistOf(ContainSubstring("invalid endpoint file petstore.yaml")))
+
+		snap := NewUpstreamRemovingSanitizer().SanitizeSnapshot(ctx, glooSnapshot, xdsSnapshot, reports)
+
+		clusterName := translator.UpstreamToClusterName(upstream.GetMetadata().Ref())
+		Expect(snap.GetResources(resource.ClusterTypeV3).Items).To(HaveKey(clusterName))
+	})
+})
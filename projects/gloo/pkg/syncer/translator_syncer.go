@@ -2,12 +2,15 @@ package syncer
 
 import (
 	"context"
+	"runtime"
+	"time"
 
 	"github.com/rotisserie/eris"
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/utils/metrics"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 
@@ -36,6 +39,10 @@ type translatorSyncer struct {
 	gatewaySyncer  *gwsyncer.TranslatorSyncer
 	proxyClient    v1.ProxyClient
 	writeNamespace string
+	// the maximum number of proxies translated concurrently
+	translationWorkers int
+	// the latest translation of each proxy, keyed by its xds cache key
+	proxyTranslations map[string]*proxyTranslation
 }
 
 type TranslatorSyncerExtensionParams struct {
@@ -88,6 +95,8 @@ func NewTranslatorSyncer(
 		gatewaySyncer:  gatewaySyncer,
 		proxyClient:    proxyClient,
 		writeNamespace: writeNamespace,
+
+		translationWorkers: runtime.GOMAXPROCS(0),
 	}
 	if devMode {
 		// TODO(ilackarms): move this somewhere else?
@@ -102,18 +111,20 @@ func NewTranslatorSyncer(
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) error {
 	logger := contextutils.LoggerFrom(ctx)
 	reports := make(reporter.ResourceReports)
+	certExpiryWarnings := certExpiryWarnings(snap.Secrets, time.Now(), s.certExpiryWarningThreshold())
 
 	// If gateway controller is enabled, run the gateway translation to generate proxies.
 	// Use the ProxyClient interface to persist them either to an in-memory store or etcd as configured at startup.
 	if s.gatewaySyncer != nil {
 		logger.Debugf("getting proxies from gateway translation")
-		s.translateProxies(ctx, snap)
+		s.translateProxies(ctx, snap, certExpiryWarnings)
 	}
 	var multiErr *multierror.Error
 	err := s.syncEnvoy(ctx, snap, reports)
 	if err != nil {
 		multiErr = multierror.Append(multiErr, err)
 	}
+	addUpstreamCertExpiryWarnings(snap.Upstreams, certExpiryWarnings, reports)
 	s.extensionKeys = map[string]struct{}{}
 	for _, extension := range s.extensions {
 		intermediateReports := make(reporter.ResourceReports)
@@ -139,7 +150,14 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) e
 	}
 	return multiErr.ErrorOrNil()
 }
-func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.ApiSnapshot) error {
+func (s *translatorSyncer) certExpiryWarningThreshold() time.Duration {
+	if threshold := s.settings.GetGloo().GetCertExpiryWarningThreshold(); threshold != nil {
+		return threshold.AsDuration()
+	}
+	return utils.DefaultCertExpiryWarningThreshold
+}
+
+func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.ApiSnapshot, certExpiryWarnings map[string]string) error {
 	gwSnap := &gatewayv1.ApiSnapshot{
 		VirtualServices:    snap.VirtualServices,
 		Gateways:           snap.Gateways,
@@ -148,7 +166,7 @@ func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.Ap
 		VirtualHostOptions: snap.VirtualHostOptions,
 		HttpGateways:       snap.HttpGateways,
 	}
-	err := s.gatewaySyncer.Sync(ctx, gwSnap)
+	err := s.gatewaySyncer.SyncWithCertExpiryWarnings(ctx, gwSnap, certExpiryWarnings)
 	proxyList, err := s.proxyClient.List(s.writeNamespace, clients.ListOpts{})
 	snap.Proxies = proxyList
 	return err
@@ -2,8 +2,13 @@ package syncer_test
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -14,10 +19,12 @@ import (
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	translatorpkg "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -133,6 +140,177 @@ var _ = Describe("Translate Proxy", func() {
 		Expect(sanitizer.Called).To(BeTrue())
 		Expect(xdsCache.SetSnap).To(BeEquivalentTo(sanitizer.Snap))
 	})
+
+	It("warns on the status of upstreams which use a secret with an expired cert", func() {
+		validFrom := time.Now().Add(-2 * time.Hour)
+		validFor := time.Hour
+		cert, key := gloohelpers.GetCerts(gloohelpers.Params{Hosts: "upstream", ValidFrom: &validFrom, ValidFor: &validFor})
+		secret := &v1.Secret{
+			Metadata: &core.Metadata{Name: "expired", Namespace: ns},
+			Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: cert, PrivateKey: key}},
+		}
+		upstream := &v1.Upstream{
+			Metadata:  &core.Metadata{Name: "upstream", Namespace: ns},
+			SslConfig: &v1.UpstreamSslConfig{SslSecrets: &v1.UpstreamSslConfig_SecretRef{SecretRef: secret.GetMetadata().Ref()}},
+		}
+		written, err := upstreamClient.Write(upstream, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		snap.Secrets = v1.SecretList{secret}
+		snap.Upstreams = v1.UpstreamList{written.(*v1.Upstream)}
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		read, err := upstreamClient.Read(ns, upstream.GetMetadata().GetName(), clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		status := statusClient.GetStatus(read.(*v1.Upstream))
+		Expect(status.GetState()).To(Equal(core.Status_Warning))
+		Expect(status.GetReason()).To(ContainSubstring("any-ns.expired"))
+		Expect(status.GetReason()).To(ContainSubstring("certificate which expired"))
+	})
+})
+
+var _ = Describe("Translate only changed proxies", func() {
+
+	var (
+		xdsCache    *recordingXdsCache
+		sanitizer   *MockXdsSanitizer
+		translator  *countingTranslator
+		syncer      v1snap.ApiSyncer
+		snap        *v1snap.ApiSnapshot
+		proxyClient v1.ProxyClient
+		ns          = "any-ns"
+		ref         = "syncer-test"
+	)
+
+	clusterNames := func(proxyName string) []string {
+		snapshot := xdsCache.snapshots[xds.SnapshotCacheKey(&v1.Proxy{Metadata: &core.Metadata{Namespace: ns, Name: proxyName}})]
+		var names []string
+		for name := range snapshot.GetResources(resource.ClusterTypeV3).Items {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		xdsCache = &recordingXdsCache{snapshots: map[string]envoycache.Snapshot{}}
+		sanitizer = &MockXdsSanitizer{}
+		translator = &countingTranslator{}
+
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, _ = v1.NewProxyClient(context.Background(), resourceClientFactory)
+		usClient, err := resourceClientFactory.NewResourceClient(context.Background(), factory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		statusClient := statusutils.GetStatusClientFromEnvOrDefault(ns)
+		statusMetrics, err := metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
+		Expect(err).NotTo(HaveOccurred())
+		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
+
+		// proxy1 terminates TLS with a secret and routes to an upstream, proxy2 does neither
+		proxy1 := &v1.Proxy{
+			Metadata: &core.Metadata{Namespace: ns, Name: "proxy1"},
+			Listeners: []*v1.Listener{{
+				Name: "listener",
+				SslConfigurations: []*v1.SslConfig{{
+					SslSecrets: &v1.SslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{Namespace: ns, Name: "tls"},
+					},
+				}},
+				ListenerType: &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{
+					VirtualHosts: []*v1.VirtualHost{{
+						Name: "vh",
+						Routes: []*v1.Route{{
+							Action: &v1.Route_RouteAction{RouteAction: &v1.RouteAction{
+								Destination: &v1.RouteAction_Single{Single: &v1.Destination{
+									DestinationType: &v1.Destination_Upstream{Upstream: &core.ResourceRef{Namespace: ns, Name: "routed"}},
+								}},
+							}},
+						}},
+					}},
+				}},
+			}},
+		}
+		proxy2 := &v1.Proxy{
+			Metadata: &core.Metadata{Namespace: ns, Name: "proxy2"},
+			Listeners: []*v1.Listener{{
+				Name:         "listener",
+				ListenerType: &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{}},
+			}},
+		}
+		for _, proxy := range []*v1.Proxy{proxy1, proxy2} {
+			_, err = proxyClient.Write(proxy, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		snap = &v1snap.ApiSnapshot{
+			Proxies: v1.ProxyList{proxy1, proxy2},
+			Secrets: v1.SecretList{{
+				Metadata: &core.Metadata{Namespace: ns, Name: "tls"},
+				Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert"}},
+			}},
+			Upstreams: v1.UpstreamList{
+				{Metadata: &core.Metadata{Namespace: ns, Name: "routed"}},
+				{Metadata: &core.Metadata{Namespace: ns, Name: "unrouted"}},
+			},
+		}
+
+		syncer = NewTranslatorSyncer(translator, xdsCache, xds.NewNodeRoleHasher(), sanitizer, rep, false, nil, &v1.Settings{}, statusMetrics, nil, proxyClient, "")
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(translator.Translated()).To(ConsistOf("proxy1", "proxy2"))
+	})
+
+	It("reuses the translation of proxies whose inputs did not change", func() {
+		newSnap := snap.Clone()
+		err := syncer.Sync(context.Background(), &newSnap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(translator.Translated()).To(ConsistOf("proxy1", "proxy2"))
+	})
+
+	It("sanitizes reused translations", func() {
+		newSnap := snap.Clone()
+		sanitizer.Called = false
+		err := syncer.Sync(context.Background(), &newSnap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sanitizer.Called).To(BeTrue())
+	})
+
+	It("re-translates only the proxies that reference a changed secret", func() {
+		newSnap := snap.Clone()
+		newSnap.Secrets[0].GetTls().CertChain = "rotated"
+		err := syncer.Sync(context.Background(), &newSnap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(translator.Translated()).To(ConsistOf("proxy1", "proxy2", "proxy1"))
+	})
+
+	It("re-translates only the proxies that reference a changed upstream", func() {
+		newSnap := snap.Clone()
+		newSnap.Upstreams[0].Metadata.Labels = map[string]string{"changed": "true"}
+		err := syncer.Sync(context.Background(), &newSnap)
+		Expect(err).NotTo(HaveOccurred())
+		// only the clusters are translated again for the proxy that does not reference the upstream
+		Expect(translator.Translated()).To(ConsistOf("proxy1", "proxy2", "proxy1", "proxy2/clusters"))
+	})
+
+	It("replaces the clusters of reused translations when unreferenced upstreams change", func() {
+		newSnap := snap.Clone()
+		newSnap.Upstreams[1] = &v1.Upstream{Metadata: &core.Metadata{Namespace: ns, Name: "added"}}
+		newSnap.Endpoints = v1.EndpointList{{
+			Metadata:  &core.Metadata{Namespace: ns, Name: "ep"},
+			Upstreams: []*core.ResourceRef{{Namespace: ns, Name: "added"}},
+		}}
+		err := syncer.Sync(context.Background(), &newSnap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(translator.Translated()).To(ConsistOf("proxy1", "proxy2", "proxy1/clusters", "proxy2/clusters"))
+
+		addedCluster := translatorpkg.UpstreamToClusterName(&core.ResourceRef{Namespace: ns, Name: "added"})
+		routedCluster := translatorpkg.UpstreamToClusterName(&core.ResourceRef{Namespace: ns, Name: "routed"})
+		Expect(clusterNames("proxy1")).To(ConsistOf(routedCluster, addedCluster, "generated-proxy1"))
+		Expect(clusterNames("proxy2")).To(ConsistOf(routedCluster, addedCluster, "generated-proxy2"))
+	})
 })
 
 var _ = Describe("Translate multiple proxies with errors", func() {
@@ -334,4 +512,51 @@ func (t *mockTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envo
 	return envoycache.NilSnapshot{}, nil, &validation.ProxyReport{}, nil
 }
 
+// countingTranslator records the names of the proxies it translated, suffixed with "/clusters" for proxies without
+// listeners. It translates each upstream to a cluster, and generates a cluster for each proxy with listeners.
+type countingTranslator struct {
+	lock       sync.Mutex
+	translated []string
+}
+
+func (t *countingTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	name := proxy.GetMetadata().GetName()
+	if len(proxy.GetListeners()) == 0 {
+		name += "/clusters"
+	}
+	t.lock.Lock()
+	t.translated = append(t.translated, name)
+	t.lock.Unlock()
+
+	var clusters []envoycache.Resource
+	for _, us := range params.Snapshot.Upstreams {
+		clusters = append(clusters, resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+			Name: translatorpkg.UpstreamToClusterName(us.GetMetadata().Ref()),
+		}))
+	}
+	if len(proxy.GetListeners()) > 0 {
+		clusters = append(clusters, resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+			Name: "generated-" + proxy.GetMetadata().GetName(),
+		}))
+	}
+	return xds.NewSnapshot("version", nil, clusters, nil, nil), reporter.ResourceReports{}, &validation.ProxyReport{}, nil
+}
+
+func (t *countingTranslator) Translated() []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return append([]string{}, t.translated...)
+}
+
+// recordingXdsCache records the snapshot set for each key
+type recordingXdsCache struct {
+	MockXdsCache
+	snapshots map[string]envoycache.Snapshot
+}
+
+func (c *recordingXdsCache) SetSnapshot(node string, snapshot envoycache.Snapshot) {
+	c.MockXdsCache.SetSnapshot(node, snapshot)
+	c.snapshots[node] = snapshot
+}
+
 var _ envoycache.SnapshotCache = &MockXdsCache{}
@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"fmt"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
@@ -47,7 +49,9 @@ func loadAssignmentForUpstream(
 	clusterEndpoints []*v1.Endpoint,
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.GetMetadata().Ref())
-	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	// endpoints are grouped by locality and priority, in the order in which each group is first seen
+	var localityEndpoints []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	localityIndex := map[string]int{}
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.GetMetadata().GetLabels(), "")
 		metadata = addAnnotations(metadata, addr.GetMetadata().GetAnnotations())
@@ -76,15 +80,50 @@ func loadAssignmentForUpstream(
 					Hostname:          addr.GetHostname(),
 				},
 			},
+			HealthStatus:        endpointHealthStatus(addr),
+			LoadBalancingWeight: addr.GetLoadBalancingWeight(),
+		}
+
+		locality := addr.GetLocality()
+		key := fmt.Sprintf("%v/%v/%v/%v", locality.GetRegion(), locality.GetZone(), locality.GetSubZone(), addr.GetPriority())
+		idx, ok := localityIndex[key]
+		if !ok {
+			idx = len(localityEndpoints)
+			localityIndex[key] = idx
+			localityEndpoints = append(localityEndpoints, &envoy_config_endpoint_v3.LocalityLbEndpoints{
+				Locality: envoyLocality(locality),
+				Priority: addr.GetPriority(),
+			})
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+		localityEndpoints[idx].LbEndpoints = append(localityEndpoints[idx].GetLbEndpoints(), &lbEndpoint)
+	}
+	if len(localityEndpoints) == 0 {
+		localityEndpoints = []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}}
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityEndpoints,
+	}
+}
+
+func envoyLocality(locality *v1.Locality) *envoy_config_core_v3.Locality {
+	if locality == nil {
+		return nil
+	}
+	return &envoy_config_core_v3.Locality{
+		Region:  locality.GetRegion(),
+		Zone:    locality.GetZone(),
+		SubZone: locality.GetSubZone(),
+	}
+}
+
+func endpointHealthStatus(endpoint *v1.Endpoint) envoy_config_core_v3.HealthStatus {
+	switch endpoint.GetHealthStatus() {
+	case v1.Endpoint_DRAINING:
+		return envoy_config_core_v3.HealthStatus_DRAINING
+	default:
+		return envoy_config_core_v3.HealthStatus_UNKNOWN
 	}
 }
 
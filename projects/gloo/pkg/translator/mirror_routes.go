@@ -0,0 +1,74 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// RequestMirrorPolicies returns the mirror policies of the route, or those of its virtual host if the route specifies
+// neither mirror policies nor shadowing.
+func RequestMirrorPolicies(virtualHost *v1.VirtualHost, route *v1.Route) []*shadowing.RequestMirrorPolicy {
+	if len(route.GetOptions().GetRequestMirrorPolicies()) > 0 || route.GetOptions().GetShadowing() != nil {
+		return route.GetOptions().GetRequestMirrorPolicies()
+	}
+	return virtualHost.GetOptions().GetRequestMirrorPolicies()
+}
+
+// Envoy does not match headers in mirror policies, so the routes of mirror policies with headers are expanded: for
+// each such policy, an additional route which also matches its headers is placed ahead of the route, and mirrors
+// requests to the policy as well as to the policies without headers. The mirror policies plugin ignores policies with
+// headers, so the route itself only mirrors requests to the policies without headers. The plugin still converts the
+// policies with headers, so that their errors are reported on the route, as the reports of the additional routes are
+// dropped.
+// The routes are not merged for requests which match the headers of several policies, as that would take a route for
+// each combination of policies. Envoy uses the first route which matches, so such requests are only mirrored to the
+// first of the policies whose headers they match, as documented on the headers of the policy.
+// Returns the envoy routes, and the routes to translate each of them from.
+func expandMirrorRoutes(
+	ctx context.Context,
+	virtualHost *v1.VirtualHost,
+	in *v1.Route,
+	out []*envoy_config_route_v3.Route,
+) ([]*v1.Route, []*envoy_config_route_v3.Route) {
+
+	var unconditional, conditional []*shadowing.RequestMirrorPolicy
+	if _, ok := in.GetAction().(*v1.Route_RouteAction); ok {
+		for _, policy := range RequestMirrorPolicies(virtualHost, in) {
+			if len(policy.GetHeaders()) > 0 {
+				conditional = append(conditional, policy)
+			} else {
+				unconditional = append(unconditional, policy)
+			}
+		}
+	}
+
+	var expandedIn []*v1.Route
+	var expandedOut []*envoy_config_route_v3.Route
+	for _, route := range out {
+		for i, policy := range conditional {
+			mirrorRoute := proto.Clone(route).(*envoy_config_route_v3.Route)
+			mirrorRoute.Name = fmt.Sprintf("%s-mirror-%d", route.GetName(), i)
+			mirrorRoute.GetMatch().Headers = append(mirrorRoute.GetMatch().GetHeaders(), utils.EnvoyHeaderMatchers(ctx, policy.GetHeaders())...)
+
+			matchedPolicy := proto.Clone(policy).(*shadowing.RequestMirrorPolicy)
+			matchedPolicy.Headers = nil
+			mirrorIn := proto.Clone(in).(*v1.Route)
+			if mirrorIn.GetOptions() == nil {
+				mirrorIn.Options = &v1.RouteOptions{}
+			}
+			mirrorIn.GetOptions().RequestMirrorPolicies = append(append([]*shadowing.RequestMirrorPolicy{}, unconditional...), matchedPolicy)
+
+			expandedIn = append(expandedIn, mirrorIn)
+			expandedOut = append(expandedOut, mirrorRoute)
+		}
+		expandedIn = append(expandedIn, in)
+		expandedOut = append(expandedOut, route)
+	}
+	return expandedIn, expandedOut
+}
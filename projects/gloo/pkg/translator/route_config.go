@@ -150,9 +150,16 @@ func (h *httpRouteConfigurationTranslator) envoyRoutes(
 ) []*envoy_config_route_v3.Route {
 
 	out := initRoutes(params, in, routeReport, generatedName)
+	inputs, out := expandMirrorRoutes(params.Ctx, params.VirtualHost, in, out)
 
 	for i := range out {
-		h.setAction(params, routeReport, in, out[i])
+		report := routeReport
+		if inputs[i] != in {
+			// the mirror routes are translated from copies of the route, which would report its errors and warnings
+			// again, so their reports are dropped
+			report = &validationapi.RouteReport{}
+		}
+		h.setAction(params, report, inputs[i], out[i])
 	}
 
 	return out
@@ -202,7 +209,7 @@ func initRoutes(
 // utility function to transform gloo matcher to envoy route matcher
 func GlooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) envoy_config_route_v3.RouteMatch {
 	match := envoy_config_route_v3.RouteMatch{
-		Headers:         envoyHeaderMatcher(ctx, matcher.GetHeaders()),
+		Headers:         utils.EnvoyHeaderMatchers(ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(ctx, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -567,38 +574,6 @@ func setEnvoyPathMatcher(ctx context.Context, in *matchers.Matcher, out *envoy_c
 	}
 }
 
-func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
-	var out []*envoy_config_route_v3.HeaderMatcher
-	for _, matcher := range in {
-
-		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
-			Name: matcher.GetName(),
-		}
-		if matcher.GetValue() == "" {
-			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
-				PresentMatch: true,
-			}
-		} else {
-			if matcher.GetRegex() {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
-				}
-			} else {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
-					ExactMatch: matcher.GetValue(),
-				}
-			}
-		}
-
-		if matcher.GetInvertMatch() {
-			envoyMatch.InvertMatch = true
-		}
-
-		out = append(out, envoyMatch)
-	}
-	return out
-}
-
 func envoyQueryMatcher(ctx context.Context, in []*matchers.QueryParameterMatcher) []*envoy_config_route_v3.QueryParameterMatcher {
 	var out []*envoy_config_route_v3.QueryParameterMatcher
 	for _, matcher := range in {
@@ -52,6 +52,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
@@ -59,6 +60,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	shadowingplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
@@ -800,6 +802,105 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("request mirror policies", func() {
+		It("should add a route which matches the headers of each mirror policy with headers", func() {
+			routes[0].Options = &v1.RouteOptions{
+				RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Percentage:      &wrappers.FloatValue{Value: 10},
+					},
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}},
+					},
+				},
+			}
+			translate()
+
+			envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+			Expect(envoyRoutes).To(HaveLen(2))
+
+			mirrorRoute := envoyRoutes[0]
+			Expect(mirrorRoute.GetName()).To(Equal(envoyRoutes[1].GetName() + "-mirror-0"))
+			Expect(mirrorRoute.GetMatch().GetHeaders()).To(HaveLen(1))
+			Expect(mirrorRoute.GetMatch().GetHeaders()[0].GetName()).To(Equal("x-mirror"))
+			Expect(mirrorRoute.GetMatch().GetHeaders()[0].GetExactMatch()).To(Equal("true"))
+			Expect(mirrorRoute.GetRoute().GetRequestMirrorPolicies()).To(HaveLen(2))
+			Expect(mirrorRoute.GetRoute().GetRequestMirrorPolicies()[1].GetRuntimeFraction()).To(BeNil())
+
+			Expect(envoyRoutes[1].GetMatch().GetHeaders()).To(BeEmpty())
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()[0].GetRuntimeFraction()).NotTo(BeNil())
+		})
+
+		It("should only mirror requests which match the headers of several policies to the first of them", func() {
+			routes[0].Options = &v1.RouteOptions{
+				RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Percentage:      &wrappers.FloatValue{Value: 50},
+						Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror"}},
+					},
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Percentage:      &wrappers.FloatValue{Value: 25},
+						Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "canary"}},
+					},
+				},
+			}
+			translate()
+
+			envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+			Expect(envoyRoutes).To(HaveLen(3))
+
+			// a request with the header x-mirror: canary matches the headers of both policies, but envoy uses the first
+			// route which matches it, which only mirrors to the first policy
+			Expect(envoyRoutes[0].GetName()).To(Equal(envoyRoutes[2].GetName() + "-mirror-0"))
+			Expect(envoyRoutes[0].GetMatch().GetHeaders()).To(HaveLen(1))
+			Expect(envoyRoutes[0].GetMatch().GetHeaders()[0].GetPresentMatch()).To(BeTrue())
+			Expect(envoyRoutes[0].GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(envoyRoutes[0].GetRoute().GetRequestMirrorPolicies()[0].GetRuntimeFraction().GetDefaultValue().GetNumerator()).To(BeEquivalentTo(500000))
+
+			Expect(envoyRoutes[1].GetName()).To(Equal(envoyRoutes[2].GetName() + "-mirror-1"))
+			Expect(envoyRoutes[1].GetMatch().GetHeaders()[0].GetExactMatch()).To(Equal("canary"))
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()[0].GetRuntimeFraction().GetDefaultValue().GetNumerator()).To(BeEquivalentTo(250000))
+
+			Expect(envoyRoutes[2].GetMatch().GetHeaders()).To(BeEmpty())
+			Expect(envoyRoutes[2].GetRoute().GetRequestMirrorPolicies()).To(BeEmpty())
+		})
+
+		It("should report the errors and warnings of a route with mirror policies with headers once", func() {
+			missingUpstream := &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+			routes[0].GetRouteAction().Destination = &v1.RouteAction_Single{
+				Single: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{Upstream: missingUpstream},
+				},
+			}
+			routes[0].Options = &v1.RouteOptions{
+				RequestMirrorPolicies: []*shadowing.RequestMirrorPolicy{
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Percentage:      &wrappers.FloatValue{Value: 150},
+						Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}},
+					},
+					{
+						DestinationType: &shadowing.RequestMirrorPolicy_Upstream{Upstream: upstream.Metadata.Ref()},
+						Headers:         []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "canary"}},
+					},
+				},
+			}
+			report := translateWithError()
+
+			routeReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+			Expect(routeReport.GetWarnings()).To(HaveLen(1))
+			Expect(routeReport.GetWarnings()[0].GetReason()).To(ContainSubstring("missing"))
+			Expect(routeReport.GetErrors()).To(HaveLen(1))
+			Expect(routeReport.GetErrors()[0].GetReason()).To(ContainSubstring(shadowingplugin.InvalidNumeratorError(150).Error()))
+		})
+	})
+
 	Context("non route_routeaction routes", func() {
 		var options *v1.RouteOptions
 		BeforeEach(func() {
@@ -1583,6 +1684,96 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group endpoints by locality", func() {
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints = v1.EndpointList{
+				{
+					Metadata:  &core.Metadata{Name: "a", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.4",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "zone-a"},
+				},
+				{
+					Metadata:     &core.Metadata{Name: "b", Namespace: "gloo-system"},
+					Upstreams:    []*core.ResourceRef{ref},
+					Address:      "1.2.3.5",
+					Port:         1234,
+					Locality:     &v1.Locality{Zone: "zone-b"},
+					HealthStatus: v1.Endpoint_DRAINING,
+				},
+				{
+					Metadata:  &core.Metadata{Name: "c", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.6",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "zone-a"},
+				},
+			}
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			endpoints := snapshot.GetResources(resource.EndpointTypeV3)
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			claConfiguration = endpoints.Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.GetEndpoints()).To(HaveLen(2))
+
+			zoneA := claConfiguration.GetEndpoints()[0]
+			Expect(zoneA.GetLocality().GetZone()).To(Equal("zone-a"))
+			Expect(zoneA.GetLbEndpoints()).To(HaveLen(2))
+			Expect(zoneA.GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_UNKNOWN))
+
+			zoneB := claConfiguration.GetEndpoints()[1]
+			Expect(zoneB.GetLocality().GetZone()).To(Equal("zone-b"))
+			Expect(zoneB.GetLbEndpoints()).To(HaveLen(1))
+			Expect(zoneB.GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_DRAINING))
+		})
+
+		It("should group endpoints by priority and set their weights", func() {
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints = v1.EndpointList{
+				{
+					Metadata:            &core.Metadata{Name: "a", Namespace: "gloo-system"},
+					Upstreams:           []*core.ResourceRef{ref},
+					Address:             "1.2.3.4",
+					Port:                1234,
+					LoadBalancingWeight: &wrappers.UInt32Value{Value: 10},
+				},
+				{
+					Metadata:  &core.Metadata{Name: "b", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.5",
+					Port:      1234,
+					Priority:  1,
+				},
+				{
+					Metadata:            &core.Metadata{Name: "c", Namespace: "gloo-system"},
+					Upstreams:           []*core.ResourceRef{ref},
+					Address:             "1.2.3.6",
+					Port:                1234,
+					LoadBalancingWeight: &wrappers.UInt32Value{Value: 30},
+				},
+			}
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			endpoints := snapshot.GetResources(resource.EndpointTypeV3)
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			claConfiguration = endpoints.Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.GetEndpoints()).To(HaveLen(2))
+
+			primary := claConfiguration.GetEndpoints()[0]
+			Expect(primary.GetPriority()).To(BeZero())
+			Expect(primary.GetLbEndpoints()).To(HaveLen(2))
+			Expect(primary.GetLbEndpoints()[0].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(10)))
+			Expect(primary.GetLbEndpoints()[1].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(30)))
+
+			secondary := claConfiguration.GetEndpoints()[1]
+			Expect(secondary.GetPriority()).To(Equal(uint32(1)))
+			Expect(secondary.GetLbEndpoints()).To(HaveLen(1))
+			Expect(secondary.GetLbEndpoints()[0].GetLoadBalancingWeight()).To(BeNil())
+		})
 	})
 
 	Context("when handling subsets", func() {
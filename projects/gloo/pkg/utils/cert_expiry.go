@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// DefaultCertExpiryWarningThreshold is how long before the certs of a TLS secret expire that gloo starts warning,
+// unless it is set in the settings
+const DefaultCertExpiryWarningThreshold = 30 * 24 * time.Hour
+
+var (
+	NoCertsFoundError = eris.New("no certificates found")
+
+	CertExpiredWarning = func(ref *core.ResourceRef, notAfter time.Time) string {
+		return fmt.Sprintf("TLS secret %v contains a certificate which expired at %v", ref.Key(), notAfter.UTC().Format(time.RFC3339))
+	}
+	CertExpiringWarning = func(ref *core.ResourceRef, notAfter time.Time) string {
+		return fmt.Sprintf("TLS secret %v contains a certificate which expires at %v", ref.Key(), notAfter.UTC().Format(time.RFC3339))
+	}
+)
+
+// GetCertsExpiry returns when the first of the PEM encoded certs expires
+func GetCertsExpiry(certs string) (time.Time, error) {
+	var notAfter time.Time
+	rest := []byte(certs)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, NoCertsFoundError
+	}
+	return notAfter, nil
+}
+
+// GetTlsSecretExpiry returns when the first of the certs in the cert chain or root CA of a TLS secret expires.
+// Returns false if the secret is not a TLS secret, or it contains no certs which can be parsed.
+func GetTlsSecretExpiry(secret *v1.Secret) (time.Time, bool) {
+	tlsSecret := secret.GetTls()
+	if tlsSecret == nil {
+		return time.Time{}, false
+	}
+	notAfter, err := GetCertsExpiry(tlsSecret.GetCertChain() + "\n" + tlsSecret.GetRootCa())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return notAfter, true
+}
+
+// CertExpiryWarning returns a warning if the certs of the TLS secret have expired, or expire within the threshold.
+// Returns an empty string if there is nothing to warn about, including when the secret is not a TLS secret.
+func CertExpiryWarning(secret *v1.Secret, now time.Time, threshold time.Duration) string {
+	notAfter, ok := GetTlsSecretExpiry(secret)
+	if !ok {
+		return ""
+	}
+	if remaining := notAfter.Sub(now); remaining <= 0 {
+		return CertExpiredWarning(secret.GetMetadata().Ref(), notAfter)
+	} else if remaining <= threshold {
+		return CertExpiringWarning(secret.GetMetadata().Ref(), notAfter)
+	}
+	return ""
+}
+
+// GetListenerSslConfigs returns the ssl configs of the listener, and of the tcp hosts and matched listeners within it
+func GetListenerSslConfigs(listener *v1.Listener) []*v1.SslConfig {
+	sslConfigs := append([]*v1.SslConfig{}, listener.GetSslConfigurations()...)
+	for _, tcpHost := range listener.GetTcpListener().GetTcpHosts() {
+		if tcpHost.GetSslConfig() != nil {
+			sslConfigs = append(sslConfigs, tcpHost.GetSslConfig())
+		}
+	}
+	for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+		sslConfigs = append(sslConfigs, matchedListener.GetSslConfigurations()...)
+		if matchedListener.GetMatcher().GetSslConfig() != nil {
+			sslConfigs = append(sslConfigs, matchedListener.GetMatcher().GetSslConfig())
+		}
+		for _, tcpHost := range matchedListener.GetTcpListener().GetTcpHosts() {
+			if tcpHost.GetSslConfig() != nil {
+				sslConfigs = append(sslConfigs, tcpHost.GetSslConfig())
+			}
+		}
+	}
+	return sslConfigs
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("CertExpiry", func() {
+
+	var (
+		now time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+	})
+
+	getCert := func(validFrom time.Time, validFor time.Duration) string {
+		cert, _ := gloohelpers.GetCerts(gloohelpers.Params{
+			Hosts:     "gateway-proxy,knative-proxy,ingress-proxy",
+			ValidFrom: &validFrom,
+			ValidFor:  &validFor,
+		})
+		return cert
+	}
+
+	tlsSecret := func(certChain, rootCa string) *v1.Secret {
+		return &v1.Secret{
+			Metadata: &core.Metadata{Name: "secret", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{
+					CertChain:  certChain,
+					PrivateKey: gloohelpers.PrivateKey(),
+					RootCa:     rootCa,
+				},
+			},
+		}
+	}
+
+	Context("GetCertsExpiry", func() {
+
+		It("returns when the first of the certs expires", func() {
+			first := getCert(now.Add(-time.Hour), 2*time.Hour)
+			second := getCert(now.Add(-time.Hour), 24*time.Hour)
+
+			notAfter, err := GetCertsExpiry(second + first)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notAfter).To(BeTemporally("~", now.Add(time.Hour), time.Second))
+		})
+
+		It("ignores blocks which are not certs", func() {
+			notAfter, err := GetCertsExpiry(gloohelpers.PrivateKey() + getCert(now, time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notAfter).To(BeTemporally("~", now.Add(time.Hour), time.Second))
+		})
+
+		It("errors when there are no certs", func() {
+			_, err := GetCertsExpiry(gloohelpers.PrivateKey())
+			Expect(err).To(MatchError(NoCertsFoundError))
+		})
+	})
+
+	Context("GetTlsSecretExpiry", func() {
+
+		It("includes the root ca", func() {
+			secret := tlsSecret(getCert(now, 24*time.Hour), getCert(now, time.Hour))
+
+			notAfter, ok := GetTlsSecretExpiry(secret)
+			Expect(ok).To(BeTrue())
+			Expect(notAfter).To(BeTemporally("~", now.Add(time.Hour), time.Second))
+		})
+
+		It("returns false for secrets which are not TLS secrets", func() {
+			secret := &v1.Secret{Kind: &v1.Secret_Aws{Aws: &v1.AwsSecret{}}}
+
+			_, ok := GetTlsSecretExpiry(secret)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("CertExpiryWarning", func() {
+
+		It("does not warn about certs which are far from expiry", func() {
+			secret := tlsSecret(getCert(now, 2*DefaultCertExpiryWarningThreshold), "")
+
+			Expect(CertExpiryWarning(secret, now, DefaultCertExpiryWarningThreshold)).To(BeEmpty())
+		})
+
+		It("warns about certs which expire within the threshold", func() {
+			secret := tlsSecret(getCert(now, 48*time.Hour), "")
+
+			Expect(CertExpiryWarning(secret, now, 24*time.Hour)).To(BeEmpty())
+			warning := CertExpiryWarning(secret, now, 72*time.Hour)
+			Expect(warning).To(ContainSubstring("TLS secret gloo-system.secret contains a certificate which expires at"))
+		})
+
+		It("warns about certs which have expired", func() {
+			secret := tlsSecret(getCert(now.Add(-48*time.Hour), 24*time.Hour), "")
+
+			warning := CertExpiryWarning(secret, now, DefaultCertExpiryWarningThreshold)
+			Expect(warning).To(ContainSubstring("TLS secret gloo-system.secret contains a certificate which expired at"))
+		})
+
+		It("does not warn about secrets which are not TLS secrets", func() {
+			secret := &v1.Secret{
+				Metadata: &core.Metadata{Name: "secret", Namespace: "gloo-system"},
+				Kind:     &v1.Secret_Header{Header: &v1.HeaderSecret{}},
+			}
+
+			Expect(CertExpiryWarning(secret, now, DefaultCertExpiryWarningThreshold)).To(BeEmpty())
+		})
+	})
+
+	Context("GetListenerSslConfigs", func() {
+
+		It("collects the ssl configs of tcp hosts and matched listeners", func() {
+			httpSsl := &v1.SslConfig{SniDomains: []string{"http"}}
+			tcpSsl := &v1.SslConfig{SniDomains: []string{"tcp"}}
+			matcherSsl := &v1.SslConfig{SniDomains: []string{"matcher"}}
+			listener := &v1.Listener{
+				SslConfigurations: []*v1.SslConfig{httpSsl},
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{{
+							Matcher: &v1.Matcher{SslConfig: matcherSsl},
+							ListenerType: &v1.MatchedListener_TcpListener{
+								TcpListener: &v1.TcpListener{
+									TcpHosts: []*v1.TcpHost{{SslConfig: tcpSsl}},
+								},
+							},
+						}},
+					},
+				},
+			}
+
+			Expect(GetListenerSslConfigs(listener)).To(Equal([]*v1.SslConfig{httpSsl, matcherSsl, tcpSsl}))
+		})
+	})
+})
@@ -3,7 +3,10 @@ package utils
 import (
 	"sort"
 
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	graphqlv1beta1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
 	sk_resources "github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -78,3 +81,43 @@ func ResourceListToSecretList(resourceList sk_resources.ResourceList) gloov1.Sec
 	}
 	return secretList
 }
+
+func ResourceListToUpstreamGroupList(resourceList sk_resources.ResourceList) gloov1.UpstreamGroupList {
+	var upstreamGroupList gloov1.UpstreamGroupList
+	for _, resource := range resourceList {
+		upstreamGroupList = append(upstreamGroupList, resource.(*gloov1.UpstreamGroup))
+	}
+	return upstreamGroupList
+}
+
+func ResourceListToArtifactList(resourceList sk_resources.ResourceList) gloov1.ArtifactList {
+	var artifactList gloov1.ArtifactList
+	for _, resource := range resourceList {
+		artifactList = append(artifactList, resource.(*gloov1.Artifact))
+	}
+	return artifactList
+}
+
+func ResourceListToAuthConfigList(resourceList sk_resources.ResourceList) extauthv1.AuthConfigList {
+	var authConfigList extauthv1.AuthConfigList
+	for _, resource := range resourceList {
+		authConfigList = append(authConfigList, resource.(*extauthv1.AuthConfig))
+	}
+	return authConfigList
+}
+
+func ResourceListToRateLimitConfigList(resourceList sk_resources.ResourceList) ratelimit.RateLimitConfigList {
+	var rateLimitConfigList ratelimit.RateLimitConfigList
+	for _, resource := range resourceList {
+		rateLimitConfigList = append(rateLimitConfigList, resource.(*ratelimit.RateLimitConfig))
+	}
+	return rateLimitConfigList
+}
+
+func ResourceListToGraphQLApiList(resourceList sk_resources.ResourceList) graphqlv1beta1.GraphQLApiList {
+	var graphQLApiList graphqlv1beta1.GraphQLApiList
+	for _, resource := range resourceList {
+		graphQLApiList = append(graphQLApiList, resource.(*graphqlv1beta1.GraphQLApi))
+	}
+	return graphQLApiList
+}
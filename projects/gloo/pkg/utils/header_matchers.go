@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+)
+
+// EnvoyHeaderMatchers converts gloo header matchers to envoy header matchers. A matcher without a value matches
+// requests with the header present.
+func EnvoyHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
+	var out []*envoy_config_route_v3.HeaderMatcher
+	for _, matcher := range in {
+
+		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
+			Name: matcher.GetName(),
+		}
+		if matcher.GetValue() == "" {
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		} else {
+			if matcher.GetRegex() {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
+				}
+			} else {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+					ExactMatch: matcher.GetValue(),
+				}
+			}
+		}
+
+		if matcher.GetInvertMatch() {
+			envoyMatch.InvertMatch = true
+		}
+
+		out = append(out, envoyMatch)
+	}
+	return out
+}
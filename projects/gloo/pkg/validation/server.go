@@ -4,11 +4,15 @@ import (
 	"context"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	graphqlv1beta1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/graphql/v1beta1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -16,7 +20,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/hashutils"
-	sk_resources "github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -200,7 +204,9 @@ func (s *validator) Validate(ctx context.Context, req *validation.GlooValidation
 		s.xdsSanitizer.SanitizeSnapshot(ctx, &snapCopy, xdsSnapshot, resourceReports)
 		routeErrorToWarnings(resourceReports, proxyReport)
 
-		validationReports = append(validationReports, convertToValidationReport(proxyReport, resourceReports, proxy))
+		validationReport := convertToValidationReport(proxyReport, resourceReports, proxy)
+		addSecretAndArtifactReports(validationReport, req.GetModifiedResources())
+		validationReports = append(validationReports, validationReport)
 	}
 
 	return &validation.GlooValidationServiceResponse{
@@ -210,44 +216,102 @@ func (s *validator) Validate(ctx context.Context, req *validation.GlooValidation
 
 // updates the given snapshot with the resources from the request
 func applyRequestToSnapshot(snap *v1snap.ApiSnapshot, req *validation.GlooValidationServiceRequest) {
-	if req.GetModifiedResources() != nil {
-		existingUpstreams := snap.Upstreams.AsResources()
-		modifiedUpstreams := utils.UpstreamsToResourceList(req.GetModifiedResources().GetUpstreams())
-		mergedUpstreams := utils.MergeResourceLists(existingUpstreams, modifiedUpstreams)
-		snap.Upstreams = utils.ResourceListToUpstreamList(mergedUpstreams)
-	} else if req.GetDeletedResources() != nil {
-		// Upstreams
-		existingUpstreams := snap.Upstreams.AsResources()
-		deletedUpstreamRefs := req.GetDeletedResources().GetUpstreamRefs()
-		finalUpstreams := utils.DeleteResources(existingUpstreams, deletedUpstreamRefs)
-		snap.Upstreams = utils.ResourceListToUpstreamList(finalUpstreams)
-		// Secrets
-		existingSecrets := snap.Secrets.AsResources()
-		deletedSecretRefs := req.GetDeletedResources().GetSecretRefs()
-		finalSecrets := utils.DeleteResources(existingSecrets, deletedSecretRefs)
-		snap.Secrets = utils.ResourceListToSecretList(finalSecrets)
+	if modified := req.GetModifiedResources(); modified != nil {
+		snap.Upstreams = utils.ResourceListToUpstreamList(utils.MergeResourceLists(
+			snap.Upstreams.AsResources(), v1.UpstreamList(modified.GetUpstreams()).AsResources()))
+		snap.UpstreamGroups = utils.ResourceListToUpstreamGroupList(utils.MergeResourceLists(
+			snap.UpstreamGroups.AsResources(), v1.UpstreamGroupList(modified.GetUpstreamGroups()).AsResources()))
+		snap.Secrets = utils.ResourceListToSecretList(utils.MergeResourceLists(
+			snap.Secrets.AsResources(), v1.SecretList(modified.GetSecrets()).AsResources()))
+		snap.Artifacts = utils.ResourceListToArtifactList(utils.MergeResourceLists(
+			snap.Artifacts.AsResources(), v1.ArtifactList(modified.GetArtifacts()).AsResources()))
+		snap.AuthConfigs = utils.ResourceListToAuthConfigList(utils.MergeResourceLists(
+			snap.AuthConfigs.AsResources(), extauthv1.AuthConfigList(modified.GetAuthConfigs()).AsResources()))
+		snap.Ratelimitconfigs = utils.ResourceListToRateLimitConfigList(utils.MergeResourceLists(
+			snap.Ratelimitconfigs.AsResources(), toRateLimitConfigList(modified.GetRatelimitConfigs()).AsResources()))
+		snap.GraphqlApis = utils.ResourceListToGraphQLApiList(utils.MergeResourceLists(
+			snap.GraphqlApis.AsResources(), graphqlv1beta1.GraphQLApiList(modified.GetGraphqlApis()).AsResources()))
+	} else if deleted := req.GetDeletedResources(); deleted != nil {
+		snap.Upstreams = utils.ResourceListToUpstreamList(utils.DeleteResources(
+			snap.Upstreams.AsResources(), deleted.GetUpstreamRefs()))
+		snap.UpstreamGroups = utils.ResourceListToUpstreamGroupList(utils.DeleteResources(
+			snap.UpstreamGroups.AsResources(), deleted.GetUpstreamGroupRefs()))
+		snap.Secrets = utils.ResourceListToSecretList(utils.DeleteResources(
+			snap.Secrets.AsResources(), deleted.GetSecretRefs()))
+		snap.Artifacts = utils.ResourceListToArtifactList(utils.DeleteResources(
+			snap.Artifacts.AsResources(), deleted.GetArtifactRefs()))
+		snap.AuthConfigs = utils.ResourceListToAuthConfigList(utils.DeleteResources(
+			snap.AuthConfigs.AsResources(), deleted.GetAuthConfigRefs()))
+		snap.Ratelimitconfigs = utils.ResourceListToRateLimitConfigList(utils.DeleteResources(
+			snap.Ratelimitconfigs.AsResources(), deleted.GetRatelimitConfigRefs()))
+		snap.GraphqlApis = utils.ResourceListToGraphQLApiList(utils.DeleteResources(
+			snap.GraphqlApis.AsResources(), deleted.GetGraphqlApiRefs()))
 	}
 }
 
+// RateLimitConfigs are sent as their ref and spec, as they are not solo-kit resources
+func toRateLimitConfigList(rateLimitConfigs []*validation.RateLimitConfigResource) ratelimit.RateLimitConfigList {
+	var rateLimitConfigList ratelimit.RateLimitConfigList
+	for _, rateLimitConfig := range rateLimitConfigs {
+		out := &ratelimit.RateLimitConfig{}
+		out.SetMetadata(&core.Metadata{
+			Name:      rateLimitConfig.GetRef().GetName(),
+			Namespace: rateLimitConfig.GetRef().GetNamespace(),
+		})
+		if rateLimitConfig.GetSpec() != nil {
+			proto.Merge(&out.Spec, rateLimitConfig.GetSpec())
+		}
+		rateLimitConfigList = append(rateLimitConfigList, out)
+	}
+	return rateLimitConfigList
+}
+
 func convertToValidationReport(proxyReport *validation.ProxyReport, resourceReports reporter.ResourceReports, proxy *v1.Proxy) *validation.ValidationReport {
-	var upstreamReports []*validation.ResourceReport
+	validationReport := &validation.ValidationReport{
+		ProxyReport: proxyReport,
+		Proxy:       proxy,
+	}
 
 	for resource, report := range resourceReports {
-		switch sk_resources.Kind(resource) {
-		case "*v1.Upstream":
-			upstreamReports = append(upstreamReports, &validation.ResourceReport{
-				ResourceRef: resource.GetMetadata().Ref(),
-				Warnings:    report.Warnings,
-				Errors:      getErrors(report.Errors),
-			})
+		resourceReport := &validation.ResourceReport{
+			ResourceRef: resource.GetMetadata().Ref(),
+			Warnings:    report.Warnings,
+			Errors:      getErrors(report.Errors),
+		}
+		switch resource.(type) {
+		case *v1.Upstream:
+			validationReport.UpstreamReports = append(validationReport.GetUpstreamReports(), resourceReport)
+		case *v1.UpstreamGroup:
+			validationReport.UpstreamGroupReports = append(validationReport.GetUpstreamGroupReports(), resourceReport)
+		case *extauthv1.AuthConfig:
+			validationReport.AuthConfigReports = append(validationReport.GetAuthConfigReports(), resourceReport)
+		case *ratelimit.RateLimitConfig:
+			validationReport.RatelimitConfigReports = append(validationReport.GetRatelimitConfigReports(), resourceReport)
+		case *graphqlv1beta1.GraphQLApi:
+			validationReport.GraphqlApiReports = append(validationReport.GetGraphqlApiReports(), resourceReport)
 		}
-		// TODO add other resources types here
 	}
 
-	return &validation.ValidationReport{
-		ProxyReport:     proxyReport,
-		UpstreamReports: upstreamReports,
-		Proxy:           proxy,
+	return validationReport
+}
+
+// Secrets and artifacts are not input resources, so the translator does not report on them. Errors caused by them are
+// reported on the proxies and resources which reference them, so the modified secrets and artifacts are reported as
+// translated with the proxy.
+func addSecretAndArtifactReports(validationReport *validation.ValidationReport, modified *validation.ModifiedResources) {
+	for _, secret := range modified.GetSecrets() {
+		validationReport.SecretReports = append(validationReport.GetSecretReports(), &validation.ResourceReport{
+			ResourceRef: secret.GetMetadata().Ref(),
+			Warnings:    []string{},
+			Errors:      []string{},
+		})
+	}
+	for _, artifact := range modified.GetArtifacts() {
+		validationReport.ArtifactReports = append(validationReport.GetArtifactReports(), &validation.ResourceReport{
+			ResourceRef: artifact.GetMetadata().Ref(),
+			Warnings:    []string{},
+			Errors:      []string{},
+		})
 	}
 }
 
@@ -285,6 +285,150 @@ var _ = Describe("ValidationOpts Server", func() {
 		})
 	})
 
+	Context("gloo resource validation", func() {
+		var (
+			upstreamGroup *v1.UpstreamGroup
+		)
+
+		BeforeEach(func() {
+			upstreamGroup = &v1.UpstreamGroup{
+				Metadata: &core.Metadata{Name: "ug", Namespace: "gloo-system"},
+				Destinations: []*v1.WeightedDestination{{
+					Destination: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: &core.ResourceRef{Name: "test", Namespace: "gloo-system"},
+						},
+					},
+					Weight: 1,
+				}},
+			}
+			// route the snapshot's proxy to the upstream group
+			params.Snapshot.UpstreamGroups = v1.UpstreamGroupList{upstreamGroup}
+			params.Snapshot.Proxies = v1.ProxyList{{
+				Metadata: &core.Metadata{Name: "proxy", Namespace: "gloo-system"},
+				Listeners: []*v1.Listener{{
+					Name:        "listener",
+					BindAddress: "::",
+					BindPort:    8080,
+					ListenerType: &v1.Listener_HttpListener{
+						HttpListener: &v1.HttpListener{
+							VirtualHosts: []*v1.VirtualHost{{
+								Name:    "vh",
+								Domains: []string{"*"},
+								Routes: []*v1.Route{{
+									Action: &v1.Route_RouteAction{
+										RouteAction: &v1.RouteAction{
+											Destination: &v1.RouteAction_UpstreamGroup{
+												UpstreamGroup: upstreamGroup.GetMetadata().Ref(),
+											},
+										},
+									},
+								}},
+							}},
+						},
+					},
+				}},
+			}}
+		})
+
+		It("upstream group validation succeeds", func() {
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_ModifiedResources{
+					ModifiedResources: &validationgrpc.ModifiedResources{
+						UpstreamGroups: []*v1.UpstreamGroup{upstreamGroup},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			proxyReport := resp.ValidationReports[0].GetProxyReport()
+			Expect(validation.GetProxyWarning(proxyReport)).To(BeEmpty())
+			Expect(validation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+		})
+		It("upstream group validation fails", func() {
+			// an upstream group pointing to a missing upstream should be reported on the upstream group
+			invalidGroup := upstreamGroup.Clone().(*v1.UpstreamGroup)
+			invalidGroup.GetDestinations()[0].GetDestination().DestinationType = &v1.Destination_Upstream{
+				Upstream: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+			}
+
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_ModifiedResources{
+					ModifiedResources: &validationgrpc.ModifiedResources{
+						UpstreamGroups: []*v1.UpstreamGroup{invalidGroup},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			proxyReport := resp.ValidationReports[0].GetProxyReport()
+			Expect(validation.GetProxyWarning(proxyReport)).NotTo(BeEmpty())
+			Expect(resp.ValidationReports[0].GetUpstreamGroupReports()).To(HaveLen(1))
+			Expect(resp.ValidationReports[0].GetUpstreamGroupReports()[0].GetErrors()).NotTo(BeEmpty())
+		})
+		It("reports the modified secrets and artifacts", func() {
+			secret := &v1.Secret{Metadata: &core.Metadata{Name: "secret", Namespace: "gloo-system"}}
+			artifact := &v1.Artifact{Metadata: &core.Metadata{Name: "artifact", Namespace: "gloo-system"}}
+
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_ModifiedResources{
+					ModifiedResources: &validationgrpc.ModifiedResources{
+						Secrets:   []*v1.Secret{secret},
+						Artifacts: []*v1.Artifact{artifact},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			Expect(resp.ValidationReports[0].GetSecretReports()).To(HaveLen(1))
+			Expect(resp.ValidationReports[0].GetSecretReports()[0].GetResourceRef()).To(Equal(secret.GetMetadata().Ref()))
+			Expect(resp.ValidationReports[0].GetArtifactReports()).To(HaveLen(1))
+			Expect(resp.ValidationReports[0].GetArtifactReports()[0].GetResourceRef()).To(Equal(artifact.GetMetadata().Ref()))
+		})
+		It("upstream group deletion validation fails", func() {
+			// trying to delete an upstream group that is being referenced by a proxy should cause an error
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_DeletedResources{
+					DeletedResources: &validationgrpc.DeletedResources{
+						UpstreamGroupRefs: []*core.ResourceRef{upstreamGroup.GetMetadata().Ref()},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			proxyReport := resp.ValidationReports[0].GetProxyReport()
+			Expect(validation.GetProxyWarning(proxyReport)).NotTo(BeEmpty())
+		})
+		It("auth config and rate limit config deletion validation succeeds", func() {
+			// deleting resources that are not being used should succeed
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_DeletedResources{
+					DeletedResources: &validationgrpc.DeletedResources{
+						AuthConfigRefs:      []*core.ResourceRef{{Name: "unused-ac", Namespace: "gloo-system"}},
+						RatelimitConfigRefs: []*core.ResourceRef{{Name: "unused-rlc", Namespace: "gloo-system"}},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			proxyReport := resp.ValidationReports[0].GetProxyReport()
+			Expect(validation.GetProxyWarning(proxyReport)).To(BeEmpty())
+			Expect(validation.GetProxyError(proxyReport)).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports[0].GetAuthConfigReports()).To(BeEmpty())
+			Expect(resp.ValidationReports[0].GetRatelimitConfigReports()).To(BeEmpty())
+		})
+	})
+
 	Context("Watch Sync Notifications", func() {
 		var (
 			srv    *grpc.Server
@@ -0,0 +1,502 @@
+package xds
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync/atomic"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/solo-io/go-utils/contextutils"
+	envoy_api_v2 "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// wildcardResourceName is the explicit name a delta client uses to subscribe to all resources of a type
+const wildcardResourceName = "*"
+
+// DeltaServer serves incremental (Delta) xDS streams from a state-of-the-world SnapshotCache.
+// It tracks the version of every resource it has sent to each stream, so that a new snapshot
+// only results in the resources that changed (and the names of those that were removed) being sent.
+type DeltaServer interface {
+	// StreamDeltaEnvoyV3 is the streaming method for Envoy V3 Delta xDS
+	StreamDeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error
+	// StreamDeltaSolo is the streaming method for Delta Solo discovery
+	StreamDeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error
+}
+
+type DeltaStreamEnvoyV3 interface {
+	envoy_service_discovery_v3.AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+}
+
+type DeltaStreamSolo interface {
+	Send(*envoy_api_v2.DeltaDiscoveryResponse) error
+	Recv() (*envoy_api_v2.DeltaDiscoveryRequest, error)
+	Context() context.Context
+}
+
+type deltaServer struct {
+	cache cache.Cache
+
+	// streamCount for counting bi-di streams
+	streamCount int64
+}
+
+// NewDeltaServer returns a DeltaServer which serves resources from the provided cache.
+// The cache is watched with wildcard state-of-the-world requests; filtering by subscription
+// and diffing against the resources a client already has is done by the server.
+func NewDeltaServer(config cache.Cache) DeltaServer {
+	return &deltaServer{cache: config}
+}
+
+func (s *deltaServer) StreamDeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err := s.process(stream.Context(), stream.Send, reqCh, defaultTypeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *deltaServer) StreamDeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- upgradeDeltaDiscoveryRequest(req)
+		}
+	}()
+
+	send := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+		return stream.Send(downgradeDeltaDiscoveryResponse(resp))
+	}
+	err := s.process(stream.Context(), send, reqCh, defaultTypeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+// deltaWatch is the state of a single resource type on a delta stream
+type deltaWatch struct {
+	// wildcard is true if the client subscribed to all resources of this type
+	wildcard bool
+	// subscribed holds the names the client explicitly subscribed to
+	subscribed map[string]struct{}
+	// known maps the name of each resource the client has to the version it has
+	known map[string]string
+	// absent holds the explicitly subscribed names the client was told do not exist
+	absent map[string]struct{}
+	// pending holds the changes of the responses which the client has not acknowledged yet, in the order they were sent
+	pending []*pendingDelta
+
+	// version is the last snapshot version that was diffed against known
+	version string
+	// nonce is the nonce of the last response sent for this type, empty once it is acknowledged
+	nonce string
+	// initialized is true once a first response has been sent for this type
+	initialized bool
+
+	// watchID identifies the currently open cache watch, so that responses of canceled watches are dropped
+	watchID int64
+	cancel  func()
+}
+
+// pendingDelta is the change a response makes to the resources of the client, once it is acknowledged
+type pendingDelta struct {
+	nonce   string
+	updated []*envoy_service_discovery_v3.Resource
+	removed []string
+}
+
+func newDeltaWatch() *deltaWatch {
+	return &deltaWatch{
+		subscribed: map[string]struct{}{},
+		known:      map[string]string{},
+		absent:     map[string]struct{}{},
+	}
+}
+
+func (w *deltaWatch) isSubscribed(name string) bool {
+	if w.wildcard {
+		return true
+	}
+	_, ok := w.subscribed[name]
+	return ok
+}
+
+func (w *deltaWatch) cancelWatch() {
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+}
+
+// applySubscriptions updates the subscription state from a request.
+// It returns true if the client subscribed to something it was not subscribed to before.
+func (w *deltaWatch) applySubscriptions(req *envoy_service_discovery_v3.DeltaDiscoveryRequest, first bool) bool {
+	added := false
+	if first {
+		// a first request without any names is an implicit wildcard subscription
+		if len(req.GetResourceNamesSubscribe()) == 0 {
+			w.wildcard = true
+			added = true
+		}
+		for name, version := range req.GetInitialResourceVersions() {
+			w.known[name] = version
+		}
+	}
+	for _, name := range req.GetResourceNamesSubscribe() {
+		if name == wildcardResourceName {
+			added = added || !w.wildcard
+			w.wildcard = true
+			continue
+		}
+		if _, ok := w.subscribed[name]; !ok {
+			w.subscribed[name] = struct{}{}
+			added = true
+		}
+	}
+	for _, name := range req.GetResourceNamesUnsubscribe() {
+		if name == wildcardResourceName {
+			w.wildcard = false
+			continue
+		}
+		delete(w.subscribed, name)
+		// the client forgets about unsubscribed resources, we do the same
+		// so that a later re-subscription sends the resource again
+		delete(w.known, name)
+		delete(w.absent, name)
+	}
+	return added
+}
+
+// diff computes the resources that must be sent and removed for the client to converge on the given resources.
+func (w *deltaWatch) diff(typeURL string, resources []cache.Resource) ([]*envoy_service_discovery_v3.Resource, []string, error) {
+	var updated []*envoy_service_discovery_v3.Resource
+	var removed []string
+
+	present := make(map[string]struct{}, len(resources))
+	for _, res := range resources {
+		name := res.Self().Name
+		present[name] = struct{}{}
+		if !w.isSubscribed(name) {
+			continue
+		}
+		data, err := protov2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(res.ResourceProto()))
+		if err != nil {
+			return nil, nil, err
+		}
+		version := resourceVersion(data)
+		if w.known[name] == version {
+			continue
+		}
+		updated = append(updated, &envoy_service_discovery_v3.Resource{
+			Name:    name,
+			Version: version,
+			Resource: &any.Any{
+				TypeUrl: typeURL,
+				Value:   data,
+			},
+		})
+	}
+	for name := range w.known {
+		if _, ok := present[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	// explicitly subscribed resources which do not exist are reported as removed, so that the client stops waiting for them
+	for name := range w.subscribed {
+		_, isPresent := present[name]
+		_, isKnown := w.known[name]
+		_, isAbsent := w.absent[name]
+		if !isPresent && !isKnown && !isAbsent {
+			removed = append(removed, name)
+		}
+	}
+	return updated, removed, nil
+}
+
+// acknowledge handles the client's response to the response with the given nonce. The changes of the response are
+// committed if the client accepted them, and dropped if it rejected them, so that they are sent again.
+// Responses sent before it were superseded, as their changes were included in the diff of the acknowledged response.
+func (w *deltaWatch) acknowledge(nonce string, accepted bool) {
+	for i, pending := range w.pending {
+		if pending.nonce != nonce {
+			continue
+		}
+		if accepted {
+			w.commit(pending.updated, pending.removed)
+		}
+		w.pending = w.pending[i+1:]
+		return
+	}
+}
+
+// commit records that the client now has the given updates and removals
+func (w *deltaWatch) commit(updated []*envoy_service_discovery_v3.Resource, removed []string) {
+	for _, res := range updated {
+		w.known[res.GetName()] = res.GetVersion()
+		delete(w.absent, res.GetName())
+	}
+	for _, name := range removed {
+		delete(w.known, name)
+		if _, ok := w.subscribed[name]; ok {
+			w.absent[name] = struct{}{}
+		}
+	}
+}
+
+// resourceVersion returns a stable per-resource version, derived from the hash of its serialized contents
+func resourceVersion(data []byte) string {
+	hasher := fnv.New64()
+	hasher.Write(data)
+	return strconv.FormatUint(hasher.Sum64(), 16)
+}
+
+type typedDeltaResponse struct {
+	response *cache.Response
+	typeURL  string
+	watchID  int64
+}
+
+// process handles a bi-di delta stream
+func (s *deltaServer) process(
+	ctx context.Context,
+	send func(*envoy_service_discovery_v3.DeltaDiscoveryResponse) error,
+	reqCh <-chan *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	defaultTypeURL string,
+) error {
+	logger := contextutils.LoggerFrom(ctx)
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+
+	var streamNonce, watchCount int64
+	watches := map[string]*deltaWatch{}
+	defer func() {
+		for _, w := range watches {
+			w.cancelWatch()
+		}
+	}()
+
+	responses := make(chan typedDeltaResponse)
+
+	// node may only be set on the first discovery request
+	var node = &envoy_config_core_v3.Node{}
+
+	openWatch := func(typeURL string, w *deltaWatch, version string) {
+		w.cancelWatch()
+		watchCount = watchCount + 1
+		w.watchID = watchCount
+		w.cancel = s.createWatch(ctx, responses, w.watchID, node, typeURL, version)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case resp := <-responses:
+			if resp.response == nil {
+				return status.Errorf(codes.Unavailable, "watching failed for "+resp.typeURL)
+			}
+			w, ok := watches[resp.typeURL]
+			if !ok || w.watchID != resp.watchID {
+				continue
+			}
+			w.cancel = nil
+			updated, removed, err := w.diff(resp.typeURL, resp.response.Resources)
+			if err != nil {
+				return err
+			}
+			w.version = resp.response.Version
+
+			// nothing changed for this client; wait for the next snapshot
+			if w.initialized && len(updated) == 0 && len(removed) == 0 {
+				openWatch(resp.typeURL, w, w.version)
+				continue
+			}
+
+			streamNonce = streamNonce + 1
+			out := &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+				SystemVersionInfo: w.version,
+				TypeUrl:           resp.typeURL,
+				Resources:         updated,
+				RemovedResources:  removed,
+				Nonce:             strconv.FormatInt(streamNonce, 10),
+			}
+			if err := send(out); err != nil {
+				return err
+			}
+			w.pending = append(w.pending, &pendingDelta{nonce: out.GetNonce(), updated: updated, removed: removed})
+			w.nonce = out.GetNonce()
+			w.initialized = true
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+
+			// node field in discovery request is delta-compressed
+			if req.GetNode() != nil {
+				node = req.GetNode()
+			}
+
+			// type URL is required for ADS but is implicit for xDS
+			if defaultTypeURL == resource.AnyType {
+				if req.GetTypeUrl() == "" {
+					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+				}
+			} else if req.GetTypeUrl() == "" {
+				req.TypeUrl = defaultTypeURL
+			}
+			typeURL := req.GetTypeUrl()
+
+			w, ok := watches[typeURL]
+			if !ok {
+				w = newDeltaWatch()
+				watches[typeURL] = w
+			}
+			if req.GetResponseNonce() != "" {
+				w.acknowledge(req.GetResponseNonce(), req.GetErrorDetail() == nil)
+			}
+			added := w.applySubscriptions(req, !ok)
+
+			if req.GetErrorDetail() != nil {
+				logger.Warnf("delta xDS stream %d: %s rejected update %s: %s",
+					streamID, typeURL, req.GetResponseNonce(), req.GetErrorDetail().GetMessage())
+			}
+
+			switch {
+			case added:
+				// new subscriptions must be answered with the current state, without waiting for a new snapshot
+				openWatch(typeURL, w, "")
+			case w.nonce != "" && req.GetResponseNonce() == w.nonce:
+				// the last response was acknowledged (or rejected); wait for the next snapshot
+				w.nonce = ""
+				openWatch(typeURL, w, w.version)
+			}
+		}
+	}
+}
+
+// createWatch opens a wildcard state-of-the-world watch on the cache and forwards its response.
+// It returns a function which cancels the watch.
+func (s *deltaServer) createWatch(
+	ctx context.Context,
+	responses chan<- typedDeltaResponse,
+	watchID int64,
+	node *envoy_config_core_v3.Node,
+	typeURL string,
+	version string,
+) func() {
+	watchedResource, cancelWatch := s.cache.CreateWatch(cache.Request{
+		Node:        node,
+		TypeUrl:     typeURL,
+		VersionInfo: version,
+	})
+
+	var isCanceled int32
+	canceled := make(chan struct{})
+	go func() {
+		select {
+		case <-canceled:
+		case <-ctx.Done():
+		case response, ok := <-watchedResource:
+			resp := typedDeltaResponse{typeURL: typeURL, watchID: watchID}
+			if ok {
+				resp.response = &response
+			} else if atomic.LoadInt32(&isCanceled) != 0 {
+				// resource chan was closed by our own cancel
+				return
+			}
+			select {
+			case responses <- resp:
+			case <-canceled:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return func() {
+		if atomic.CompareAndSwapInt32(&isCanceled, 0, 1) {
+			close(canceled)
+		}
+		if cancelWatch != nil {
+			cancelWatch()
+		}
+	}
+}
+
+func upgradeDeltaDiscoveryRequest(req *envoy_api_v2.DeltaDiscoveryRequest) *envoy_service_discovery_v3.DeltaDiscoveryRequest {
+	if req == nil {
+		return nil
+	}
+	return &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+		Node:                     util.UpgradeNode(req.GetNode()),
+		TypeUrl:                  req.GetTypeUrl(),
+		ResourceNamesSubscribe:   req.GetResourceNamesSubscribe(),
+		ResourceNamesUnsubscribe: req.GetResourceNamesUnsubscribe(),
+		InitialResourceVersions:  req.GetInitialResourceVersions(),
+		ResponseNonce:            req.GetResponseNonce(),
+		ErrorDetail:              req.GetErrorDetail(),
+	}
+}
+
+func downgradeDeltaDiscoveryResponse(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) *envoy_api_v2.DeltaDiscoveryResponse {
+	if resp == nil {
+		return nil
+	}
+	resources := make([]*envoy_api_v2.Resource, 0, len(resp.GetResources()))
+	for _, res := range resp.GetResources() {
+		resources = append(resources, &envoy_api_v2.Resource{
+			Name:     res.GetName(),
+			Aliases:  res.GetAliases(),
+			Version:  res.GetVersion(),
+			Resource: res.GetResource(),
+		})
+	}
+	return &envoy_api_v2.DeltaDiscoveryResponse{
+		SystemVersionInfo: resp.GetSystemVersionInfo(),
+		Resources:         resources,
+		TypeUrl:           resp.GetTypeUrl(),
+		RemovedResources:  resp.GetRemovedResources(),
+		Nonce:             resp.GetNonce(),
+	}
+}
@@ -0,0 +1,216 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *envoy_service_discovery_v3.DeltaDiscoveryRequest
+	responses chan *envoy_service_discovery_v3.DeltaDiscoveryResponse
+}
+
+func (f *fakeDeltaStream) Context() context.Context { return f.ctx }
+
+func (f *fakeDeltaStream) Send(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+	f.responses <- resp
+	return nil
+}
+
+func (f *fakeDeltaStream) Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error) {
+	req, ok := <-f.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+var _ = Describe("DeltaServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		snapshotCache cache.SnapshotCache
+		stream        *fakeDeltaStream
+		node          *envoy_config_core_v3.Node
+	)
+
+	snapshot := func(version string, clusterNames ...string) *xds.EnvoySnapshot {
+		var clusters, endpoints []cache.Resource
+		for _, name := range clusterNames {
+			clusters = append(clusters, resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+				Name:                 name,
+				ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_EDS},
+				EdsClusterConfig:     &envoy_config_cluster_v3.Cluster_EdsClusterConfig{ServiceName: name},
+			}))
+			endpoints = append(endpoints, resource.NewEnvoyResource(&envoy_config_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: name,
+			}))
+		}
+		return xds.NewSnapshot(version, endpoints, clusters, nil, nil)
+	}
+
+	names := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) []string {
+		var out []string
+		for _, res := range resp.GetResources() {
+			out = append(out, res.GetName())
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = xds.NewAdsSnapshotCache(ctx)
+		stream = &fakeDeltaStream{
+			ctx:       ctx,
+			requests:  make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest, 10),
+			responses: make(chan *envoy_service_discovery_v3.DeltaDiscoveryResponse, 10),
+		}
+		node = &envoy_config_core_v3.Node{
+			Id: "envoy",
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"role": structpb.NewStringValue(nodeKey),
+			}},
+		}
+		go xds.NewDeltaServer(snapshotCache).StreamDeltaEnvoyV3(stream, resource.AnyType)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("sends only changed and removed resources", func() {
+		snapshotCache.SetSnapshot(nodeKey, snapshot("1", "a", "b"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: resource.ClusterTypeV3,
+		}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a", "b"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+
+		// ack
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       resource.ClusterTypeV3,
+			ResponseNonce: resp.GetNonce(),
+		}
+
+		// "a" is unchanged, "b" is removed and "c" is added
+		snapshotCache.SetSnapshot(nodeKey, snapshot("2", "a", "c"))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("2"))
+		Expect(names(resp)).To(ConsistOf("c"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("b"))
+	})
+
+	It("only sends explicitly subscribed resources", func() {
+		snapshotCache.SetSnapshot(nodeKey, snapshot("1", "a", "b"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                   node,
+			TypeUrl:                resource.EndpointTypeV3,
+			ResourceNamesSubscribe: []string{"a"},
+		}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a"))
+
+		// subscribing to another resource is answered without a new snapshot
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:                resource.EndpointTypeV3,
+			ResponseNonce:          resp.GetNonce(),
+			ResourceNamesSubscribe: []string{"b"},
+		}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("b"))
+	})
+
+	It("does not resend resources the client already has", func() {
+		snapshotCache.SetSnapshot(nodeKey, snapshot("1", "a"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: resource.ClusterTypeV3,
+		}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       resource.ClusterTypeV3,
+			ResponseNonce: resp.GetNonce(),
+		}
+
+		// a new snapshot version with identical clusters produces no response
+		snapshotCache.SetSnapshot(nodeKey, snapshot("2", "a"))
+		Consistently(stream.responses).ShouldNot(Receive())
+	})
+	It("sends rejected resources again", func() {
+		snapshotCache.SetSnapshot(nodeKey, snapshot("1", "a"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: resource.ClusterTypeV3,
+		}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a"))
+
+		// nack
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       resource.ClusterTypeV3,
+			ResponseNonce: resp.GetNonce(),
+			ErrorDetail:   &status.Status{Message: "rejected"},
+		}
+
+		// the client does not have "a", so it is sent again with the next snapshot
+		snapshotCache.SetSnapshot(nodeKey, snapshot("2", "a", "b"))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a", "b"))
+	})
+
+	It("reports explicitly subscribed resources which do not exist as removed", func() {
+		snapshotCache.SetSnapshot(nodeKey, snapshot("1", "a"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                   node,
+			TypeUrl:                resource.EndpointTypeV3,
+			ResourceNamesSubscribe: []string{"a", "missing"},
+		}
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("missing"))
+
+		// ack
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       resource.EndpointTypeV3,
+			ResponseNonce: resp.GetNonce(),
+		}
+
+		// the missing resource is only reported once, and sent once it exists
+		snapshotCache.SetSnapshot(nodeKey, snapshot("2", "a", "b"))
+		Consistently(stream.responses).ShouldNot(Receive())
+		snapshotCache.SetSnapshot(nodeKey, snapshot("3", "a", "missing"))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("missing"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+	})
+})
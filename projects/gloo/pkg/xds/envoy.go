@@ -24,10 +24,13 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	// The Gloo Server is an xDS server that accepts v2 Envoy ADS requests. The Envoy v2 API has been
 	// deprecated but the ADS api has been preserved internally to support discovery of
 	// ext-auth and rate-limit configurations.
-	glooServer := NewGlooXdsServer(xdsServer)
+	// Delta (incremental) xDS is served from the same snapshot cache as state-of-the-world xDS.
+	deltaServer := NewDeltaServer(envoyCache)
+
+	glooServer := NewGlooXdsServer(xdsServer, deltaServer)
 	solo_xds.RegisterSoloDiscoveryServiceServer(grpcServer, glooServer)
 
-	envoyServer := NewEnvoyServerV3(xdsServer)
+	envoyServer := NewEnvoyServerV3(xdsServer, deltaServer)
 	envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_route_v3.RegisterRouteDiscoveryServiceServer(grpcServer, envoyServer)
@@ -0,0 +1,248 @@
+package ingressclass
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubewatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+const typeUrl = "k8s.io/networking.v1/IngressClass"
+
+// ResourceClient reads and writes kubernetes IngressClasses. IngressClasses are cluster-scoped,
+// so the namespaces passed to the client are ignored.
+type ResourceClient struct {
+	kube         kubernetes.Interface
+	resourceName string
+	resourceType resources.Resource
+}
+
+func NewResourceClient(kube kubernetes.Interface, resourceType resources.Resource) *ResourceClient {
+	return &ResourceClient{
+		kube:         kube,
+		resourceName: reflect.TypeOf(resourceType).String(),
+		resourceType: resourceType,
+	}
+}
+
+func FromKube(ingressClass *networkingv1.IngressClass) (*v1.IngressClass, error) {
+	rawSpec, err := json.Marshal(ingressClass.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling kube ingress class object")
+	}
+	spec := &any.Any{
+		TypeUrl: typeUrl,
+		Value:   rawSpec,
+	}
+
+	resource := &v1.IngressClass{
+		KubeIngressClassSpec: spec,
+	}
+
+	resource.SetMetadata(kubeutils.FromKubeMeta(ingressClass.ObjectMeta, true))
+
+	return resource, nil
+}
+
+func ToKube(resource resources.Resource) (*networkingv1.IngressClass, error) {
+	classResource, ok := resource.(*v1.IngressClass)
+	if !ok {
+		return nil, errors.Errorf("internal error: invalid resource %v passed to ingress-class-only client", resources.Kind(resource))
+	}
+	if classResource.GetKubeIngressClassSpec() == nil {
+		return nil, errors.Errorf("internal error: %v ingress class spec cannot be nil", classResource.GetMetadata().Ref())
+	}
+	var ingressClass networkingv1.IngressClass
+	if err := json.Unmarshal(classResource.GetKubeIngressClassSpec().GetValue(), &ingressClass.Spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshalling kube ingress class spec data")
+	}
+
+	meta := kubeutils.ToKubeMeta(resource.GetMetadata())
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	ingressClass.ObjectMeta = meta
+	return &ingressClass, nil
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(rc.resourceType)
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return resources.Clone(rc.resourceType)
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) Read(_, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+
+	ingressClassObj, err := rc.kube.NetworkingV1().IngressClasses().Get(opts.Ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.NewNotExistErr("", name, err)
+		}
+		return nil, errors.Wrapf(err, "reading ingressClassObj from kubernetes")
+	}
+	resource, err := FromKube(ingressClassObj)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return nil, errors.Errorf("ingressClassObj %v is not kind %v", name, rc.Kind())
+	}
+	return resource, nil
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	opts = opts.WithDefaults()
+	if err := resources.Validate(resource); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+
+	ingressClassObj, err := ToKube(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := rc.Read("", meta.GetName(), clients.ReadOpts{
+		Ctx: opts.Ctx,
+	})
+	if original != nil && err == nil {
+		if !opts.OverwriteExisting {
+			return nil, errors.NewExistErr(meta)
+		}
+		if meta.GetResourceVersion() != original.GetMetadata().GetResourceVersion() {
+			return nil, errors.NewResourceVersionErr("", meta.GetName(), meta.GetResourceVersion(), original.GetMetadata().GetResourceVersion())
+		}
+		if _, err := rc.kube.NetworkingV1().IngressClasses().Update(opts.Ctx, ingressClassObj, metav1.UpdateOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "updating kube ingressClassObj %v", ingressClassObj.Name)
+		}
+	} else {
+		if _, err := rc.kube.NetworkingV1().IngressClasses().Create(opts.Ctx, ingressClassObj, metav1.CreateOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "creating kube ingressClassObj %v", ingressClassObj.Name)
+		}
+	}
+
+	// return a read object to update the resource version
+	return rc.Read("", ingressClassObj.Name, clients.ReadOpts{Ctx: opts.Ctx})
+}
+
+func (rc *ResourceClient) Delete(_, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+	if !rc.exist(opts.Ctx, name) {
+		if !opts.IgnoreNotExist {
+			return errors.NewNotExistErr("", name)
+		}
+		return nil
+	}
+
+	if err := rc.kube.NetworkingV1().IngressClasses().Delete(opts.Ctx, name, metav1.DeleteOptions{}); err != nil {
+		return errors.Wrapf(err, "deleting ingressClassObj %v", name)
+	}
+	return nil
+}
+
+func (rc *ResourceClient) List(_ string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+
+	ingressClassObjList, err := rc.kube.NetworkingV1().IngressClasses().List(opts.Ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing ingressClassObjs")
+	}
+	var resourceList resources.ResourceList
+	for _, ingressClassObj := range ingressClassObjList.Items {
+		resource, err := FromKube(&ingressClassObj)
+		if err != nil {
+			return nil, err
+		}
+		if resource == nil {
+			continue
+		}
+		resourceList = append(resourceList, resource)
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().GetName() < resourceList[j].GetMetadata().GetName()
+	})
+
+	return resourceList, nil
+}
+
+func (rc *ResourceClient) Watch(_ string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	watch, err := rc.kube.NetworkingV1().IngressClasses().Watch(opts.Ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "initiating kube watch")
+	}
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	updateResourceList := func() {
+		list, err := rc.List("", clients.ListOpts{
+			Ctx:      opts.Ctx,
+			Selector: opts.Selector,
+		})
+		if err != nil {
+			errs <- err
+			return
+		}
+		resourcesChan <- list
+	}
+
+	go func() {
+		// watch should open up with an initial read
+		updateResourceList()
+		for {
+			select {
+			case <-time.After(opts.RefreshRate):
+				updateResourceList()
+			case event := <-watch.ResultChan():
+				switch event.Type {
+				case kubewatch.Error:
+					errs <- errors.Errorf("error during watch: %v", event)
+				default:
+					updateResourceList()
+				}
+			case <-opts.Ctx.Done():
+				watch.Stop()
+				close(resourcesChan)
+				close(errs)
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func (rc *ResourceClient) exist(ctx context.Context, name string) bool {
+	_, err := rc.kube.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+	return err == nil
+}
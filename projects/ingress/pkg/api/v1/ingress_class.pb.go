@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/ingress/api/v1/ingress_class.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	any1 "github.com/golang/protobuf/ptypes/any"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+//
+//A simple wrapper for a Kubernetes IngressClass Object.
+type IngressClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a raw byte representation of the kubernetes ingress class this resource wraps
+	KubeIngressClassSpec *any1.Any `protobuf:"bytes,1,opt,name=kube_ingress_class_spec,json=kubeIngressClassSpec,proto3" json:"kube_ingress_class_spec,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata *core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *IngressClass) Reset() {
+	*x = IngressClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngressClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressClass) ProtoMessage() {}
+
+func (x *IngressClass) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressClass.ProtoReflect.Descriptor instead.
+func (*IngressClass) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IngressClass) GetKubeIngressClassSpec() *any1.Any {
+	if x != nil {
+		return x.KubeIngressClassSpec
+	}
+	return nil
+}
+
+func (x *IngressClass) GetMetadata() *core.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDesc = []byte{
+	0x0a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69,
+	0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x0c,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x4b, 0x0a, 0x17,
+	0x6b, 0x75, 0x62, 0x65, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x14, 0x6b, 0x75, 0x62, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x24, 0x82,
+	0xf1, 0x04, 0x05, 0x0a, 0x03, 0x69, 0x67, 0x63, 0x82, 0xf1, 0x04, 0x11, 0x12, 0x0f, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x82, 0xf1, 0x04,
+	0x02, 0x18, 0x01, 0x42, 0x41, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xb8, 0xf5, 0x04, 0x01, 0xd0, 0xf5,
+	0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescData = file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_goTypes = []interface{}{
+	(*IngressClass)(nil),  // 0: ingress.solo.io.IngressClass
+	(*any1.Any)(nil),      // 1: google.protobuf.Any
+	(*core.Metadata)(nil), // 2: core.solo.io.Metadata
+}
+var file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_depIdxs = []int32{
+	1, // 0: ingress.solo.io.IngressClass.kube_ingress_class_spec:type_name -> google.protobuf.Any
+	2, // 1: ingress.solo.io.IngressClass.metadata:type_name -> core.solo.io.Metadata
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_init() }
+func file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_init() {
+	if File_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngressClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto = out.File
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_ingress_api_v1_ingress_class_proto_depIdxs = nil
+}
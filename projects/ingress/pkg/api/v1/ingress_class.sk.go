@@ -0,0 +1,145 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"log"
+	"sort"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func NewIngressClass(namespace, name string) *IngressClass {
+	ingressclass := &IngressClass{}
+	ingressclass.SetMetadata(&core.Metadata{
+		Name:      name,
+		Namespace: namespace,
+	})
+	return ingressclass
+}
+
+func (r *IngressClass) SetMetadata(meta *core.Metadata) {
+	r.Metadata = meta
+}
+
+func (r *IngressClass) MustHash() uint64 {
+	hashVal, err := r.Hash(nil)
+	if err != nil {
+		log.Panicf("error while hashing: (%s) this should never happen", err)
+	}
+	return hashVal
+}
+
+func (r *IngressClass) GroupVersionKind() schema.GroupVersionKind {
+	return IngressClassGVK
+}
+
+type IngressClassList []*IngressClass
+
+func (list IngressClassList) Find(namespace, name string) (*IngressClass, error) {
+	for _, ingressClass := range list {
+		if ingressClass.GetMetadata().Name == name && ingressClass.GetMetadata().Namespace == namespace {
+			return ingressClass, nil
+		}
+	}
+	return nil, errors.Errorf("list did not find ingressClass %v.%v", namespace, name)
+}
+
+func (list IngressClassList) AsResources() resources.ResourceList {
+	var ress resources.ResourceList
+	for _, ingressClass := range list {
+		ress = append(ress, ingressClass)
+	}
+	return ress
+}
+
+func (list IngressClassList) Names() []string {
+	var names []string
+	for _, ingressClass := range list {
+		names = append(names, ingressClass.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list IngressClassList) NamespacesDotNames() []string {
+	var names []string
+	for _, ingressClass := range list {
+		names = append(names, ingressClass.GetMetadata().Namespace+"."+ingressClass.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list IngressClassList) Sort() IngressClassList {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetMetadata().Less(list[j].GetMetadata())
+	})
+	return list
+}
+
+func (list IngressClassList) Clone() IngressClassList {
+	var ingressClassList IngressClassList
+	for _, ingressClass := range list {
+		ingressClassList = append(ingressClassList, resources.Clone(ingressClass).(*IngressClass))
+	}
+	return ingressClassList
+}
+
+func (list IngressClassList) Each(f func(element *IngressClass)) {
+	for _, ingressClass := range list {
+		f(ingressClass)
+	}
+}
+
+func (list IngressClassList) EachResource(f func(element resources.Resource)) {
+	for _, ingressClass := range list {
+		f(ingressClass)
+	}
+}
+
+func (list IngressClassList) AsInterfaces() []interface{} {
+	var asInterfaces []interface{}
+	list.Each(func(element *IngressClass) {
+		asInterfaces = append(asInterfaces, element)
+	})
+	return asInterfaces
+}
+
+// Kubernetes Adapter for IngressClass
+
+func (o *IngressClass) GetObjectKind() schema.ObjectKind {
+	t := IngressClassCrd.TypeMeta()
+	return &t
+}
+
+func (o *IngressClass) DeepCopyObject() runtime.Object {
+	return resources.Clone(o).(*IngressClass)
+}
+
+func (o *IngressClass) DeepCopyInto(out *IngressClass) {
+	clone := resources.Clone(o).(*IngressClass)
+	*out = *clone
+}
+
+var (
+	IngressClassCrd = crd.NewCrd(
+		"ingressclasses",
+		IngressClassGVK.Group,
+		IngressClassGVK.Version,
+		IngressClassGVK.Kind,
+		"igc",
+		true,
+		&IngressClass{})
+)
+
+var (
+	IngressClassGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "ingress.solo.io",
+		Kind:    "IngressClass",
+	}
+)
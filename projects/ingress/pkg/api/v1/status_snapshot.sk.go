@@ -14,14 +14,16 @@ import (
 )
 
 type StatusSnapshot struct {
-	Services  KubeServiceList
-	Ingresses IngressList
+	Services       KubeServiceList
+	Ingresses      IngressList
+	IngressClasses IngressClassList
 }
 
 func (s StatusSnapshot) Clone() StatusSnapshot {
 	return StatusSnapshot{
-		Services:  s.Services.Clone(),
-		Ingresses: s.Ingresses.Clone(),
+		Services:       s.Services.Clone(),
+		Ingresses:      s.Ingresses.Clone(),
+		IngressClasses: s.IngressClasses.Clone(),
 	}
 }
 
@@ -35,6 +37,9 @@ func (s StatusSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashIngresses(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashIngressClasses(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -46,6 +51,10 @@ func (s StatusSnapshot) hashIngresses(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Ingresses.AsInterfaces()...)
 }
 
+func (s StatusSnapshot) hashIngressClasses(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.IngressClasses.AsInterfaces()...)
+}
+
 func (s StatusSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -59,6 +68,11 @@ func (s StatusSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("ingresses", IngressesHash))
+	IngressClassesHash, err := s.hashIngressClasses(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("ingressClasses", IngressClassesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -67,9 +81,10 @@ func (s StatusSnapshot) HashFields() []zap.Field {
 }
 
 type StatusSnapshotStringer struct {
-	Version   uint64
-	Services  []string
-	Ingresses []string
+	Version        uint64
+	Services       []string
+	Ingresses      []string
+	IngressClasses []string
 }
 
 func (ss StatusSnapshotStringer) String() string {
@@ -85,6 +100,11 @@ func (ss StatusSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  IngressClasses %v\n", len(ss.IngressClasses))
+	for _, name := range ss.IngressClasses {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -94,8 +114,9 @@ func (s StatusSnapshot) Stringer() StatusSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return StatusSnapshotStringer{
-		Version:   snapshotHash,
-		Services:  s.Services.NamespacesDotNames(),
-		Ingresses: s.Ingresses.NamespacesDotNames(),
+		Version:        snapshotHash,
+		Services:       s.Services.NamespacesDotNames(),
+		Ingresses:      s.Ingresses.NamespacesDotNames(),
+		IngressClasses: s.IngressClasses.Names(),
 	}
 }
@@ -82,24 +82,27 @@ type StatusEmitter interface {
 	Register() error
 	KubeService() KubeServiceClient
 	Ingress() IngressClient
+	IngressClass() IngressClassClient
 }
 
-func NewStatusEmitter(kubeServiceClient KubeServiceClient, ingressClient IngressClient) StatusEmitter {
-	return NewStatusEmitterWithEmit(kubeServiceClient, ingressClient, make(chan struct{}))
+func NewStatusEmitter(kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient IngressClassClient) StatusEmitter {
+	return NewStatusEmitterWithEmit(kubeServiceClient, ingressClient, ingressClassClient, make(chan struct{}))
 }
 
-func NewStatusEmitterWithEmit(kubeServiceClient KubeServiceClient, ingressClient IngressClient, emit <-chan struct{}) StatusEmitter {
+func NewStatusEmitterWithEmit(kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient IngressClassClient, emit <-chan struct{}) StatusEmitter {
 	return &statusEmitter{
-		kubeService: kubeServiceClient,
-		ingress:     ingressClient,
-		forceEmit:   emit,
+		kubeService:  kubeServiceClient,
+		ingress:      ingressClient,
+		ingressClass: ingressClassClient,
+		forceEmit:    emit,
 	}
 }
 
 type statusEmitter struct {
-	forceEmit   <-chan struct{}
-	kubeService KubeServiceClient
-	ingress     IngressClient
+	forceEmit    <-chan struct{}
+	kubeService  KubeServiceClient
+	ingress      IngressClient
+	ingressClass IngressClassClient
 }
 
 func (c *statusEmitter) Register() error {
@@ -109,6 +112,9 @@ func (c *statusEmitter) Register() error {
 	if err := c.ingress.Register(); err != nil {
 		return err
 	}
+	if err := c.ingressClass.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,6 +126,10 @@ func (c *statusEmitter) Ingress() IngressClient {
 	return c.ingress
 }
 
+func (c *statusEmitter) IngressClass() IngressClassClient {
+	return c.ingressClass
+}
+
 func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *StatusSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -152,6 +162,7 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 	ingressChan := make(chan ingressListWithNamespace)
 
 	var initialIngressList IngressList
+	/* Create channel for IngressClass */
 
 	currentSnapshot := StatusSnapshot{}
 
@@ -225,6 +236,21 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 	currentSnapshot.Services = initialKubeServiceList.Sort()
 	/* Initialize snapshot for Ingresses */
 	currentSnapshot.Ingresses = initialIngressList.Sort()
+	/* Setup cluster-wide watch for IngressClass */
+	var err error
+	currentSnapshot.IngressClasses, err = c.ingressClass.List(clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "initial IngressClass list")
+	}
+	ingressClassChan, ingressClassErrs, err := c.ingressClass.Watch(opts)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "starting IngressClass watch")
+	}
+	done.Add(1)
+	go func() {
+		defer done.Done()
+		errutils.AggregateErrs(ctx, errs, ingressClassErrs, "ingressClasses")
+	}()
 
 	snapshots := make(chan *StatusSnapshot)
 	go func() {
@@ -320,6 +346,20 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 					ingressList = append(ingressList, ingresses...)
 				}
 				currentSnapshot.Ingresses = ingressList.Sort()
+			case ingressClassList, ok := <-ingressClassChan:
+				if !ok {
+					return
+				}
+				record()
+
+				skstats.IncrementResourceCount(
+					ctx,
+					"<all>",
+					"ingress_class",
+					mStatusResourcesIn,
+				)
+
+				currentSnapshot.IngressClasses = ingressClassList
 			}
 		}
 	}()
@@ -92,6 +92,8 @@ func (c *statusSimpleEmitter) Snapshots(ctx context.Context) (<-chan *StatusSnap
 						currentSnapshot.Services = append(currentSnapshot.Services, typed)
 					case *Ingress:
 						currentSnapshot.Ingresses = append(currentSnapshot.Ingresses, typed)
+					case *IngressClass:
+						currentSnapshot.IngressClasses = append(currentSnapshot.IngressClasses, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("StatusSnapshotEmitter "+
@@ -16,16 +16,18 @@ import (
 )
 
 type TranslatorSnapshot struct {
-	Upstreams gloo_solo_io.UpstreamList
-	Services  KubeServiceList
-	Ingresses IngressList
+	Upstreams      gloo_solo_io.UpstreamList
+	Services       KubeServiceList
+	Ingresses      IngressList
+	IngressClasses IngressClassList
 }
 
 func (s TranslatorSnapshot) Clone() TranslatorSnapshot {
 	return TranslatorSnapshot{
-		Upstreams: s.Upstreams.Clone(),
-		Services:  s.Services.Clone(),
-		Ingresses: s.Ingresses.Clone(),
+		Upstreams:      s.Upstreams.Clone(),
+		Services:       s.Services.Clone(),
+		Ingresses:      s.Ingresses.Clone(),
+		IngressClasses: s.IngressClasses.Clone(),
 	}
 }
 
@@ -42,6 +44,9 @@ func (s TranslatorSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashIngresses(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashIngressClasses(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -57,6 +62,10 @@ func (s TranslatorSnapshot) hashIngresses(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Ingresses.AsInterfaces()...)
 }
 
+func (s TranslatorSnapshot) hashIngressClasses(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.IngressClasses.AsInterfaces()...)
+}
+
 func (s TranslatorSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -75,6 +84,11 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("ingresses", IngressesHash))
+	IngressClassesHash, err := s.hashIngressClasses(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("ingressClasses", IngressClassesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -83,10 +97,11 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 }
 
 type TranslatorSnapshotStringer struct {
-	Version   uint64
-	Upstreams []string
-	Services  []string
-	Ingresses []string
+	Version        uint64
+	Upstreams      []string
+	Services       []string
+	Ingresses      []string
+	IngressClasses []string
 }
 
 func (ss TranslatorSnapshotStringer) String() string {
@@ -107,6 +122,11 @@ func (ss TranslatorSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  IngressClasses %v\n", len(ss.IngressClasses))
+	for _, name := range ss.IngressClasses {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -116,9 +136,10 @@ func (s TranslatorSnapshot) Stringer() TranslatorSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return TranslatorSnapshotStringer{
-		Version:   snapshotHash,
-		Upstreams: s.Upstreams.NamespacesDotNames(),
-		Services:  s.Services.NamespacesDotNames(),
-		Ingresses: s.Ingresses.NamespacesDotNames(),
+		Version:        snapshotHash,
+		Upstreams:      s.Upstreams.NamespacesDotNames(),
+		Services:       s.Services.NamespacesDotNames(),
+		Ingresses:      s.Ingresses.NamespacesDotNames(),
+		IngressClasses: s.IngressClasses.Names(),
 	}
 }
@@ -85,26 +85,29 @@ type TranslatorEmitter interface {
 	Upstream() gloo_solo_io.UpstreamClient
 	KubeService() KubeServiceClient
 	Ingress() IngressClient
+	IngressClass() IngressClassClient
 }
 
-func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient) TranslatorEmitter {
-	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, make(chan struct{}))
+func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient IngressClassClient) TranslatorEmitter {
+	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, ingressClassClient, make(chan struct{}))
 }
 
-func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, emit <-chan struct{}) TranslatorEmitter {
+func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient IngressClassClient, emit <-chan struct{}) TranslatorEmitter {
 	return &translatorEmitter{
-		upstream:    upstreamClient,
-		kubeService: kubeServiceClient,
-		ingress:     ingressClient,
-		forceEmit:   emit,
+		upstream:     upstreamClient,
+		kubeService:  kubeServiceClient,
+		ingress:      ingressClient,
+		ingressClass: ingressClassClient,
+		forceEmit:    emit,
 	}
 }
 
 type translatorEmitter struct {
-	forceEmit   <-chan struct{}
-	upstream    gloo_solo_io.UpstreamClient
-	kubeService KubeServiceClient
-	ingress     IngressClient
+	forceEmit    <-chan struct{}
+	upstream     gloo_solo_io.UpstreamClient
+	kubeService  KubeServiceClient
+	ingress      IngressClient
+	ingressClass IngressClassClient
 }
 
 func (c *translatorEmitter) Register() error {
@@ -117,6 +120,9 @@ func (c *translatorEmitter) Register() error {
 	if err := c.ingress.Register(); err != nil {
 		return err
 	}
+	if err := c.ingressClass.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -132,6 +138,10 @@ func (c *translatorEmitter) Ingress() IngressClient {
 	return c.ingress
 }
 
+func (c *translatorEmitter) IngressClass() IngressClassClient {
+	return c.ingressClass
+}
+
 func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -172,6 +182,7 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	ingressChan := make(chan ingressListWithNamespace)
 
 	var initialIngressList IngressList
+	/* Create channel for IngressClass */
 
 	currentSnapshot := TranslatorSnapshot{}
 
@@ -274,6 +285,21 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	currentSnapshot.Services = initialKubeServiceList.Sort()
 	/* Initialize snapshot for Ingresses */
 	currentSnapshot.Ingresses = initialIngressList.Sort()
+	/* Setup cluster-wide watch for IngressClass */
+	var err error
+	currentSnapshot.IngressClasses, err = c.ingressClass.List(clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "initial IngressClass list")
+	}
+	ingressClassChan, ingressClassErrs, err := c.ingressClass.Watch(opts)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "starting IngressClass watch")
+	}
+	done.Add(1)
+	go func() {
+		defer done.Done()
+		errutils.AggregateErrs(ctx, errs, ingressClassErrs, "ingressClasses")
+	}()
 
 	snapshots := make(chan *TranslatorSnapshot)
 	go func() {
@@ -392,6 +418,20 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 					ingressList = append(ingressList, ingresses...)
 				}
 				currentSnapshot.Ingresses = ingressList.Sort()
+			case ingressClassList, ok := <-ingressClassChan:
+				if !ok {
+					return
+				}
+				record()
+
+				skstats.IncrementResourceCount(
+					ctx,
+					"<all>",
+					"ingress_class",
+					mTranslatorResourcesIn,
+				)
+
+				currentSnapshot.IngressClasses = ingressClassList
 			}
 		}
 	}()
@@ -96,6 +96,8 @@ func (c *translatorSimpleEmitter) Snapshots(ctx context.Context) (<-chan *Transl
 						currentSnapshot.Services = append(currentSnapshot.Services, typed)
 					case *Ingress:
 						currentSnapshot.Ingresses = append(currentSnapshot.Ingresses, typed)
+					case *IngressClass:
+						currentSnapshot.IngressClasses = append(currentSnapshot.IngressClasses, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("TranslatorSnapshotEmitter "+
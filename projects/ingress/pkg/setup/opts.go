@@ -21,5 +21,6 @@ type Opts struct {
 	DisableKubeIngress          bool
 	RequireIngressClass         bool
 	CustomIngressClass          string
+	CustomIngressControllerName string
 	IngressProxyLabel           string
 }
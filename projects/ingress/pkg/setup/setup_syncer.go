@@ -18,6 +18,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/ingress/pkg/status"
@@ -34,6 +35,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	knativeclientset "knative.dev/networking/pkg/client/clientset/versioned"
@@ -105,6 +107,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	requireIngressClass := envTrue("REQUIRE_INGRESS_CLASS")
 	enableKnative := envTrue("ENABLE_KNATIVE_INGRESS")
 	customIngressClass := os.Getenv("CUSTOM_INGRESS_CLASS")
+	customIngressControllerName := os.Getenv("CUSTOM_INGRESS_CONTROLLER_NAME")
 	knativeVersion := os.Getenv("KNATIVE_VERSION")
 	ingressProxyLabel := os.Getenv("INGRESS_PROXY_LABEL")
 
@@ -141,12 +144,13 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
 		},
-		EnableKnative:       enableKnative,
-		KnativeVersion:      knativeVersion,
-		DisableKubeIngress:  disableKubeIngress,
-		RequireIngressClass: requireIngressClass,
-		CustomIngressClass:  customIngressClass,
-		IngressProxyLabel:   ingressProxyLabel,
+		EnableKnative:               enableKnative,
+		KnativeVersion:              knativeVersion,
+		DisableKubeIngress:          disableKubeIngress,
+		RequireIngressClass:         requireIngressClass,
+		CustomIngressClass:          customIngressClass,
+		IngressProxyLabel:           ingressProxyLabel,
+		CustomIngressControllerName: customIngressControllerName,
 	}
 
 	return RunIngress(opts)
@@ -195,7 +199,9 @@ func RunIngress(opts Opts) error {
 		baseKubeServiceClient := service.NewResourceClient(kube, &v1.KubeService{})
 		kubeServiceClient := v1.NewKubeServiceClientWithBase(baseKubeServiceClient)
 
-		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient)
+		ingressClassClient := v1.NewIngressClassClientWithBase(ingressClassResourceClient(opts.WatchOpts.Ctx, kube, opts.RequireIngressClass))
+
+		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient, ingressClassClient)
 		statusClient := statusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
 		translatorSync := translator.NewSyncer(
 			opts.WriteNamespace,
@@ -204,6 +210,7 @@ func RunIngress(opts Opts) error {
 			writeErrs,
 			opts.RequireIngressClass,
 			opts.CustomIngressClass,
+			opts.CustomIngressControllerName,
 			statusClient)
 		translatorEventLoop := v1.NewTranslatorEventLoop(translatorEmitter, translatorSync)
 		translatorEventLoopErrs, err := translatorEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
@@ -217,8 +224,8 @@ func RunIngress(opts Opts) error {
 		ingressServiceClient := service.NewClientWithSelector(kubeServiceClient, map[string]string{
 			"gloo": opts.IngressProxyLabel,
 		})
-		statusEmitter := v1.NewStatusEmitter(ingressServiceClient, ingressClient)
-		statusSync := status.NewSyncer(ingressClient)
+		statusEmitter := v1.NewStatusEmitter(ingressServiceClient, ingressClient, ingressClassClient)
+		statusSync := status.NewSyncer(ingressClient, opts.RequireIngressClass, opts.CustomIngressClass, opts.CustomIngressControllerName)
 		statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 		statusEventLoopErrs, err := statusEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -308,6 +315,21 @@ func RunIngress(opts Opts) error {
 // knative is pre-0.8.0 in the absence of a valid version parameter
 const defaultPre080 = true
 
+// ingressClassResourceClient returns the client of the IngressClasses. They are only watched if ingresses require an
+// ingress class and they can be listed, i.e. the cluster supports them and we are allowed to list them. Otherwise no
+// IngressClasses exist, so ingress class names are matched against the ingress class.
+func ingressClassResourceClient(ctx context.Context, kube kubernetes.Interface, requireIngressClass bool) clients.ResourceClient {
+	noIngressClasses := memory.NewResourceClient(memory.NewInMemoryResourceCache(), &v1.IngressClass{})
+	if !requireIngressClass {
+		return noIngressClasses
+	}
+	if _, err := kube.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{}); err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("unable to list ingress classes, ingress class names will be matched against the ingress class: %v", err)
+		return noIngressClasses
+	}
+	return ingressclass.NewResourceClient(kube, &v1.IngressClass{})
+}
+
 func pre080knativeVersion(version string) bool {
 	// expected format: 0.8.0
 	parts := strings.Split(version, ".")
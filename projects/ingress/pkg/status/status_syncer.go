@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"sort"
+	"strings"
 
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/go-utils/hashutils"
@@ -13,8 +14,10 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
@@ -23,11 +26,20 @@ import (
 
 type statusSyncer struct {
 	ingressClient v1.IngressClient
+
+	// determine the ingresses handled by us, which are told about their invalid annotations.
+	// see the translator syncer.
+	requireIngressClass         bool
+	customIngressClass          string
+	customIngressControllerName string
 }
 
-func NewSyncer(ingressClient v1.IngressClient) v1.StatusSyncer {
+func NewSyncer(ingressClient v1.IngressClient, requireIngressClass bool, customIngressClass, customIngressControllerName string) v1.StatusSyncer {
 	return &statusSyncer{
-		ingressClient: ingressClient,
+		ingressClient:               ingressClient,
+		requireIngressClass:         requireIngressClass,
+		customIngressClass:          customIngressClass,
+		customIngressControllerName: customIngressControllerName,
 	}
 }
 
@@ -36,8 +48,8 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 	ctx = contextutils.WithLogger(ctx, "statusSyncer")
 	snapHash := hashutils.MustHash(snap)
 	logger := contextutils.LoggerFrom(ctx)
-	logger.Infof("begin sync %v (%v ingresses, %v services)", snapHash,
-		len(snap.Ingresses), len(snap.Services))
+	logger.Infof("begin sync %v (%v ingresses, %v services, %v ingress classes)", snapHash,
+		len(snap.Ingresses), len(snap.Services), len(snap.IngressClasses))
 	defer logger.Infof("end sync %v", snapHash)
 	services := snap.Services
 
@@ -52,19 +64,26 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 		return err
 	}
 
+	ourIngresses := make(map[string]bool)
+	for _, kubeIngress := range translator.OurIngresses(ctx, snap.Ingresses, snap.IngressClasses, s.requireIngressClass, s.customIngressClass, s.customIngressControllerName) {
+		ourIngresses[kubeIngress.Namespace+"/"+kubeIngress.Name] = true
+	}
+
 	for _, ing := range snap.Ingresses {
 		kubeIngress, err := ingress.ToKube(ing)
 		if err != nil {
 			return errors.Wrapf(err, "internal error: converting proto ingress to kube ingress")
 		}
 		kubeIngress.Status.LoadBalancer.Ingress = lbStatus
+		setAnnotationErrors(kubeIngress, ourIngresses[kubeIngress.Namespace+"/"+kubeIngress.Name])
 
 		updatedIngress, err := ingress.FromKube(kubeIngress)
 		if err != nil {
 			return errors.Wrapf(err, "internal error: converting back to proto ingress from kube ingress")
 		}
 
-		if proto.Equal(updatedIngress.GetKubeIngressStatus(), ing.GetKubeIngressStatus()) {
+		if proto.Equal(updatedIngress.GetKubeIngressStatus(), ing.GetKubeIngressStatus()) &&
+			updatedIngress.GetMetadata().GetAnnotations()[translator.AnnotationErrorsAnnotation] == ing.GetMetadata().GetAnnotations()[translator.AnnotationErrorsAnnotation] {
 			continue
 		}
 		if _, err := s.ingressClient.Write(updatedIngress, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
@@ -76,6 +95,18 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 	return nil
 }
 
+// setAnnotationErrors reports the invalid annotations of an ingress handled by us in an annotation, as ingresses
+// have no status conditions
+func setAnnotationErrors(kubeIngress *networkingv1.Ingress, ours bool) {
+	if ours {
+		if err := translator.ValidateAnnotations(kubeIngress); err != nil {
+			kubeIngress.Annotations[translator.AnnotationErrorsAnnotation] = strings.TrimSpace(err.Error())
+			return
+		}
+	}
+	delete(kubeIngress.Annotations, translator.AnnotationErrorsAnnotation)
+}
+
 func getLbStatus(services v1.KubeServiceList) ([]kubev1.LoadBalancerIngress, error) {
 	switch len(services) {
 	case 0:
@@ -0,0 +1,139 @@
+package status_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/ingress/pkg/status"
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("StatusSyncer", func() {
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		ingressClient v1.IngressClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		ingressClient, err = v1.NewIngressClient(ctx, &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	writeIngress := func(name string, className *string, annotations map[string]string) {
+		kubeIngress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns",
+				Name:        name,
+				Annotations: annotations,
+			},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: className,
+			},
+		}
+		ing, err := ingress.FromKube(kubeIngress)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ingressClient.Write(ing, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	makeClass := func(name, controller string) *v1.IngressClass {
+		class, err := ingressclass.FromKube(&networkingv1.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: networkingv1.IngressClassSpec{
+				Controller: controller,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return class
+	}
+
+	sync := func(syncer v1.StatusSyncer, classes v1.IngressClassList) {
+		ingresses, err := ingressClient.List("ns", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = syncer.Sync(ctx, &v1.StatusSnapshot{
+			Ingresses:      ingresses,
+			IngressClasses: classes,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	annotationErrors := func(name string) (string, bool) {
+		ing, err := ingressClient.Read("ns", name, clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		errs, ok := ing.GetMetadata().GetAnnotations()[translator.AnnotationErrorsAnnotation]
+		return errs, ok
+	}
+
+	It("reports the invalid annotations of ingresses until they are fixed", func() {
+		syncer := NewSyncer(ingressClient, false, "", "")
+		writeIngress("valid", nil, map[string]string{translator.TimeoutAnnotation: "5s"})
+		writeIngress("invalid", nil, map[string]string{"gloo.solo.io/timout": "5s"})
+		sync(syncer, nil)
+
+		_, ok := annotationErrors("valid")
+		Expect(ok).To(BeFalse())
+		errs, ok := annotationErrors("invalid")
+		Expect(ok).To(BeTrue())
+		Expect(errs).To(ContainSubstring(translator.UnknownAnnotationError("gloo.solo.io/timout").Error()))
+
+		// the errors stay reported while the annotations are unchanged
+		sync(syncer, nil)
+		_, ok = annotationErrors("invalid")
+		Expect(ok).To(BeTrue())
+
+		ing, err := ingressClient.Read("ns", "invalid", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		delete(ing.GetMetadata().GetAnnotations(), "gloo.solo.io/timout")
+		_, err = ingressClient.Write(ing, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		sync(syncer, nil)
+
+		_, ok = annotationErrors("invalid")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("reports invalid annotations only on the ingresses handled by us", func() {
+		syncer := NewSyncer(ingressClient, true, "", "")
+		ours, theirs := "ours", "theirs"
+		writeIngress("ours", &ours, map[string]string{"gloo.solo.io/timout": "5s"})
+		writeIngress("theirs", &theirs, map[string]string{"gloo.solo.io/timout": "5s"})
+		sync(syncer, v1.IngressClassList{
+			makeClass(ours, translator.DefaultIngressControllerName),
+			makeClass(theirs, "k8s.io/ingress-nginx"),
+		})
+
+		_, ok := annotationErrors("ours")
+		Expect(ok).To(BeTrue())
+		_, ok = annotationErrors("theirs")
+		Expect(ok).To(BeFalse())
+
+		// the class of the ingress is no longer controlled by us
+		sync(syncer, v1.IngressClassList{
+			makeClass(ours, "k8s.io/ingress-nginx"),
+			makeClass(theirs, "k8s.io/ingress-nginx"),
+		})
+		_, ok = annotationErrors("ours")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,274 @@
+package translator
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	matcherv3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// The annotations which configure the routes of an ingress. Unless noted otherwise, their values are single strings.
+const (
+	annotationPrefix = "gloo.solo.io/"
+
+	// the path prefix which replaces the matched prefix of the request path
+	PrefixRewriteAnnotation = annotationPrefix + "prefix-rewrite"
+	// the timeout for requests, as a duration such as "15s"
+	TimeoutAnnotation = annotationPrefix + "timeout"
+	// the maximum number of retries of a request
+	RetriesAnnotation = annotationPrefix + "retries"
+	// the conditions under which requests are retried, e.g. "5xx,connect-failure"
+	RetryOnAnnotation = annotationPrefix + "retry-on"
+	// the timeout for each attempt of a request, as a duration such as "5s"
+	PerTryTimeoutAnnotation = annotationPrefix + "per-try-timeout"
+	// "true" to redirect plaintext requests for hosts which have TLS configured to https
+	SslRedirectAnnotation = annotationPrefix + "ssl-redirect"
+	// the comma-separated origins allowed to make CORS requests. Required for the other CORS annotations to apply.
+	CorsAllowOriginAnnotation = annotationPrefix + "cors-allow-origin"
+	// the comma-separated methods allowed in CORS requests
+	CorsAllowMethodsAnnotation = annotationPrefix + "cors-allow-methods"
+	// the comma-separated headers allowed in CORS requests
+	CorsAllowHeadersAnnotation = annotationPrefix + "cors-allow-headers"
+	// the comma-separated headers exposed to CORS requests
+	CorsExposeHeadersAnnotation = annotationPrefix + "cors-expose-headers"
+	// how long, in seconds, the results of a CORS preflight request may be cached
+	CorsMaxAgeAnnotation = annotationPrefix + "cors-max-age"
+	// "true" to allow credentials in CORS requests
+	CorsAllowCredentialsAnnotation = annotationPrefix + "cors-allow-credentials"
+	// a JSON object of the headers to add to requests, e.g. '{"x-foo": "bar"}'
+	RequestHeadersToAddAnnotation = annotationPrefix + "request-headers-to-add"
+	// the comma-separated headers to remove from requests
+	RequestHeadersToRemoveAnnotation = annotationPrefix + "request-headers-to-remove"
+	// a JSON object of the headers to add to responses, e.g. '{"x-foo": "bar"}'
+	ResponseHeadersToAddAnnotation = annotationPrefix + "response-headers-to-add"
+	// the comma-separated headers to remove from responses
+	ResponseHeadersToRemoveAnnotation = annotationPrefix + "response-headers-to-remove"
+
+	// AnnotationErrorsAnnotation is set on ingresses by the status syncer to report their invalid annotations
+	AnnotationErrorsAnnotation = annotationPrefix + "annotation-errors"
+)
+
+var (
+	UnknownAnnotationError = func(key string) error {
+		return errors.Errorf("unknown annotation %v", key)
+	}
+
+	InvalidAnnotationError = func(key string, err error) error {
+		return errors.Wrapf(err, "invalid value for annotation %v", key)
+	}
+
+	MissingCorsAllowOriginError = errors.Errorf("annotation %v is required for the CORS annotations to apply", CorsAllowOriginAnnotation)
+)
+
+// ingressOptions are the options configured by the annotations of an ingress
+type ingressOptions struct {
+	// nil if no route options are configured
+	routeOptions *gloov1.RouteOptions
+	sslRedirect  bool
+}
+
+// routeOptionsForRoute returns a copy of the route options, so that routes do not share them
+func (o *ingressOptions) routeOptionsForRoute() *gloov1.RouteOptions {
+	if o.routeOptions == nil {
+		return nil
+	}
+	return o.routeOptions.Clone().(*gloov1.RouteOptions)
+}
+
+// routeOptionsForPath returns a copy of the route options for the route of an ingress path. Prefix paths are matched
+// by a regex, for which envoy would replace the whole path with the prefix rewrite, so the rewrite becomes a regex
+// rewrite which replaces only the prefix.
+func (o *ingressOptions) routeOptionsForPath(path networkingv1.HTTPIngressPath) *gloov1.RouteOptions {
+	options := o.routeOptionsForRoute()
+	if options.GetPrefixRewrite() == nil || path.PathType == nil || *path.PathType != networkingv1.PathTypePrefix {
+		return options
+	}
+	pathRegex := matcherForPath(path).GetRegex()
+	if pathRegex == "" {
+		return options
+	}
+	options.RegexRewrite = &matcherv3.RegexMatchAndSubstitute{
+		Pattern:      &matcherv3.RegexMatcher{Regex: "^" + pathRegex + "$"},
+		Substitution: options.GetPrefixRewrite().GetValue() + `\1`,
+	}
+	options.PrefixRewrite = nil
+	return options
+}
+
+// ValidateAnnotations returns an error describing the invalid gloo.solo.io annotations of an ingress, if any
+func ValidateAnnotations(ingress *networkingv1.Ingress) error {
+	_, err := parseAnnotations(ingress.Annotations)
+	return err
+}
+
+// parseAnnotations returns the options configured by the valid annotations. Invalid annotations are ignored,
+// and reported in the returned error.
+func parseAnnotations(annotations map[string]string) (*ingressOptions, error) {
+	var errs *multierror.Error
+	options := &ingressOptions{}
+	routeOptions := &gloov1.RouteOptions{}
+	corsPolicy := &cors.CorsPolicy{}
+	headerManipulation := &headers.HeaderManipulation{}
+	var retryPolicy *retries.RetryPolicy
+	getRetryPolicy := func() *retries.RetryPolicy {
+		if retryPolicy == nil {
+			retryPolicy = &retries.RetryPolicy{}
+		}
+		return retryPolicy
+	}
+	var hasCors bool
+
+	// iterate in order, so that errors are reported deterministically
+	var keys []string
+	for key := range annotations {
+		if strings.HasPrefix(key, annotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := annotations[key]
+		var err error
+		switch key {
+		case PrefixRewriteAnnotation:
+			routeOptions.PrefixRewrite = &wrappers.StringValue{Value: value}
+		case TimeoutAnnotation:
+			var timeout time.Duration
+			if timeout, err = parseDuration(value); err == nil {
+				routeOptions.Timeout = ptypes.DurationProto(timeout)
+			}
+		case RetriesAnnotation:
+			var numRetries uint64
+			if numRetries, err = strconv.ParseUint(value, 10, 32); err == nil {
+				getRetryPolicy().NumRetries = uint32(numRetries)
+			}
+		case RetryOnAnnotation:
+			getRetryPolicy().RetryOn = value
+		case PerTryTimeoutAnnotation:
+			var perTryTimeout time.Duration
+			if perTryTimeout, err = parseDuration(value); err == nil {
+				getRetryPolicy().PerTryTimeout = ptypes.DurationProto(perTryTimeout)
+			}
+		case SslRedirectAnnotation:
+			options.sslRedirect, err = strconv.ParseBool(value)
+		case CorsAllowOriginAnnotation:
+			corsPolicy.AllowOrigin = splitList(value)
+		case CorsAllowMethodsAnnotation:
+			hasCors = true
+			corsPolicy.AllowMethods = splitList(value)
+		case CorsAllowHeadersAnnotation:
+			hasCors = true
+			corsPolicy.AllowHeaders = splitList(value)
+		case CorsExposeHeadersAnnotation:
+			hasCors = true
+			corsPolicy.ExposeHeaders = splitList(value)
+		case CorsMaxAgeAnnotation:
+			hasCors = true
+			if _, err = strconv.ParseUint(value, 10, 64); err == nil {
+				corsPolicy.MaxAge = value
+			}
+		case CorsAllowCredentialsAnnotation:
+			hasCors = true
+			corsPolicy.AllowCredentials, err = strconv.ParseBool(value)
+		case RequestHeadersToAddAnnotation:
+			var headersToAdd map[string]string
+			if headersToAdd, err = parseHeaders(value); err == nil {
+				for _, name := range sortedKeys(headersToAdd) {
+					headerManipulation.RequestHeadersToAdd = append(headerManipulation.GetRequestHeadersToAdd(), &envoycore_sk.HeaderValueOption{
+						HeaderOption: &envoycore_sk.HeaderValueOption_Header{
+							Header: &envoycore_sk.HeaderValue{Key: name, Value: headersToAdd[name]},
+						},
+					})
+				}
+			}
+		case RequestHeadersToRemoveAnnotation:
+			headerManipulation.RequestHeadersToRemove = splitList(value)
+		case ResponseHeadersToAddAnnotation:
+			var headersToAdd map[string]string
+			if headersToAdd, err = parseHeaders(value); err == nil {
+				for _, name := range sortedKeys(headersToAdd) {
+					headerManipulation.ResponseHeadersToAdd = append(headerManipulation.GetResponseHeadersToAdd(), &headers.HeaderValueOption{
+						Header: &headers.HeaderValue{Key: name, Value: headersToAdd[name]},
+					})
+				}
+			}
+		case ResponseHeadersToRemoveAnnotation:
+			headerManipulation.ResponseHeadersToRemove = splitList(value)
+		case AnnotationErrorsAnnotation:
+			// written by us
+		default:
+			errs = multierror.Append(errs, UnknownAnnotationError(key))
+		}
+		if err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationError(key, err))
+		}
+	}
+
+	if len(corsPolicy.GetAllowOrigin()) > 0 {
+		routeOptions.Cors = corsPolicy
+	} else if hasCors {
+		errs = multierror.Append(errs, MissingCorsAllowOriginError)
+	}
+	if retryPolicy != nil {
+		routeOptions.Retries = retryPolicy
+	}
+	if !headerManipulation.Equal(&headers.HeaderManipulation{}) {
+		routeOptions.HeaderManipulation = headerManipulation
+	}
+	if !routeOptions.Equal(&gloov1.RouteOptions{}) {
+		options.routeOptions = routeOptions
+	}
+	return options, errs.ErrorOrNil()
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, errors.Errorf("duration %v must not be negative", value)
+	}
+	return duration, nil
+}
+
+func parseHeaders(value string) (map[string]string, error) {
+	var headersToAdd map[string]string
+	if err := json.Unmarshal([]byte(value), &headersToAdd); err != nil {
+		return nil, errors.Wrapf(err, "headers must be a JSON object of header names to values")
+	}
+	return headersToAdd, nil
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
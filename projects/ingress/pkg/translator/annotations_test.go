@@ -0,0 +1,106 @@
+package translator
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Annotations", func() {
+
+	It("returns no options without annotations", func() {
+		options, err := parseAnnotations(map[string]string{
+			"kubernetes.io/ingress.class": "gloo",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.routeOptions).To(BeNil())
+		Expect(options.sslRedirect).To(BeFalse())
+	})
+
+	It("maps annotations onto route options", func() {
+		options, err := parseAnnotations(map[string]string{
+			PrefixRewriteAnnotation:           "/v2",
+			TimeoutAnnotation:                 "15s",
+			RetriesAnnotation:                 "3",
+			RetryOnAnnotation:                 "5xx,connect-failure",
+			PerTryTimeoutAnnotation:           "5s",
+			SslRedirectAnnotation:             "true",
+			CorsAllowOriginAnnotation:         "https://a.com, https://b.com",
+			CorsAllowMethodsAnnotation:        "GET,POST",
+			CorsAllowHeadersAnnotation:        "x-foo",
+			CorsExposeHeadersAnnotation:       "x-bar",
+			CorsMaxAgeAnnotation:              "600",
+			CorsAllowCredentialsAnnotation:    "true",
+			RequestHeadersToAddAnnotation:     `{"x-b": "2", "x-a": "1"}`,
+			RequestHeadersToRemoveAnnotation:  "x-c",
+			ResponseHeadersToAddAnnotation:    `{"x-d": "4"}`,
+			ResponseHeadersToRemoveAnnotation: "server,x-e",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.sslRedirect).To(BeTrue())
+
+		routeOptions := options.routeOptions
+		Expect(routeOptions.GetPrefixRewrite().GetValue()).To(Equal("/v2"))
+		Expect(routeOptions.GetTimeout()).To(Equal(ptypes.DurationProto(15 * time.Second)))
+
+		Expect(routeOptions.GetRetries().GetNumRetries()).To(Equal(uint32(3)))
+		Expect(routeOptions.GetRetries().GetRetryOn()).To(Equal("5xx,connect-failure"))
+		Expect(routeOptions.GetRetries().GetPerTryTimeout()).To(Equal(ptypes.DurationProto(5 * time.Second)))
+
+		corsPolicy := routeOptions.GetCors()
+		Expect(corsPolicy.GetAllowOrigin()).To(Equal([]string{"https://a.com", "https://b.com"}))
+		Expect(corsPolicy.GetAllowMethods()).To(Equal([]string{"GET", "POST"}))
+		Expect(corsPolicy.GetAllowHeaders()).To(Equal([]string{"x-foo"}))
+		Expect(corsPolicy.GetExposeHeaders()).To(Equal([]string{"x-bar"}))
+		Expect(corsPolicy.GetMaxAge()).To(Equal("600"))
+		Expect(corsPolicy.GetAllowCredentials()).To(BeTrue())
+
+		headerManipulation := routeOptions.GetHeaderManipulation()
+		Expect(headerManipulation.GetRequestHeadersToAdd()).To(HaveLen(2))
+		Expect(headerManipulation.GetRequestHeadersToAdd()[0].GetHeader().GetKey()).To(Equal("x-a"))
+		Expect(headerManipulation.GetRequestHeadersToAdd()[0].GetHeader().GetValue()).To(Equal("1"))
+		Expect(headerManipulation.GetRequestHeadersToAdd()[1].GetHeader().GetKey()).To(Equal("x-b"))
+		Expect(headerManipulation.GetRequestHeadersToRemove()).To(Equal([]string{"x-c"}))
+		Expect(headerManipulation.GetResponseHeadersToAdd()).To(HaveLen(1))
+		Expect(headerManipulation.GetResponseHeadersToAdd()[0].GetHeader().GetKey()).To(Equal("x-d"))
+		Expect(headerManipulation.GetResponseHeadersToRemove()).To(Equal([]string{"server", "x-e"}))
+	})
+
+	It("ignores and reports invalid annotations", func() {
+		options, err := parseAnnotations(map[string]string{
+			PrefixRewriteAnnotation:    "/v2",
+			TimeoutAnnotation:          "soon",
+			RetriesAnnotation:          "-1",
+			SslRedirectAnnotation:      "yes please",
+			CorsAllowMethodsAnnotation: "GET",
+			"gloo.solo.io/timout":      "15s",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(TimeoutAnnotation))
+		Expect(err.Error()).To(ContainSubstring(RetriesAnnotation))
+		Expect(err.Error()).To(ContainSubstring(SslRedirectAnnotation))
+		Expect(err.Error()).To(ContainSubstring(MissingCorsAllowOriginError.Error()))
+		Expect(err.Error()).To(ContainSubstring(UnknownAnnotationError("gloo.solo.io/timout").Error()))
+
+		Expect(options.sslRedirect).To(BeFalse())
+		Expect(options.routeOptions.GetPrefixRewrite().GetValue()).To(Equal("/v2"))
+		Expect(options.routeOptions.GetTimeout()).To(BeNil())
+		Expect(options.routeOptions.GetRetries()).To(BeNil())
+		Expect(options.routeOptions.GetCors()).To(BeNil())
+	})
+
+	It("does not report the annotation which reports the annotation errors", func() {
+		err := ValidateAnnotations(&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					AnnotationErrorsAnnotation: "unknown annotation gloo.solo.io/timout",
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
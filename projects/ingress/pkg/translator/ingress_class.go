@@ -0,0 +1,55 @@
+package translator
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+const defaultIngressClass = "gloo"
+
+// DefaultIngressControllerName is the controller which IngressClasses must name for their ingresses to be handled by Gloo Edge
+const DefaultIngressControllerName = "solo.io/gloo-ingress"
+
+const IngressClassKey = "kubernetes.io/ingress.class"
+
+// isOurIngress determines whether an ingress belongs to this controller. In order of precedence:
+// - the legacy ingress class annotation must match our ingress class
+// - the IngressClass named by the ingress must be controlled by us. If no such IngressClass exists,
+//   its name must match our ingress class.
+// - an ingress without a class belongs to us if we control the default IngressClass
+func isOurIngress(ingress *networkingv1.Ingress, ingressClassToUse, controllerName string, ingressClasses []*networkingv1.IngressClass) bool {
+	if annotation := ingress.Annotations[IngressClassKey]; annotation != "" {
+		return annotation == ingressClassToUse
+	}
+	if className := ingress.Spec.IngressClassName; className != nil {
+		for _, class := range ingressClasses {
+			if class.Name == *className {
+				return class.Spec.Controller == controllerName
+			}
+		}
+		return *className == ingressClassToUse
+	}
+	for _, class := range ingressClasses {
+		if class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] == "true" && class.Spec.Controller == controllerName {
+			return true
+		}
+	}
+	return false
+}
+
+func kubeIngressClasses(ctx context.Context, snapIngressClasses v1.IngressClassList) []*networkingv1.IngressClass {
+	var ingressClasses []*networkingv1.IngressClass
+	for _, class := range snapIngressClasses {
+		kubeClass, err := ingressclass.ToKube(class)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("internal error: parsing internal ingress class representation: %v", err)
+			continue
+		}
+		ingressClasses = append(ingressClasses, kubeClass)
+	}
+	return ingressClasses
+}
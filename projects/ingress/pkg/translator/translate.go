@@ -2,7 +2,9 @@ package translator
 
 import (
 	"context"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	"github.com/solo-io/go-utils/contextutils"
@@ -20,25 +22,8 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 )
 
-const defaultIngressClass = "gloo"
-
-const IngressClassKey = "kubernetes.io/ingress.class"
-
-func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string) *gloov1.Proxy {
-
-	if ingressClass == "" {
-		ingressClass = defaultIngressClass
-	}
-
-	var ingresses []*networkingv1.Ingress
-	for _, ig := range snap.Ingresses {
-		kubeIngress, err := ingress.ToKube(ig)
-		if err != nil {
-			contextutils.LoggerFrom(ctx).Errorf("internal error: parsing internal ingress representation: %v", err)
-			continue
-		}
-		ingresses = append(ingresses, kubeIngress)
-	}
+func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass, controllerName string) *gloov1.Proxy {
+	ingresses := OurIngresses(ctx, snap.Ingresses, snap.IngressClasses, requireIngressClass, ingressClass, controllerName)
 
 	var services []*kubev1.Service
 	for _, svc := range snap.Services {
@@ -52,7 +37,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 
 	upstreams := snap.Upstreams
 
-	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services, requireIngressClass, ingressClass)
+	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services)
 
 	var virtualHostsHttps []*gloov1.VirtualHost
 	var sslConfigs []*gloov1.SslConfig
@@ -101,6 +86,39 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 	}
 }
 
+// OurIngresses returns the ingresses handled by us, sorted by namespace and name. When ingresses conflict, such as
+// by declaring a default backend or the TLS secret of a host, the first in that order takes precedence.
+func OurIngresses(ctx context.Context, snapIngresses v1.IngressList, snapIngressClasses v1.IngressClassList, requireIngressClass bool, ingressClass, controllerName string) []*networkingv1.Ingress {
+	if ingressClass == "" {
+		ingressClass = defaultIngressClass
+	}
+	if controllerName == "" {
+		controllerName = DefaultIngressControllerName
+	}
+	ingressClasses := kubeIngressClasses(ctx, snapIngressClasses)
+
+	var ingresses []*networkingv1.Ingress
+	for _, ig := range snapIngresses {
+		kubeIngress, err := ingress.ToKube(ig)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("internal error: parsing internal ingress representation: %v", err)
+			continue
+		}
+		if requireIngressClass && !isOurIngress(kubeIngress, ingressClass, controllerName, ingressClasses) {
+			continue
+		}
+		ingresses = append(ingresses, kubeIngress)
+	}
+
+	sort.SliceStable(ingresses, func(i, j int) bool {
+		if ingresses[i].Namespace != ingresses[j].Namespace {
+			return ingresses[i].Namespace < ingresses[j].Namespace
+		}
+		return ingresses[i].Name < ingresses[j].Name
+	})
+	return ingresses
+}
+
 func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Service, ingressNamespace string, backend networkingv1.IngressBackend) (*gloov1.Upstream, error) {
 	serviceName, servicePort, err := getServiceNameAndPort(services, ingressNamespace, backend.Service)
 	if err != nil {
@@ -166,22 +184,36 @@ type secureVirtualHost struct {
 	secret core.ResourceRef
 }
 
-func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
+func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service) ([]*gloov1.VirtualHost, []secureVirtualHost) {
 	routesByHostHttp := make(map[string][]*gloov1.Route)
 	routesByHostHttps := make(map[string][]*gloov1.Route)
 	secretsByHost := make(map[string]*core.ResourceRef)
 	var defaultBackend *networkingv1.IngressBackend
+	var defaultBackendIngress string
+	var defaultRoute *gloov1.Route
 	for _, ing := range ingresses {
-		if requireIngressClass && !isOurIngress(ing, ingressClass) {
-			continue
+		options, err := parseAnnotations(ing.Annotations)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("ignoring invalid annotations of ingress %v: %v", ing.Name, err)
 		}
 		spec := ing.Spec
 		if spec.DefaultBackend != nil {
 			if defaultBackend != nil {
-				contextutils.LoggerFrom(ctx).Warnf("default backend was redeclared in ingress %v, ignoring", ing.Name)
-				continue
+				contextutils.LoggerFrom(ctx).Warnf("default backend was redeclared in ingress %v, ignoring as ingress %v takes precedence", ing.Name, defaultBackendIngress)
+			} else {
+				defaultBackend = spec.DefaultBackend
+				defaultBackendIngress = ing.Namespace + "/" + ing.Name
+				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, *defaultBackend)
+				if err != nil {
+					contextutils.LoggerFrom(ctx).Errorf("lookup upstream for default backend of ingress %v: %v", ing.Name, err)
+				} else {
+					defaultRoute = routeToUpstream(&matchers.Matcher{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/",
+						},
+					}, upstream, options.routeOptionsForRoute())
+				}
 			}
-			defaultBackend = spec.DefaultBackend
 		}
 		for _, tls := range spec.TLS {
 
@@ -210,37 +242,19 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 				log.Warnf("rule %v in ingress %v is missing HTTP field", i, ing.Name)
 				continue
 			}
-			for _, route := range rule.HTTP.Paths {
-				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, route.Backend)
+			for _, path := range rule.HTTP.Paths {
+				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, path.Backend)
 				if err != nil {
 					contextutils.LoggerFrom(ctx).Errorf("lookup upstream for ingress %v: %v", ing.Name, err)
 					continue
 				}
 
-				pathRegex := route.Path
-				if pathRegex == "" {
-					pathRegex = ".*"
-				}
-				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
-					Action: &gloov1.Route_RouteAction{
-						RouteAction: &gloov1.RouteAction{
-							Destination: &gloov1.RouteAction_Single{
-								Single: &gloov1.Destination{
-									DestinationType: &gloov1.Destination_Upstream{
-										Upstream: upstream.GetMetadata().Ref(),
-									},
-								},
-							},
-						},
-					},
-				}
+				route := routeToUpstream(matcherForPath(path), upstream, options.routeOptionsForPath(path))
 				if _, useTls := secretsByHost[host]; useTls {
 					routesByHostHttps[host] = append(routesByHostHttps[host], route)
+					if options.sslRedirect {
+						routesByHostHttp[host] = append(routesByHostHttp[host], httpsRedirectRoute(matcherForPath(path)))
+					}
 				} else {
 					routesByHostHttp[host] = append(routesByHostHttp[host], route)
 				}
@@ -248,6 +262,19 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 		}
 	}
 
+	// the default backend serves the requests which match no rule, whatever their host
+	if defaultRoute != nil {
+		if _, ok := routesByHostHttp["*"]; !ok {
+			routesByHostHttp["*"] = nil
+		}
+		for host := range routesByHostHttp {
+			routesByHostHttp[host] = append(routesByHostHttp[host], defaultRoute.Clone().(*gloov1.Route))
+		}
+		for host := range routesByHostHttps {
+			routesByHostHttps[host] = append(routesByHostHttps[host], defaultRoute.Clone().(*gloov1.Route))
+		}
+	}
+
 	var virtualHostsHttp []*gloov1.VirtualHost
 	var virtualHostsHttps []secureVirtualHost
 
@@ -286,6 +313,75 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 	return virtualHostsHttp, virtualHostsHttps
 }
 
-func isOurIngress(ingress *networkingv1.Ingress, ingressClassToUse string) bool {
-	return ingress.Annotations[IngressClassKey] == ingressClassToUse
+func routeToUpstream(matcher *matchers.Matcher, upstream *gloov1.Upstream, options *gloov1.RouteOptions) *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: []*matchers.Matcher{matcher},
+		Action: &gloov1.Route_RouteAction{
+			RouteAction: &gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Single{
+					Single: &gloov1.Destination{
+						DestinationType: &gloov1.Destination_Upstream{
+							Upstream: upstream.GetMetadata().Ref(),
+						},
+					},
+				},
+			},
+		},
+		Options: options,
+	}
+}
+
+func httpsRedirectRoute(matcher *matchers.Matcher) *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: []*matchers.Matcher{matcher},
+		Action: &gloov1.Route_RedirectAction{
+			RedirectAction: &gloov1.RedirectAction{
+				HttpsRedirect: true,
+			},
+		},
+	}
+}
+
+// matcherForPath returns the matcher for an ingress path according to its path type:
+// - Exact paths match the path exactly
+// - Prefix paths match the path element-wise, so that /foo matches /foo and /foo/bar but not /foobar
+// - ImplementationSpecific paths are regular expressions
+func matcherForPath(path networkingv1.HTTPIngressPath) *matchers.Matcher {
+	pathType := networkingv1.PathTypeImplementationSpecific
+	if path.PathType != nil {
+		pathType = *path.PathType
+	}
+	switch pathType {
+	case networkingv1.PathTypeExact:
+		return &matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Exact{
+				Exact: path.Path,
+			},
+		}
+	case networkingv1.PathTypePrefix:
+		// a trailing slash is ignored
+		prefix := strings.TrimSuffix(path.Path, "/")
+		if prefix == "" {
+			return &matchers.Matcher{
+				PathSpecifier: &matchers.Matcher_Prefix{
+					Prefix: "/",
+				},
+			}
+		}
+		return &matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Regex{
+				Regex: regexp.QuoteMeta(prefix) + "(/.*)?",
+			},
+		}
+	default:
+		pathRegex := path.Path
+		if pathRegex == "" {
+			pathRegex = ".*"
+		}
+		return &matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Regex{
+				Regex: pathRegex,
+			},
+		}
+	}
 }
@@ -2,6 +2,8 @@ package translator
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -9,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	ingresstype "github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -194,7 +197,7 @@ var _ = Describe("Translate", func() {
 				Ingresses: v1.IngressList{ingressRes, ingressResTls, ingressResTls2},
 				Upstreams: gloov1.UpstreamList{us, usSubset},
 			}
-			proxy := translateProxy(ctx, namespace, snap, requireIngressClass, "")
+			proxy := translateProxy(ctx, namespace, snap, requireIngressClass, "", "")
 
 			Expect(proxy.String()).To(Equal((&gloov1.Proxy{
 				Listeners: []*gloov1.Listener{
@@ -364,7 +367,7 @@ var _ = Describe("Translate", func() {
 			Upstreams: gloov1.UpstreamList{us1, us2},
 		}
 
-		proxy := translateProxy(ctx, "gloo-system", snap, false, "")
+		proxy := translateProxy(ctx, "gloo-system", snap, false, "", "")
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		Expect(proxy.Listeners[0].SslConfigurations).To(Equal([]*gloov1.SslConfig{
@@ -413,7 +416,7 @@ var _ = Describe("Translate", func() {
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, false, "")
+		}, false, "", "")
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -443,7 +446,7 @@ var _ = Describe("Translate", func() {
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, true, customClass1)
+		}, true, customClass1, "")
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -467,19 +470,414 @@ var _ = Describe("Translate", func() {
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1},
-		}, false, "")
+		}, false, "", "")
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
 		// successful translation
 		Expect(vhosts).To(HaveLen(1))
 	})
+
+	Context("path types", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		matchersForPaths := func(paths ...networkingv1.HTTPIngressPath) []*matchers.Matcher {
+			ing := makeIngWithPaths("ing", namespace, "host", paths...)
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing},
+			}, false, "", "")
+			Expect(proxy.Listeners).To(HaveLen(1))
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			var pathMatchers []*matchers.Matcher
+			for _, route := range vhosts[0].GetRoutes() {
+				Expect(route.GetMatchers()).To(HaveLen(1))
+				pathMatchers = append(pathMatchers, route.GetMatchers()[0])
+			}
+			return pathMatchers
+		}
+
+		It("matches exact paths exactly", func() {
+			pathMatchers := matchersForPaths(makePath("/api", networkingv1.PathTypeExact, "svc", 8081))
+			Expect(pathMatchers).To(HaveLen(1))
+			Expect(pathMatchers[0].GetExact()).To(Equal("/api"))
+		})
+
+		It("matches prefix paths element-wise", func() {
+			pathMatchers := matchersForPaths(
+				makePath("/api", networkingv1.PathTypePrefix, "svc", 8081),
+				makePath("/foo/", networkingv1.PathTypePrefix, "svc", 8081),
+			)
+			Expect(pathMatchers).To(HaveLen(2))
+			Expect(pathMatchers[0].GetRegex()).To(Equal("/foo(/.*)?"))
+			Expect(pathMatchers[1].GetRegex()).To(Equal("/api(/.*)?"))
+
+			for _, path := range []string{"/api", "/api/", "/api/v1"} {
+				Expect(regexp.MustCompile("^"+pathMatchers[1].GetRegex()+"$").MatchString(path)).To(BeTrue(), path)
+			}
+			for _, path := range []string{"/apis", "/ap", "/"} {
+				Expect(regexp.MustCompile("^"+pathMatchers[1].GetRegex()+"$").MatchString(path)).To(BeFalse(), path)
+			}
+		})
+
+		It("escapes prefix paths", func() {
+			pathMatchers := matchersForPaths(makePath("/v1.0", networkingv1.PathTypePrefix, "svc", 8081))
+			Expect(pathMatchers).To(HaveLen(1))
+			Expect(pathMatchers[0].GetRegex()).To(Equal(`/v1\.0(/.*)?`))
+		})
+
+		It("matches all paths for the root prefix", func() {
+			pathMatchers := matchersForPaths(makePath("/", networkingv1.PathTypePrefix, "svc", 8081))
+			Expect(pathMatchers).To(HaveLen(1))
+			Expect(pathMatchers[0].GetPrefix()).To(Equal("/"))
+		})
+
+		It("treats implementation specific paths as regular expressions", func() {
+			pathMatchers := matchersForPaths(
+				makePath("/api/.*", networkingv1.PathTypeImplementationSpecific, "svc", 8081),
+				makePath("", networkingv1.PathTypeImplementationSpecific, "svc", 8081),
+			)
+			Expect(pathMatchers).To(HaveLen(2))
+			Expect(pathMatchers[0].GetRegex()).To(Equal("/api/.*"))
+			Expect(pathMatchers[1].GetRegex()).To(Equal(".*"))
+		})
+
+		It("orders exact paths before prefix paths", func() {
+			pathMatchers := matchersForPaths(
+				makePath("/", networkingv1.PathTypePrefix, "svc", 8081),
+				makePath("/api", networkingv1.PathTypePrefix, "svc", 8081),
+				makePath("/api", networkingv1.PathTypeExact, "svc", 8081),
+			)
+			Expect(pathMatchers).To(HaveLen(3))
+			Expect(pathMatchers[0].GetExact()).To(Equal("/api"))
+			Expect(pathMatchers[1].GetRegex()).To(Equal("/api(/.*)?"))
+			Expect(pathMatchers[2].GetPrefix()).To(Equal("/"))
+		})
+	})
+
+	Context("ingress classes", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		makeClass := func(name, controller string, isDefault bool) *v1.IngressClass {
+			class := &networkingv1.IngressClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: networkingv1.IngressClassSpec{
+					Controller: controller,
+				},
+			}
+			if isDefault {
+				class.Annotations = map[string]string{
+					networkingv1.AnnotationIsDefaultIngressClass: "true",
+				}
+			}
+			classResource, err := ingressclass.FromKube(class)
+			Expect(err).NotTo(HaveOccurred())
+			return classResource
+		}
+
+		makeIngWithClass := func(host string, className *string) *v1.Ingress {
+			ing, err := ingresstype.ToKube(makeIngWithPaths(host, namespace, host, makePath("/", networkingv1.PathTypePrefix, "svc", 8081)))
+			Expect(err).NotTo(HaveOccurred())
+			ing.Spec.IngressClassName = className
+			igResource, err := ingresstype.FromKube(ing)
+			Expect(err).NotTo(HaveOccurred())
+			return igResource
+		}
+
+		translatedHosts := func(ingressClass, controllerName string, classes v1.IngressClassList, ingresses ...*v1.Ingress) []string {
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams:      []*gloov1.Upstream{us},
+				Services:       []*v1.KubeService{svc},
+				Ingresses:      ingresses,
+				IngressClasses: classes,
+			}, true, ingressClass, controllerName)
+			var hosts []string
+			for _, listener := range proxy.GetListeners() {
+				for _, vhost := range listener.GetHttpListener().GetVirtualHosts() {
+					hosts = append(hosts, vhost.GetDomains()[0])
+				}
+			}
+			return hosts
+		}
+
+		It("handles ingresses whose class is controlled by gloo", func() {
+			classes := v1.IngressClassList{
+				makeClass("ours", DefaultIngressControllerName, false),
+				makeClass("theirs", "k8s.io/ingress-nginx", false),
+			}
+			hosts := translatedHosts("", "", classes,
+				makeIngWithClass("ours", pointerToString("ours")),
+				makeIngWithClass("theirs", pointerToString("theirs")),
+				makeIngWithClass("unclassified", nil),
+			)
+			Expect(hosts).To(Equal([]string{"ours"}))
+		})
+
+		It("respects a custom controller name", func() {
+			classes := v1.IngressClassList{
+				makeClass("ours", "example.com/custom", false),
+				makeClass("default", DefaultIngressControllerName, false),
+			}
+			hosts := translatedHosts("", "example.com/custom", classes,
+				makeIngWithClass("ours", pointerToString("ours")),
+				makeIngWithClass("default", pointerToString("default")),
+			)
+			Expect(hosts).To(Equal([]string{"ours"}))
+		})
+
+		It("matches the class name against the ingress class if the IngressClass does not exist", func() {
+			hosts := translatedHosts("fancy", "", nil,
+				makeIngWithClass("fancy", pointerToString("fancy")),
+				makeIngWithClass("gloo", pointerToString("gloo")),
+			)
+			Expect(hosts).To(Equal([]string{"fancy"}))
+		})
+
+		It("handles ingresses without a class if gloo controls the default class", func() {
+			ingresses := []*v1.Ingress{
+				makeIngWithClass("unclassified", nil),
+			}
+			Expect(translatedHosts("", "", v1.IngressClassList{
+				makeClass("ours", DefaultIngressControllerName, true),
+			}, ingresses...)).To(Equal([]string{"unclassified"}))
+			Expect(translatedHosts("", "", v1.IngressClassList{
+				makeClass("ours", DefaultIngressControllerName, false),
+				makeClass("theirs", "k8s.io/ingress-nginx", true),
+			}, ingresses...)).To(BeEmpty())
+		})
+
+		It("prefers the ingress class annotation to the class name", func() {
+			classes := v1.IngressClassList{
+				makeClass("ours", DefaultIngressControllerName, true),
+			}
+			annotated := makeIng("annotated", namespace, "nginx", "annotated", "svc", intstr.FromInt(8081))
+			kubeIng, err := ingresstype.ToKube(annotated)
+			Expect(err).NotTo(HaveOccurred())
+			kubeIng.Spec.IngressClassName = pointerToString("ours")
+			annotated, err = ingresstype.FromKube(kubeIng)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(translatedHosts("", "", classes, annotated)).To(BeEmpty())
+		})
+	})
+
+	Context("default backend", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		makeIngWithDefaultBackend := func(name string, ing *v1.Ingress) *v1.Ingress {
+			return makeIngWithDefaultBackendService(name, "svc", ing)
+		}
+
+		It("routes requests which match no rule to the default backend", func() {
+			ing := makeIngWithDefaultBackend("ing", makeIngWithPaths("ing", namespace, "host", makePath("/api", networkingv1.PathTypePrefix, "svc", 8081)))
+
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing},
+			}, false, "", "")
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(2))
+
+			// the host of the rule falls back to the default backend
+			Expect(vhosts[0].GetDomains()[0]).To(Equal("*"))
+			Expect(vhosts[0].GetRoutes()).To(HaveLen(1))
+			Expect(vhosts[1].GetDomains()[0]).To(Equal("host"))
+			Expect(vhosts[1].GetRoutes()).To(HaveLen(2))
+			Expect(vhosts[1].GetRoutes()[0].GetMatchers()[0].GetRegex()).To(Equal("/api(/.*)?"))
+
+			for _, vhost := range vhosts {
+				defaultRoute := vhost.GetRoutes()[len(vhost.GetRoutes())-1]
+				Expect(defaultRoute.GetMatchers()[0].GetPrefix()).To(Equal("/"))
+				Expect(defaultRoute.GetRouteAction().GetSingle().GetUpstream().GetName()).To(Equal("us"))
+			}
+		})
+
+		It("uses the default backend of the first ingress by namespace and name", func() {
+			svc2 := makeService("svc2", namespace, "http", 8081)
+			us2 := makeUpstream("us2", namespace, svc2)
+
+			ing1 := makeIngWithDefaultBackendService("ing1", "svc", makeIngWithPaths("ing1", namespace, "host1", makePath("/", networkingv1.PathTypePrefix, "svc", 8081)))
+			ing2 := makeIngWithDefaultBackendService("ing2", "svc2", makeIngWithPaths("ing2", namespace, "host2", makePath("/", networkingv1.PathTypePrefix, "svc", 8081)))
+
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us, us2},
+				Services:  []*v1.KubeService{svc, svc2},
+				Ingresses: []*v1.Ingress{ing2, ing1},
+			}, false, "", "")
+
+			// the rules of the ingress which redeclares the default backend are still translated
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(3))
+			Expect(vhosts[0].GetDomains()[0]).To(Equal("*"))
+			Expect(vhosts[1].GetDomains()[0]).To(Equal("host1"))
+			Expect(vhosts[2].GetDomains()[0]).To(Equal("host2"))
+			for _, vhost := range vhosts {
+				defaultRoute := vhost.GetRoutes()[len(vhost.GetRoutes())-1]
+				Expect(defaultRoute.GetRouteAction().GetSingle().GetUpstream().GetName()).To(Equal("us"))
+			}
+		})
+	})
+
+	Context("annotations", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		annotate := func(ing *v1.Ingress, annotations map[string]string) *v1.Ingress {
+			ing.GetMetadata().Annotations = annotations
+			return ing
+		}
+
+		It("sets the route options of the routes of an ingress", func() {
+			ing1 := annotate(makeIngWithPaths("ing1", namespace, "host", makePath("/a", networkingv1.PathTypeExact, "svc", 8081)), map[string]string{
+				PrefixRewriteAnnotation: "/",
+				TimeoutAnnotation:       "10s",
+			})
+			ing2 := makeIngWithPaths("ing2", namespace, "host", makePath("/b", networkingv1.PathTypeExact, "svc", 8081))
+
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing1, ing2},
+			}, false, "", "")
+
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			routes := vhosts[0].GetRoutes()
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].GetMatchers()[0].GetExact()).To(Equal("/b"))
+			Expect(routes[0].GetOptions()).To(BeNil())
+			Expect(routes[1].GetMatchers()[0].GetExact()).To(Equal("/a"))
+			Expect(routes[1].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/"))
+			Expect(routes[1].GetOptions().GetTimeout().GetSeconds()).To(Equal(int64(10)))
+		})
+
+		It("rewrites only the matched prefix of prefix paths", func() {
+			ing := annotate(makeIngWithPaths("ing", namespace, "host", makePath("/api", networkingv1.PathTypePrefix, "svc", 8081)), map[string]string{
+				PrefixRewriteAnnotation: "/v2",
+			})
+
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing},
+			}, false, "", "")
+
+			routes := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			Expect(routes).To(HaveLen(1))
+			Expect(routes[0].GetMatchers()[0].GetRegex()).To(Equal("/api(/.*)?"))
+			options := routes[0].GetOptions()
+			Expect(options.GetPrefixRewrite()).To(BeNil())
+			Expect(options.GetRegexRewrite().GetPattern().GetRegex()).To(Equal("^/api(/.*)?$"))
+			Expect(options.GetRegexRewrite().GetSubstitution()).To(Equal(`/v2\1`))
+
+			rewrite := func(path string) string {
+				substitution := strings.ReplaceAll(options.GetRegexRewrite().GetSubstitution(), `\1`, "${1}")
+				return regexp.MustCompile(options.GetRegexRewrite().GetPattern().GetRegex()).ReplaceAllString(path, substitution)
+			}
+			Expect(rewrite("/api")).To(Equal("/v2"))
+			Expect(rewrite("/api/users/1")).To(Equal("/v2/users/1"))
+		})
+
+		It("redirects plaintext requests for hosts with tls", func() {
+			kubeIng, err := ingresstype.ToKube(makeIngWithPaths("ing", namespace, "host", makePath("/", networkingv1.PathTypePrefix, "svc", 8081)))
+			Expect(err).NotTo(HaveOccurred())
+			kubeIng.Annotations = map[string]string{
+				SslRedirectAnnotation: "true",
+			}
+			kubeIng.Spec.TLS = []networkingv1.IngressTLS{{
+				Hosts:      []string{"host"},
+				SecretName: "secret",
+			}}
+			ing, err := ingresstype.FromKube(kubeIng)
+			Expect(err).NotTo(HaveOccurred())
+
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing},
+			}, false, "", "")
+
+			Expect(proxy.Listeners).To(HaveLen(2))
+			httpVhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(httpVhosts).To(HaveLen(1))
+			Expect(httpVhosts[0].GetDomains()[0]).To(Equal("host"))
+			Expect(httpVhosts[0].GetRoutes()).To(HaveLen(1))
+			Expect(httpVhosts[0].GetRoutes()[0].GetRedirectAction().GetHttpsRedirect()).To(BeTrue())
+
+			httpsVhosts := proxy.Listeners[1].GetHttpListener().GetVirtualHosts()
+			Expect(httpsVhosts).To(HaveLen(1))
+			Expect(httpsVhosts[0].GetRoutes()[0].GetRouteAction()).NotTo(BeNil())
+		})
+	})
 })
 
 func getFirstPort(svc *kubev1.Service) int32 {
 	return svc.Spec.Ports[0].Port
 }
 
+func makeIngWithDefaultBackendService(name, svcName string, ing *v1.Ingress) *v1.Ingress {
+	kubeIng, err := ingresstype.ToKube(ing)
+	Expect(err).NotTo(HaveOccurred())
+	kubeIng.Name = name
+	kubeIng.Spec.DefaultBackend = &networkingv1.IngressBackend{
+		Service: &networkingv1.IngressServiceBackend{
+			Name: svcName,
+			Port: networkingv1.ServiceBackendPort{
+				Number: 8081,
+			},
+		},
+	}
+	withDefaultBackend, err := ingresstype.FromKube(kubeIng)
+	Expect(err).NotTo(HaveOccurred())
+	return withDefaultBackend
+}
+
 func makeIng(name, namespace, ingressClass, host string, svcName string, servicePort intstr.IntOrString) *v1.Ingress {
 	backendPort := networkingv1.ServiceBackendPort{}
 	if servicePort.Type == intstr.Int {
@@ -524,6 +922,48 @@ func makeIng(name, namespace, ingressClass, host string, svcName string, service
 	return ingType
 }
 
+func makePath(path string, pathType networkingv1.PathType, svcName string, servicePort int32) networkingv1.HTTPIngressPath {
+	return networkingv1.HTTPIngressPath{
+		Path:     path,
+		PathType: &pathType,
+		Backend: networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: svcName,
+				Port: networkingv1.ServiceBackendPort{
+					Number: servicePort,
+				},
+			},
+		},
+	}
+}
+
+func makeIngWithPaths(name, namespace, host string, paths ...networkingv1.HTTPIngressPath) *v1.Ingress {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: paths,
+						},
+					},
+				},
+			},
+		},
+	}
+	ingType, _ := ingresstype.FromKube(ing)
+	return ingType
+}
+
+func pointerToString(s string) *string {
+	return &s
+}
+
 func makeService(name, namespace, servicePortName string, servicePort int32) *v1.KubeService {
 	svc, _ := service.FromKube(&kubev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
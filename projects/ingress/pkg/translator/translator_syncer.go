@@ -28,6 +28,10 @@ type translatorSyncer struct {
 	// defaults to 'gloo'
 	customIngressClass string
 
+	// the controller of the IngressClasses whose ingresses we handle.
+	// defaults to 'solo.io/gloo-ingress'
+	customIngressControllerName string
+
 	statusClient resources.StatusClient
 }
 
@@ -53,16 +57,17 @@ var (
 	}
 )
 
-func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string, statusClient resources.StatusClient) v1.TranslatorSyncer {
+func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass, customIngressControllerName string, statusClient resources.StatusClient) v1.TranslatorSyncer {
 	return &translatorSyncer{
-		writeNamespace:      writeNamespace,
-		writeErrs:           writeErrs,
-		proxyClient:         proxyClient,
-		ingressClient:       ingressClient,
-		proxyReconciler:     gloov1.NewProxyReconciler(proxyClient, statusClient),
-		requireIngressClass: requireIngressClass,
-		customIngressClass:  customIngressClass,
-		statusClient:        statusClient,
+		writeNamespace:              writeNamespace,
+		writeErrs:                   writeErrs,
+		proxyClient:                 proxyClient,
+		ingressClient:               ingressClient,
+		proxyReconciler:             gloov1.NewProxyReconciler(proxyClient, statusClient),
+		requireIngressClass:         requireIngressClass,
+		customIngressClass:          customIngressClass,
+		customIngressControllerName: customIngressControllerName,
+		statusClient:                statusClient,
 	}
 }
 
@@ -72,8 +77,8 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 
 	snapHash := hashutils.MustHash(snap)
 	logger := contextutils.LoggerFrom(ctx)
-	logger.Infof("begin sync %v (%v ingresses)", snapHash,
-		len(snap.Ingresses))
+	logger.Infof("begin sync %v (%v ingresses, %v ingress classes)", snapHash,
+		len(snap.Ingresses), len(snap.IngressClasses))
 	defer logger.Infof("end sync %v", snapHash)
 
 	// stringifying the snapshot may be an expensive operation, so we'd like to avoid building the large
@@ -82,7 +87,7 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 
-	proxy := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass)
+	proxy := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass, s.customIngressControllerName)
 
 	var desiredResources gloov1.ProxyList
 	if proxy != nil {
@@ -6,14 +6,18 @@ import (
 	"os"
 
 	"github.com/solo-io/gloo/pkg/version"
+	"github.com/solo-io/gloo/projects/sds/pkg/config"
 	"github.com/solo-io/gloo/projects/sds/pkg/run"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/stats"
+	"github.com/solo-io/k8s-utils/kubeutils"
 
 	"github.com/avast/retry-go"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 var (
@@ -24,6 +28,7 @@ var (
 type Config struct {
 	SdsServerAddress string `split_words:"true" default:"0.0.0.0:8234"` //sds_config target_uri in the envoy instance that it provides secrets to
 	SdsClient        string `split_words:"true"`
+	SdsConfigFile    string `split_words:"true"` // yaml file listing named secret sets, see config.Config
 
 	PodName      string `split_words:"true"`
 	PodNamespace string `split_words:"true"`
@@ -40,6 +45,8 @@ type Config struct {
 }
 
 func main() {
+	stats.ConditionallyStartStatsServer()
+
 	ctx := contextutils.WithLogger(context.Background(), "sds_server")
 	ctx = contextutils.WithLoggerValues(ctx, "version", version.Version)
 
@@ -51,6 +58,7 @@ func main() {
 		"config loaded",
 		zap.Bool("glooMtlsSdsEnabled", c.GlooMtlsSdsEnabled),
 		zap.Bool("istioMtlsSdsEnabled", c.IstioMtlsSdsEnabled),
+		zap.String("sdsConfigFile", c.SdsConfigFile),
 	)
 
 	secrets := []server.Secret{}
@@ -87,6 +95,13 @@ func main() {
 
 	contextutils.LoggerFrom(ctx).Info("secrets confirmed present, proceeding to start SDS server")
 
+	// The secret sets from the config file are served as they become available
+	configSecrets, err := secretSets(c.SdsConfigFile)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
+	secrets = append(secrets, configSecrets...)
+
 	if err := run.Run(ctx, secrets, c.SdsClient, c.SdsServerAddress); err != nil {
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
@@ -105,13 +120,31 @@ func setup(ctx context.Context) Config {
 	}
 
 	// At least one must be enabled, otherwise we have nothing to do.
-	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled {
-		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED or ISTIO_MTLS_SDS_ENABLED")
+	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled && c.SdsConfigFile == "" {
+		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, or secret sets must be configured, using env vars GLOO_MTLS_SDS_ENABLED, ISTIO_MTLS_SDS_ENABLED or SDS_CONFIG_FILE")
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 	return c
 }
 
+// secretSets loads the named secret sets from the config file, if there is one
+func secretSets(configFile string) ([]server.Secret, error) {
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg.Secrets(func() (kubernetes.Interface, error) {
+		restConfig, err := kubeutils.GetConfig("", "")
+		if err != nil {
+			return nil, err
+		}
+		return kubernetes.NewForConfig(restConfig)
+	})
+}
+
 // determineSdsClient checks POD_NAME or POD_NAMESPACE
 // environment vars to try and figure out the NodeID,
 // otherwise returns the default "sds_client"
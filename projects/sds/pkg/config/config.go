@@ -0,0 +1,216 @@
+package config
+
+import (
+	"os"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	ReadConfigErr = func(err error, path string) error {
+		return eris.Wrapf(err, "reading sds config from %v", path)
+	}
+	MissingNameErr = func(index int) error {
+		return eris.Errorf("secret set %v must set name", index)
+	}
+	DuplicateNameErr = func(name string) error {
+		return eris.Errorf("secret set name %v is used more than once", name)
+	}
+	DuplicateSecretNameErr = func(name string) error {
+		return eris.Errorf("secret %v is served by more than one secret set", name)
+	}
+	NothingServedErr = func(name string) error {
+		return eris.Errorf("secret set %v must set serverCert, validationContext or both", name)
+	}
+	InvalidSourceErr = func(name string) error {
+		return eris.Errorf("secret set %v must set exactly one of files, kubeSecret or directory", name)
+	}
+	MissingSourceOptionErr = func(name, option string) error {
+		return eris.Errorf("secret set %v must set %v", name, option)
+	}
+)
+
+// Config lists the named secret sets served by the SDS server
+type Config struct {
+	SecretSets []*SecretSet `json:"secretSets,omitempty"`
+}
+
+// SecretSet is a private key, cert chain and CA cert, which are served to envoy as a tls certificate secret and a
+// validation context secret, and read from exactly one of the sources.
+type SecretSet struct {
+	// identifies the secret set in logs and metrics
+	Name string `json:"name,omitempty"`
+	// the name of the tls certificate secret, which is not served if empty
+	ServerCert string `json:"serverCert,omitempty"`
+	// the name of the validation context secret, which is not served if empty
+	ValidationContext string `json:"validationContext,omitempty"`
+
+	Files      *FilesSource      `json:"files,omitempty"`
+	KubeSecret *KubeSecretSource `json:"kubeSecret,omitempty"`
+	Directory  *DirectorySource  `json:"directory,omitempty"`
+}
+
+// FilesSource reads a secret set from files, which are watched for changes
+type FilesSource struct {
+	PrivateKey string `json:"privateKey,omitempty"`
+	CertChain  string `json:"certChain,omitempty"`
+	Ca         string `json:"ca,omitempty"`
+}
+
+// KubeSecretSource reads a secret set from a Kubernetes Secret, which is watched through the Kubernetes API
+type KubeSecretSource struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Keys      *Keys  `json:"keys,omitempty"`
+}
+
+// DirectorySource reads a secret set from PEM files in a directory, which is watched for changes
+type DirectorySource struct {
+	Path string `json:"path,omitempty"`
+	Keys *Keys  `json:"keys,omitempty"`
+}
+
+// Keys are the names of the data within a Kubernetes Secret or directory, which default to tls.key, tls.crt and ca.crt
+type Keys struct {
+	PrivateKey string `json:"privateKey,omitempty"`
+	CertChain  string `json:"certChain,omitempty"`
+	Ca         string `json:"ca,omitempty"`
+}
+
+// Load reads the config from a yaml file, returning an empty config if there is no file
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+	if path == "" {
+		return cfg, nil
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ReadConfigErr(err, path)
+	}
+	if err := yaml.UnmarshalStrict(contents, cfg); err != nil {
+		return nil, ReadConfigErr(err, path)
+	}
+	return cfg, nil
+}
+
+func (c *Config) Validate() error {
+	names := map[string]bool{}
+	secretNames := map[string]bool{}
+	for i, set := range c.SecretSets {
+		if set.Name == "" {
+			return MissingNameErr(i)
+		}
+		if names[set.Name] {
+			return DuplicateNameErr(set.Name)
+		}
+		names[set.Name] = true
+
+		if set.ServerCert == "" && set.ValidationContext == "" {
+			return NothingServedErr(set.Name)
+		}
+		for _, secretName := range []string{set.ServerCert, set.ValidationContext} {
+			if secretName == "" {
+				continue
+			}
+			if secretNames[secretName] {
+				return DuplicateSecretNameErr(secretName)
+			}
+			secretNames[secretName] = true
+		}
+
+		if err := set.validateSource(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SecretSet) validateSource() error {
+	var sources int
+	for _, set := range []bool{s.Files != nil, s.KubeSecret != nil, s.Directory != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return InvalidSourceErr(s.Name)
+	}
+
+	switch {
+	case s.Files != nil:
+		if s.ServerCert != "" && (s.Files.PrivateKey == "" || s.Files.CertChain == "") {
+			return MissingSourceOptionErr(s.Name, "files.privateKey and files.certChain")
+		}
+		if s.ValidationContext != "" && s.Files.Ca == "" {
+			return MissingSourceOptionErr(s.Name, "files.ca")
+		}
+	case s.KubeSecret != nil:
+		if s.KubeSecret.Namespace == "" || s.KubeSecret.Name == "" {
+			return MissingSourceOptionErr(s.Name, "kubeSecret.namespace and kubeSecret.name")
+		}
+	case s.Directory != nil:
+		if s.Directory.Path == "" {
+			return MissingSourceOptionErr(s.Name, "directory.path")
+		}
+	}
+	return nil
+}
+
+// Secrets returns the secret sets to serve. The Kubernetes client is only created if a secret set is read from a
+// Kubernetes Secret.
+func (c *Config) Secrets(kubeClient func() (kubernetes.Interface, error)) ([]server.Secret, error) {
+	var client kubernetes.Interface
+	var secrets []server.Secret
+	for _, set := range c.SecretSets {
+		secret := server.Secret{
+			Name:              set.Name,
+			ServerCert:        set.ServerCert,
+			ValidationContext: set.ValidationContext,
+		}
+
+		switch {
+		case set.Files != nil:
+			secret.Source = source.NewFiles(set.Files.PrivateKey, set.Files.CertChain, set.Files.Ca)
+		case set.KubeSecret != nil:
+			if client == nil {
+				var err error
+				if client, err = kubeClient(); err != nil {
+					return nil, err
+				}
+			}
+			secret.Source = source.NewKubeSecret(client, set.KubeSecret.Namespace, set.KubeSecret.Name, set.keys(set.KubeSecret.Keys))
+		case set.Directory != nil:
+			secret.Source = source.NewDirectory(set.Directory.Path, set.keys(set.Directory.Keys))
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// keys returns the names of the data to read, leaving out the data which is not served
+func (s *SecretSet) keys(keys *Keys) source.Keys {
+	result := source.DefaultKeys()
+	if keys != nil {
+		if keys.PrivateKey != "" {
+			result.PrivateKey = keys.PrivateKey
+		}
+		if keys.CertChain != "" {
+			result.CertChain = keys.CertChain
+		}
+		if keys.Ca != "" {
+			result.Ca = keys.Ca
+		}
+	}
+	if s.ServerCert == "" {
+		result.PrivateKey = ""
+		result.CertChain = ""
+	}
+	if s.ValidationContext == "" {
+		result.Ca = ""
+	}
+	return result
+}
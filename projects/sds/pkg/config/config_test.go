@@ -0,0 +1,117 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/sds/pkg/config"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Config", func() {
+
+	Context("Load", func() {
+
+		var (
+			dir string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "sds-config")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		writeConfig := func(contents string) string {
+			path := filepath.Join(dir, "config.yaml")
+			Expect(os.WriteFile(path, []byte(contents), 0644)).NotTo(HaveOccurred())
+			return path
+		}
+
+		It("returns an empty config when there is no file", func() {
+			cfg, err := config.Load("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.SecretSets).To(BeEmpty())
+		})
+
+		It("loads the secret sets", func() {
+			cfg, err := config.Load(writeConfig(`
+secretSets:
+- name: upstream-mtls
+  serverCert: upstream_cert
+  validationContext: upstream_validation_context
+  kubeSecret:
+    namespace: gloo-system
+    name: upstream-tls
+- name: local
+  validationContext: local_validation_context
+  directory:
+    path: /etc/certs
+    keys:
+      ca: root.pem
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Validate()).NotTo(HaveOccurred())
+			Expect(cfg.SecretSets).To(HaveLen(2))
+			Expect(cfg.SecretSets[0].KubeSecret).To(Equal(&config.KubeSecretSource{Namespace: "gloo-system", Name: "upstream-tls"}))
+			Expect(cfg.SecretSets[1].Directory.Keys.Ca).To(Equal("root.pem"))
+
+			var clientCreated bool
+			secrets, err := cfg.Secrets(func() (kubernetes.Interface, error) {
+				clientCreated = true
+				return fake.NewSimpleClientset(), nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clientCreated).To(BeTrue())
+			Expect(secrets).To(HaveLen(2))
+			Expect(secrets[0].GetName()).To(Equal("upstream-mtls"))
+			Expect(secrets[1].ServerCert).To(BeEmpty())
+			Expect(secrets[1].Source).To(Equal(source.NewDirectory("/etc/certs", source.Keys{Ca: "root.pem"})))
+		})
+
+		It("errors on unknown options", func() {
+			_, err := config.Load(writeConfig(`secretSet: []`))
+			Expect(err).To(MatchError(ContainSubstring("reading sds config from")))
+		})
+	})
+
+	DescribeTable("Validate",
+		func(sets []*config.SecretSet, expectedErr string) {
+			cfg := &config.Config{SecretSets: sets}
+			Expect(cfg.Validate()).To(MatchError(expectedErr))
+		},
+		Entry("no name", []*config.SecretSet{{ServerCert: "cert", Directory: &config.DirectorySource{Path: "/certs"}}},
+			"secret set 0 must set name"),
+		Entry("duplicate name", []*config.SecretSet{
+			{Name: "a", ServerCert: "cert-a", Directory: &config.DirectorySource{Path: "/certs"}},
+			{Name: "a", ServerCert: "cert-b", Directory: &config.DirectorySource{Path: "/certs"}},
+		}, "secret set name a is used more than once"),
+		Entry("duplicate secret name", []*config.SecretSet{
+			{Name: "a", ServerCert: "cert", Directory: &config.DirectorySource{Path: "/certs"}},
+			{Name: "b", ServerCert: "cert", Directory: &config.DirectorySource{Path: "/certs"}},
+		}, "secret cert is served by more than one secret set"),
+		Entry("nothing served", []*config.SecretSet{{Name: "a", Directory: &config.DirectorySource{Path: "/certs"}}},
+			"secret set a must set serverCert, validationContext or both"),
+		Entry("no source", []*config.SecretSet{{Name: "a", ServerCert: "cert"}},
+			"secret set a must set exactly one of files, kubeSecret or directory"),
+		Entry("two sources", []*config.SecretSet{{Name: "a", ServerCert: "cert",
+			Directory:  &config.DirectorySource{Path: "/certs"},
+			KubeSecret: &config.KubeSecretSource{Namespace: "gloo-system", Name: "tls"},
+		}}, "secret set a must set exactly one of files, kubeSecret or directory"),
+		Entry("files without a ca", []*config.SecretSet{{Name: "a", ValidationContext: "ca",
+			Files: &config.FilesSource{PrivateKey: "tls.key", CertChain: "tls.crt"},
+		}}, "secret set a must set files.ca"),
+		Entry("kube secret without a namespace", []*config.SecretSet{{Name: "a", ServerCert: "cert",
+			KubeSecret: &config.KubeSecretSource{Name: "tls"},
+		}}, "secret set a must set kubeSecret.namespace and kubeSecret.name"),
+	)
+})
@@ -7,7 +7,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/fsnotify/fsnotify"
 	"go.uber.org/zap"
 
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
@@ -26,44 +25,26 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		return err
 	}
 
-	// Initialize the SDS config
-	err = sdsServer.UpdateSDSConfig(ctx)
-	if err != nil {
-		cancel()
-		return err
-	}
-
-	// create a new file watcher
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		cancel()
-		return err
+	// Initialize the SDS config. Secret sets which can't be read yet, such as Kubernetes Secrets which have not been
+	// created, are served once their watch sees them.
+	if err = sdsServer.UpdateSDSConfig(ctx); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to initialize SDS config", zap.Error(err))
 	}
-	defer watcher.Close()
 
 	// Wire in signal handling
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
-	go func() {
-		for {
-			select {
-			// watch for events
-			case event := <-watcher.Events:
-				contextutils.LoggerFrom(ctx).Infow("received event", zap.Any("event", event))
-				sdsServer.UpdateSDSConfig(ctx)
-				watchFiles(ctx, watcher, secrets)
-			// watch for errors
-			case err := <-watcher.Errors:
-				contextutils.LoggerFrom(ctx).Warnw("Received error from file watcher", zap.Error(err))
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
-	watchFiles(ctx, watcher, secrets)
+	// Watch the secret sets for changes
+	if err = sdsServer.Watch(ctx); err != nil {
+		cancel()
+		return err
+	}
 
-	<-sigs
+	select {
+	case <-sigs:
+	case <-ctx.Done():
+	}
 	cancel()
 	select {
 	case <-serverStopped:
@@ -72,18 +53,3 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		return nil
 	}
 }
-
-func watchFiles(ctx context.Context, watcher *fsnotify.Watcher, secrets []server.Secret) {
-	for _, s := range secrets {
-		contextutils.LoggerFrom(ctx).Infow("watcher started", zap.String("sslKeyFile", s.SslKeyFile), zap.String("sshCertFile", s.SslCertFile), zap.String("sslCaFile", s.SslCaFile))
-		if err := watcher.Add(s.SslKeyFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-		if err := watcher.Add(s.SslCertFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-		if err := watcher.Add(s.SslCaFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-	}
-}
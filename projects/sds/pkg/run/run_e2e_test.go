@@ -72,10 +72,9 @@ var _ = Describe("SDS Server E2E Test", func() {
 
 	It("runs and stops correctly", func() {
 		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan error, 1)
 		go func() {
-			if err := run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress); err != nil {
-				Expect(err).To(BeNil())
-			}
+			stopped <- run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress)
 		}()
 
 		// Connect with the server
@@ -100,11 +99,15 @@ var _ = Describe("SDS Server E2E Test", func() {
 			return err != nil
 		}, "5s", "1s").Should(BeTrue())
 
+		// Run returns once the gRPC server has stopped, so that the next test can listen on its address
+		Eventually(stopped, "10s").Should(Receive(BeNil()))
 	})
 
 	It("correctly picks up multiple cert rotations", func() {
 
-		go run.Run(context.Background(), []server.Secret{secret}, sdsClient, testServerAddress)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress)
 
 		// Give it a second to spin up + read the files
 		time.Sleep(1 * time.Second)
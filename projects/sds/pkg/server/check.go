@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// The problems reported for secret sets
+const (
+	// the secret set could not be read, so the certs which were last read are served
+	ProblemUnavailable = "unavailable"
+	// a cert or key is not valid PEM, or can't be parsed
+	ProblemMalformed = "malformed"
+	// a cert in the chain or the CA has expired
+	ProblemExpired = "expired"
+	// a cert in the chain or the CA is not valid yet
+	ProblemNotYetValid = "not_yet_valid"
+	// the private key can't be used with the leaf cert of the chain, usually because they do not match
+	ProblemKeyMismatch = "key_mismatch"
+)
+
+var (
+	NotPemErr = eris.New("not valid PEM")
+
+	problems = []string{ProblemUnavailable, ProblemMalformed, ProblemExpired, ProblemNotYetValid, ProblemKeyMismatch}
+
+	secretSetKey, _ = tag.NewKey("secret_set")
+	problemKey, _   = tag.NewKey("problem")
+
+	mSecretSetProblems = stats.Int64("gloo.solo.io/sds/secret_set_problems", "Whether each secret set has a problem, such as an expired cert or a private key which does not match its cert. 1 while the problem is present, 0 otherwise.", stats.UnitDimensionless)
+
+	secretSetProblemsView = &view.View{
+		Name:        mSecretSetProblems.Name(),
+		Measure:     mSecretSetProblems,
+		Description: mSecretSetProblems.Description(),
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{secretSetKey, problemKey},
+	}
+)
+
+func init() {
+	_ = view.Register(secretSetProblemsView)
+}
+
+// checkCert returns the problems with the certs of a secret set at the given time. Certs with problems are still
+// served, as envoy keeps its current certs when it is sent certs which it can't use.
+func checkCert(data *source.Data, now time.Time) []string {
+	found := map[string]bool{}
+
+	var leafCert *x509.Certificate
+	for i, pemBytes := range [][]byte{data.CertChain, data.Ca} {
+		if len(pemBytes) == 0 {
+			continue
+		}
+		certs, err := parseCerts(pemBytes)
+		if err != nil {
+			found[ProblemMalformed] = true
+			continue
+		}
+		if i == 0 {
+			leafCert = certs[0]
+		}
+		for _, cert := range certs {
+			if now.After(cert.NotAfter) {
+				found[ProblemExpired] = true
+			}
+			if now.Before(cert.NotBefore) {
+				found[ProblemNotYetValid] = true
+			}
+		}
+	}
+
+	if len(data.PrivateKey) > 0 {
+		if !source.IsPem(data.PrivateKey) {
+			found[ProblemMalformed] = true
+		} else if leafCert != nil {
+			if _, err := tls.X509KeyPair(data.CertChain, data.PrivateKey); err != nil {
+				found[ProblemKeyMismatch] = true
+			}
+		}
+	}
+
+	var result []string
+	for _, problem := range problems {
+		if found[problem] {
+			result = append(result, problem)
+		}
+	}
+	return result
+}
+
+// parseCerts parses every block of the PEM as a cert
+func parseCerts(pemBytes []byte) ([]*x509.Certificate, error) {
+	if !source.IsPem(pemBytes) {
+		return nil, NotPemErr
+	}
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(pemBytes); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// recordProblems sets the problems gauge of the secret set to 1 for the given problems, and 0 for the others
+func recordProblems(ctx context.Context, secretSet string, present []string) {
+	for _, problem := range problems {
+		var value int64
+		for _, p := range present {
+			if p == problem {
+				value = 1
+			}
+		}
+		if err := stats.RecordWithTags(ctx,
+			[]tag.Mutator{tag.Insert(secretSetKey, secretSet), tag.Insert(problemKey, problem)},
+			mSecretSetProblems.M(value),
+		); err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("recording %v: %v", mSecretSetProblems.Name(), err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package server_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+	"github.com/solo-io/gloo/projects/sds/pkg/testutils"
+	"go.opencensus.io/stats/view"
+)
+
+type fakeSource struct {
+	data *source.Data
+	err  error
+}
+
+func (f *fakeSource) Read(_ context.Context) (*source.Data, error) {
+	return f.data, f.err
+}
+
+func (f *fakeSource) Watch(_ context.Context, _ func()) error {
+	return nil
+}
+
+var _ = Describe("Secret set problems", func() {
+
+	var (
+		ctx = context.Background()
+		now = time.Now()
+	)
+
+	// problems returns the problems which are present for each secret set
+	problems := func() map[string][]string {
+		rows, err := view.RetrieveData("gloo.solo.io/sds/secret_set_problems")
+		Expect(err).NotTo(HaveOccurred())
+		result := map[string][]string{}
+		for _, row := range rows {
+			tags := map[string]string{}
+			for _, t := range row.Tags {
+				tags[t.Key.Name()] = t.Value
+			}
+			if _, ok := result[tags["secret_set"]]; !ok {
+				result[tags["secret_set"]] = []string{}
+			}
+			if row.Data.(*view.LastValueData).Value == 1 {
+				result[tags["secret_set"]] = append(result[tags["secret_set"]], tags["problem"])
+			}
+		}
+		return result
+	}
+
+	cert := func(notBefore, notAfter time.Time) ([]byte, []byte) {
+		certPem, keyPem, err := testutils.SelfSignedCert(notBefore, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+		return certPem, keyPem
+	}
+
+	It("reports expired certs, mismatched keys and malformed certs", func() {
+		validCert, validKey := cert(now.Add(-time.Hour), now.Add(time.Hour))
+		expiredCert, expiredKey := cert(now.Add(-2*time.Hour), now.Add(-time.Hour))
+		_, otherKey := cert(now.Add(-time.Hour), now.Add(time.Hour))
+
+		secrets := []server.Secret{
+			{
+				Name:              "valid",
+				ServerCert:        "valid-cert",
+				ValidationContext: "valid-ca",
+				Source:            &fakeSource{data: &source.Data{PrivateKey: validKey, CertChain: validCert, Ca: validCert}},
+			},
+			{
+				Name:       "expired",
+				ServerCert: "expired-cert",
+				Source:     &fakeSource{data: &source.Data{PrivateKey: expiredKey, CertChain: expiredCert}},
+			},
+			{
+				Name:       "mismatched",
+				ServerCert: "mismatched-cert",
+				Source:     &fakeSource{data: &source.Data{PrivateKey: otherKey, CertChain: validCert}},
+			},
+			{
+				Name:              "malformed",
+				ServerCert:        "malformed-cert",
+				ValidationContext: "malformed-ca",
+				Source:            &fakeSource{data: &source.Data{PrivateKey: validKey, CertChain: validCert, Ca: []byte("not a cert")}},
+			},
+		}
+		srv := server.SetupEnvoySDS(secrets, "problems-client", "127.0.0.1:0")
+		Expect(srv.UpdateSDSConfig(ctx)).NotTo(HaveOccurred())
+
+		Expect(problems()).To(And(
+			HaveKeyWithValue("valid", BeEmpty()),
+			HaveKeyWithValue("expired", Equal([]string{server.ProblemExpired})),
+			HaveKeyWithValue("mismatched", Equal([]string{server.ProblemKeyMismatch})),
+			HaveKeyWithValue("malformed", Equal([]string{server.ProblemMalformed})),
+		))
+	})
+
+	It("reports secret sets which can't be read, and serves the certs which were last read", func() {
+		validCert, validKey := cert(now.Add(-time.Hour), now.Add(time.Hour))
+		src := &fakeSource{data: &source.Data{PrivateKey: validKey, CertChain: validCert}}
+		srv := server.SetupEnvoySDS([]server.Secret{{Name: "flaky", ServerCert: "flaky-cert", Source: src}}, "flaky-client", "127.0.0.1:0")
+		Expect(srv.UpdateSDSConfig(ctx)).NotTo(HaveOccurred())
+		Expect(problems()["flaky"]).To(BeEmpty())
+
+		src.err = eris.New("secret is gone")
+		Expect(srv.UpdateSDSConfig(ctx)).To(MatchError(ContainSubstring("reading secret set flaky: secret is gone")))
+		Expect(problems()["flaky"]).To(Equal([]string{server.ProblemUnavailable}))
+	})
+})
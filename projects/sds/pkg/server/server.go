@@ -2,16 +2,18 @@ package server
 
 import (
 	"context"
-	"encoding/pem"
 	"fmt"
 	"hash/fnv"
-	"io/ioutil"
 	"net"
+	"sync"
+	"time"
 
-	"github.com/avast/retry-go"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/hashutils"
 
@@ -25,15 +27,40 @@ import (
 
 var (
 	grpcOptions = []grpc.ServerOption{grpc.MaxConcurrentStreams(10000)}
+
+	ReadSecretSetErr = func(err error, name string) error {
+		return eris.Wrapf(err, "reading secret set %v", name)
+	}
+	WatchSecretSetErr = func(err error, name string) error {
+		return eris.Wrapf(err, "watching secret set %v", name)
+	}
 )
 
-// Secret represents an envoy auth secret
+// Secret represents a named set of envoy auth secrets
 type Secret struct {
+	Name              string // name of the secret set in logs and metrics, defaults to the ServerCert
 	SslCaFile         string
 	SslKeyFile        string
 	SslCertFile       string
-	ServerCert        string // name of a tls_certificate_sds_secret_config
-	ValidationContext string // name of the validation_context_sds_secret_config
+	ServerCert        string        // name of a tls_certificate_sds_secret_config, not served if empty
+	ValidationContext string        // name of the validation_context_sds_secret_config, not served if empty
+	Source            source.Source // where the certs are read from, defaults to the Ssl*File files
+}
+
+// GetName returns the name of the secret set
+func (s Secret) GetName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.ServerCert
+}
+
+// GetSource returns where the certs of the secret set are read from
+func (s Secret) GetSource() source.Source {
+	if s.Source != nil {
+		return s.Source
+	}
+	return source.NewFiles(s.SslKeyFile, s.SslCertFile, s.SslCaFile)
 }
 
 // Server is the SDS server. Holds config & secrets.
@@ -43,6 +70,11 @@ type Server struct {
 	grpcServer    *grpc.Server
 	address       string
 	snapshotCache cache.SnapshotCache
+
+	// serializes updates, which are triggered by the watches of each secret set
+	lock sync.Mutex
+	// the certs last read from each secret set, which are served while a secret set can't be read
+	lastRead map[string]*source.Data
 }
 
 // ID needed for snapshotCache
@@ -58,6 +90,7 @@ func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string) *Server {
 		grpcServer: grpcServer,
 		sdsClient:  sdsClient,
 		address:    serverAddress,
+		lastRead:   map[string]*source.Data{},
 	}
 	snapshotCache := cache.NewSnapshotCache(false, sdsServer, nil)
 	sdsServer.snapshotCache = snapshotCache
@@ -91,28 +124,43 @@ func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 	return serverStopped, nil
 }
 
-// UpdateSDSConfig updates with the current certs
+// UpdateSDSConfig updates with the current certs. Secret sets which can't be read are reported and keep serving the
+// certs which were last read, and the errors reading them are returned once the others have been updated.
 func (s *Server) UpdateSDSConfig(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var readErrs *multierror.Error
 	var certs [][]byte
 	var items []cache_types.Resource
 	for _, sec := range s.secrets {
-		key, err := readAndVerifyCert(sec.SslKeyFile)
+		name := sec.GetName()
+		data, err := sec.GetSource().Read(ctx)
 		if err != nil {
-			return err
+			contextutils.LoggerFrom(ctx).Warnw("failed to read secret set", zap.String("secretSet", name), zap.Error(err))
+			readErrs = multierror.Append(readErrs, ReadSecretSetErr(err, name))
+			recordProblems(ctx, name, []string{ProblemUnavailable})
+			data = s.lastRead[name]
+			if data == nil {
+				continue
+			}
+		} else {
+			problems := checkCert(data, time.Now())
+			if len(problems) > 0 {
+				contextutils.LoggerFrom(ctx).Warnw("serving invalid secret set", zap.String("secretSet", name), zap.Strings("problems", problems))
+			}
+			recordProblems(ctx, name, problems)
+			s.lastRead[name] = data
 		}
-		certs = append(certs, key)
-		certChain, err := readAndVerifyCert(sec.SslCertFile)
-		if err != nil {
-			return err
+
+		if sec.ServerCert != "" {
+			certs = append(certs, data.PrivateKey, data.CertChain)
+			items = append(items, serverCertSecret(data.PrivateKey, data.CertChain, sec.ServerCert))
 		}
-		certs = append(certs, certChain)
-		ca, err := readAndVerifyCert(sec.SslCaFile)
-		if err != nil {
-			return err
+		if sec.ValidationContext != "" {
+			certs = append(certs, data.Ca)
+			items = append(items, validationContextSecret(data.Ca, sec.ValidationContext))
 		}
-		certs = append(certs, ca)
-		items = append(items, serverCertSecret(key, certChain, sec.ServerCert))
-		items = append(items, validationContextSecret(ca, sec.ValidationContext))
 	}
 
 	snapshotVersion, err := GetSnapshotVersion(certs)
@@ -124,7 +172,24 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 
 	secretSnapshot := cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
-	return s.snapshotCache.SetSnapshot(ctx, s.sdsClient, secretSnapshot)
+	if err := s.snapshotCache.SetSnapshot(ctx, s.sdsClient, secretSnapshot); err != nil {
+		return err
+	}
+	return readErrs.ErrorOrNil()
+}
+
+// Watch updates the SDS config whenever the certs of a secret set may have changed, until the context is done
+func (s *Server) Watch(ctx context.Context) error {
+	for _, sec := range s.secrets {
+		if err := sec.GetSource().Watch(ctx, func() {
+			if err := s.UpdateSDSConfig(ctx); err != nil {
+				contextutils.LoggerFrom(ctx).Warnw("failed to update SDS config", zap.Error(err))
+			}
+		}); err != nil {
+			return WatchSecretSetErr(err, sec.GetName())
+		}
+	}
+	return nil
 }
 
 // GetSnapshotVersion generates a version string by hashing the certs
@@ -133,54 +198,6 @@ func GetSnapshotVersion(certs ...interface{}) (string, error) {
 	return fmt.Sprintf("%d", hash), err
 }
 
-// readAndVerifyCert will read the file from the given
-// path, then check for validity every 100ms for 2 seconds.
-// This is needed because the filesystem watcher
-// that gets triggered by a WRITE doesn't have a guarantee
-// that the write has finished yet.
-// See https://github.com/fsnotify/fsnotify/pull/252 for more context
-func readAndVerifyCert(certFilePath string) ([]byte, error) {
-	var err error
-	var fileBytes []byte
-
-	var validCerts bool
-	// Retry for a few seconds as a write may still be in progress
-	err = retry.Do(
-		func() error {
-			fileBytes, err = ioutil.ReadFile(certFilePath)
-			if err != nil {
-				return err
-			}
-			validCerts = checkCert(fileBytes)
-			if !validCerts {
-				return fmt.Errorf("failed to validate file %v", certFilePath)
-			}
-			return nil
-		},
-		retry.Attempts(5), // Exponential backoff over ~3s
-	)
-
-	return fileBytes, nil
-}
-
-// checkCert uses pem.Decode to verify that the given
-// bytes are not malformed, as could be caused by a
-// write-in-progress. Uses pem.Decode to check the blocks.
-// See https://golang.org/src/encoding/pem/pem.go?s=2505:2553#L76
-func checkCert(certs []byte) bool {
-	block, rest := pem.Decode(certs)
-	if block == nil {
-		// Remainder does not contain any certs/keys
-		return false
-	}
-	// Found a cert, check the rest
-	if len(rest) > 0 {
-		// Something after the cert, validate that too
-		return checkCert(rest)
-	}
-	return true
-}
-
 func serverCertSecret(privateKey, certChain []byte, serverCert string) cache_types.Resource {
 	return &envoy_extensions_transport_sockets_tls_v3.Secret{
 		Name: serverCert,
@@ -0,0 +1,169 @@
+package source
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/avast/retry-go"
+	"github.com/fsnotify/fsnotify"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+type files struct {
+	privateKeyFile string
+	certChainFile  string
+	caFile         string
+	// when set, the directory is watched rather than the files themselves, so that files which are created after
+	// the watch starts are picked up
+	dir string
+}
+
+// NewFiles returns a source which reads the secret set from the given files. A file with an empty path is not read.
+func NewFiles(privateKeyFile, certChainFile, caFile string) Source {
+	return &files{
+		privateKeyFile: privateKeyFile,
+		certChainFile:  certChainFile,
+		caFile:         caFile,
+	}
+}
+
+// NewDirectory returns a source which reads the secret set from the files with the given names in a directory, such
+// as a directory of PEMs on a developer's machine or a mounted Kubernetes Secret.
+func NewDirectory(dir string, keys Keys) Source {
+	return &files{
+		privateKeyFile: join(dir, keys.PrivateKey),
+		certChainFile:  join(dir, keys.CertChain),
+		caFile:         join(dir, keys.Ca),
+		dir:            dir,
+	}
+}
+
+func join(dir, name string) string {
+	if name == "" {
+		return ""
+	}
+	return filepath.Join(dir, name)
+}
+
+func (f *files) Read(_ context.Context) (*Data, error) {
+	var data Data
+	var err error
+	if data.PrivateKey, err = readAndVerifyCert(f.privateKeyFile); err != nil {
+		return nil, err
+	}
+	if data.CertChain, err = readAndVerifyCert(f.certChainFile); err != nil {
+		return nil, err
+	}
+	if data.Ca, err = readAndVerifyCert(f.caFile); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (f *files) Watch(ctx context.Context, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			// watch for events
+			case event := <-watcher.Events:
+				contextutils.LoggerFrom(ctx).Infow("received event", zap.Any("event", event))
+				f.watch(ctx, watcher)
+				onChange()
+			// watch for errors
+			case err := <-watcher.Errors:
+				contextutils.LoggerFrom(ctx).Warnw("Received error from file watcher", zap.Error(err))
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	f.watch(ctx, watcher)
+	return nil
+}
+
+// watch (re-)adds the watches, as files which are replaced, such as when Kubernetes updates the symlinks of a mounted
+// secret, are no longer watched. The directories of the files are watched too, as a file which is removed and written
+// again can only be watched once it exists, and a watch on its directory sees it being written.
+func (f *files) watch(ctx context.Context, watcher *fsnotify.Watcher) {
+	paths := []string{f.dir}
+	if f.dir == "" {
+		contextutils.LoggerFrom(ctx).Infow("watcher started", zap.String("sslKeyFile", f.privateKeyFile), zap.String("sshCertFile", f.certChainFile), zap.String("sslCaFile", f.caFile))
+		paths = nil
+		for _, file := range []string{f.privateKeyFile, f.certChainFile, f.caFile} {
+			if file != "" {
+				paths = append(paths, file, filepath.Dir(file))
+			}
+		}
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if err := watcher.Add(path); err != nil {
+			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+		}
+	}
+}
+
+// readAndVerifyCert will read the file from the given
+// path, then check for validity every 100ms for 2 seconds.
+// This is needed because the filesystem watcher
+// that gets triggered by a WRITE doesn't have a guarantee
+// that the write has finished yet.
+// See https://github.com/fsnotify/fsnotify/pull/252 for more context
+func readAndVerifyCert(certFilePath string) ([]byte, error) {
+	if certFilePath == "" {
+		return nil, nil
+	}
+
+	var err error
+	var fileBytes []byte
+
+	// Retry for a few seconds as a write may still be in progress. Files which are still malformed after retrying
+	// are returned, so that the server can report them.
+	err = retry.Do(
+		func() error {
+			fileBytes, err = ioutil.ReadFile(certFilePath)
+			if err != nil {
+				return err
+			}
+			if !IsPem(fileBytes) {
+				return fmt.Errorf("failed to validate file %v", certFilePath)
+			}
+			return nil
+		},
+		retry.Attempts(5), // Exponential backoff over ~3s
+	)
+	if fileBytes == nil {
+		return nil, err
+	}
+	return fileBytes, nil
+}
+
+// IsPem uses pem.Decode to verify that the given
+// bytes are not malformed, as could be caused by a
+// write-in-progress. Uses pem.Decode to check the blocks.
+// See https://golang.org/src/encoding/pem/pem.go?s=2505:2553#L76
+func IsPem(certs []byte) bool {
+	block, rest := pem.Decode(certs)
+	if block == nil {
+		// Remainder does not contain any certs/keys
+		return false
+	}
+	// Found a cert, check the rest
+	if len(rest) > 0 {
+		// Something after the cert, validate that too
+		return IsPem(rest)
+	}
+	return true
+}
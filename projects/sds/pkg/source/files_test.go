@@ -0,0 +1,63 @@
+package source_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+)
+
+var _ = Describe("Directory", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		dir    string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		dir, err = os.MkdirTemp("", "sds-source")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, contents string) {
+		Expect(os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644)).NotTo(HaveOccurred())
+	}
+
+	It("reads the files with the given names, and watches for files which are created", func() {
+		src := source.NewDirectory(dir, source.Keys{PrivateKey: "tls.key", CertChain: "tls.crt"})
+		_, err := src.Read(ctx)
+		Expect(err).To(HaveOccurred())
+
+		changed := make(chan struct{}, 10)
+		Expect(src.Watch(ctx, func() { changed <- struct{}{} })).NotTo(HaveOccurred())
+
+		writeFile("tls.key", "key")
+		writeFile("tls.crt", "cert")
+		Eventually(changed).Should(Receive())
+
+		data, err := src.Read(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(&source.Data{PrivateKey: []byte("key"), CertChain: []byte("cert")}))
+	})
+})
+
+var _ = Describe("IsPem", func() {
+
+	It("checks that every block is valid PEM", func() {
+		block := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+		Expect(source.IsPem([]byte(block + block))).To(BeTrue())
+		Expect(source.IsPem([]byte(block + "test"))).To(BeFalse())
+		Expect(source.IsPem([]byte("test"))).To(BeFalse())
+	})
+})
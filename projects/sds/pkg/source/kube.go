@@ -0,0 +1,125 @@
+package source
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rotisserie/eris"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	KubeSecretNotFoundErr = func(namespace, name string) error {
+		return eris.Errorf("secret %v.%v not found", namespace, name)
+	}
+	MissingSecretKeyErr = func(namespace, name, key string) error {
+		return eris.Errorf("secret %v.%v does not contain %v", namespace, name, key)
+	}
+)
+
+type kubeSecret struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	keys      Keys
+
+	lock sync.RWMutex
+	// set once the watch has synced, after which secrets are read from the watch rather than the API
+	store cache.Store
+}
+
+// NewKubeSecret returns a source which reads the secret set from a Kubernetes Secret, and watches it through the
+// Kubernetes API.
+func NewKubeSecret(client kubernetes.Interface, namespace, name string, keys Keys) Source {
+	return &kubeSecret{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		keys:      keys,
+	}
+}
+
+func (k *kubeSecret) Read(ctx context.Context) (*Data, error) {
+	secret, err := k.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Data
+	for _, value := range []struct {
+		key  string
+		data *[]byte
+	}{
+		{key: k.keys.PrivateKey, data: &data.PrivateKey},
+		{key: k.keys.CertChain, data: &data.CertChain},
+		{key: k.keys.Ca, data: &data.Ca},
+	} {
+		if value.key == "" {
+			continue
+		}
+		secretValue, ok := secret.Data[value.key]
+		if !ok {
+			return nil, MissingSecretKeyErr(k.namespace, k.name, value.key)
+		}
+		*value.data = secretValue
+	}
+	return &data, nil
+}
+
+func (k *kubeSecret) get(ctx context.Context) (*v1.Secret, error) {
+	k.lock.RLock()
+	store := k.store
+	k.lock.RUnlock()
+
+	if store == nil {
+		secret, err := k.client.CoreV1().Secrets(k.namespace).Get(ctx, k.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return secret, nil
+	}
+
+	obj, exists, err := store.GetByKey(k.namespace + "/" + k.name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, KubeSecretNotFoundErr(k.namespace, k.name)
+	}
+	return obj.(*v1.Secret), nil
+}
+
+func (k *kubeSecret) Watch(ctx context.Context, onChange func()) error {
+	nameSelector := fields.OneTermEqualSelector("metadata.name", k.name).String()
+	listWatch := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = nameSelector
+			return k.client.CoreV1().Secrets(k.namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = nameSelector
+			return k.client.CoreV1().Secrets(k.namespace).Watch(ctx, options)
+		},
+	}
+	store, controller := cache.NewInformer(listWatch, &v1.Secret{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { onChange() },
+		UpdateFunc: func(interface{}, interface{}) { onChange() },
+		DeleteFunc: func(interface{}) { onChange() },
+	})
+	go controller.Run(ctx.Done())
+	go func() {
+		if !cache.WaitForCacheSync(ctx.Done(), controller.HasSynced) {
+			return
+		}
+		k.lock.Lock()
+		k.store = store
+		k.lock.Unlock()
+	}()
+	return nil
+}
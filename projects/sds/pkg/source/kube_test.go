@@ -0,0 +1,83 @@
+package source_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/sds/pkg/source"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("KubeSecret", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		client kubernetes.Interface
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		client = fake.NewSimpleClientset()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	secret := func(data map[string][]byte) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "gloo-system", Name: "upstream-tls"},
+			Data:       data,
+		}
+	}
+
+	It("reads the secret, and watches it for changes", func() {
+		src := source.NewKubeSecret(client, "gloo-system", "upstream-tls", source.DefaultKeys())
+		_, err := src.Read(ctx)
+		Expect(err).To(HaveOccurred())
+
+		changed := make(chan struct{}, 10)
+		Expect(src.Watch(ctx, func() { changed <- struct{}{} })).NotTo(HaveOccurred())
+
+		_, err = client.CoreV1().Secrets("gloo-system").Create(ctx, secret(map[string][]byte{
+			"tls.key": []byte("key"),
+			"tls.crt": []byte("cert"),
+			"ca.crt":  []byte("ca"),
+		}), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(changed).Should(Receive())
+		Eventually(func() (*source.Data, error) {
+			return src.Read(ctx)
+		}).Should(Equal(&source.Data{PrivateKey: []byte("key"), CertChain: []byte("cert"), Ca: []byte("ca")}))
+
+		_, err = client.CoreV1().Secrets("gloo-system").Update(ctx, secret(map[string][]byte{
+			"tls.key": []byte("key-1"),
+			"tls.crt": []byte("cert-1"),
+			"ca.crt":  []byte("ca"),
+		}), metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() (*source.Data, error) {
+			return src.Read(ctx)
+		}).Should(Equal(&source.Data{PrivateKey: []byte("key-1"), CertChain: []byte("cert-1"), Ca: []byte("ca")}))
+	})
+
+	It("errors when the secret does not contain a key which is read", func() {
+		_, err := client.CoreV1().Secrets("gloo-system").Create(ctx, secret(map[string][]byte{
+			"tls.key": []byte("key"),
+			"tls.crt": []byte("cert"),
+		}), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = source.NewKubeSecret(client, "gloo-system", "upstream-tls", source.DefaultKeys()).Read(ctx)
+		Expect(err).To(MatchError("secret gloo-system.upstream-tls does not contain ca.crt"))
+
+		data, err := source.NewKubeSecret(client, "gloo-system", "upstream-tls", source.Keys{PrivateKey: "tls.key", CertChain: "tls.crt"}).Read(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(&source.Data{PrivateKey: []byte("key"), CertChain: []byte("cert")}))
+	})
+})
@@ -0,0 +1,39 @@
+package source
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Data is the PEM encoded contents of a secret set. Data which is not read by the source is left empty.
+type Data struct {
+	PrivateKey []byte
+	CertChain  []byte
+	Ca         []byte
+}
+
+// Source provides the certificates of a secret set
+type Source interface {
+	// Read returns the current contents of the secret set
+	Read(ctx context.Context) (*Data, error)
+	// Watch calls onChange whenever the contents of the secret set may have changed, until the context is done
+	Watch(ctx context.Context, onChange func()) error
+}
+
+// Keys are the names of the private key, certificate chain and CA certificate within a directory or a Kubernetes
+// Secret. Data with an empty name is not read.
+type Keys struct {
+	PrivateKey string
+	CertChain  string
+	Ca         string
+}
+
+// DefaultKeys are the names used by Kubernetes TLS secrets and cert-manager
+func DefaultKeys() Keys {
+	return Keys{
+		PrivateKey: v1.TLSPrivateKeyKey,
+		CertChain:  v1.TLSCertKey,
+		Ca:         v1.ServiceAccountRootCAKey,
+	}
+}
@@ -100,7 +100,8 @@ func GetCerts(params Params) (string, string) {
 	}
 
 	if params.ValidFor == nil {
-		tmp := time.Hour * 24
+		// long enough that translation does not warn that the cert is about to expire
+		tmp := time.Hour * 24 * 365
 		params.ValidFor = &tmp
 	}
 
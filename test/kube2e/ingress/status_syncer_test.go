@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/ingress/pkg/status"
@@ -67,8 +68,9 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := v1.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kube, &v1.IngressClass{}))
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient)
+			statusSync := status.NewSyncer(ingressClient, false, "", "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())
@@ -227,8 +229,9 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := v1.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kubeClientset, &v1.IngressClass{}))
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient)
+			statusSync := status.NewSyncer(ingressClient, false, "", "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())
@@ -363,8 +366,9 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := v1.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kubeClientset, &v1.IngressClass{}))
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient)
+			statusSync := status.NewSyncer(ingressClient, false, "", "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())